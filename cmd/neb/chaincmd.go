@@ -67,6 +67,18 @@ Dump the genesis config info.`,
 		Description: `
 Use "./neb dump 10" to dump 10 blocks before tail block.`,
 	}
+
+	pruneCommand = cli.Command{
+		Action:    MergeFlags(prune),
+		Name:      "prune",
+		Usage:     "Prune the states of blocks before latest irreversible block from storage",
+		ArgsUsage: "[keepblocks]",
+		Category:  "BLOCKCHAIN COMMANDS",
+		Description: `
+Use "./neb prune 1000" to keep the states of 1000 blocks before latest irreversible block,
+the prune_keep_blocks in chain config is used if it is not given.
+Stop the node before pruning, the states of forks before latest irreversible block are lost.`,
+	}
 )
 
 func initGenesis(ctx *cli.Context) error {
@@ -124,3 +136,27 @@ func dumpblock(ctx *cli.Context) error {
 	fmt.Printf("blockchain dump: %s\n", neb.BlockChain().Dump(count))
 	return nil
 }
+
+func prune(ctx *cli.Context) error {
+	neb, err := makeNeb(ctx)
+	if err != nil {
+		return err
+	}
+
+	keep := neb.Config().Chain.PruneKeepBlocks
+	if ctx.NArg() > 0 {
+		keep, err = strconv.ParseUint(ctx.Args().First(), 10, 64)
+		if err != nil {
+			return err
+		}
+	}
+
+	neb.Setup()
+
+	count, err := core.NewPruner(neb.BlockChain(), keep).Prune()
+	if err != nil {
+		FatalF("prune states failed: %v", err)
+	}
+	fmt.Printf("pruned %d trie nodes, states are kept since height %d\n", count, neb.BlockChain().PrunedHeight()+1)
+	return nil
+}
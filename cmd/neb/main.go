@@ -70,6 +70,7 @@ func main() {
 		licenseCommand,
 		configCommand,
 		blockDumpCommand,
+		pruneCommand,
 		serializeCommand,
 	}
 	sort.Sort(cli.CommandsByName(app.Commands))
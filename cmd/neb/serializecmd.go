@@ -94,6 +94,8 @@ type contractJSON struct {
 	SourceType string `json:"source_type"`
 	Function   string `json:"function"`
 	Args       string `json:"args"`
+	ABI        string `json:"abi"`
+	Upgradable bool   `json:"upgradable"`
 }
 
 type candidateJSON struct {
@@ -219,7 +221,7 @@ func parseTransaction(neb *neblet.Neblet, txJSON *txJSON) (*core.Transaction, er
 	)
 	if txJSON.Contract != nil && len(txJSON.Contract.Source) > 0 {
 		payloadType = core.TxPayloadDeployType
-		payloadObj, err := core.NewDeployPayload(txJSON.Contract.SourceType, txJSON.Contract.Source, txJSON.Contract.Args)
+		payloadObj, err := core.NewDeployPayload(txJSON.Contract.SourceType, txJSON.Contract.Source, txJSON.Contract.Args, txJSON.Contract.ABI, txJSON.Contract.Upgradable)
 		if err != nil {
 			return nil, err
 		}
@@ -233,6 +235,22 @@ func parseTransaction(neb *neblet.Neblet, txJSON *txJSON) (*core.Transaction, er
 		}
 		payload, err = callpayload.ToBytes()
 
+	} else if txJSON.Candidate != nil {
+		payloadType = core.TxPayloadCandidateType
+
+		candidatePayload, err := core.NewCandidatePayload(txJSON.Candidate.Action)
+		if err != nil {
+			return nil, err
+		}
+		payload, err = candidatePayload.ToBytes()
+	} else if txJSON.Delegate != nil {
+		payloadType = core.TxPayloadDelegateType
+
+		delegatePayload, err := core.NewDelegatePayload(txJSON.Delegate.Action, txJSON.Delegate.Delegatee)
+		if err != nil {
+			return nil, err
+		}
+		payload, err = delegatePayload.ToBytes()
 	} else {
 		payloadType = core.TxPayloadBinaryType
 	}
@@ -21,6 +21,9 @@ package trie
 import (
 	"bytes"
 	"errors"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/crypto/hash"
 )
 
 // MerkleProof is a path from root to the proved node
@@ -34,7 +37,7 @@ func (t *Trie) Prove(key []byte) (MerkleProof, error) {
 	curRoute := keyToRoute(key)
 	curRootHash := t.rootHash
 	var proof MerkleProof
-	for len(curRoute) > 0 {
+	for {
 		// fetch sub-trie root node
 		rootNode, err := t.fetchNode(curRootHash)
 		if err != nil {
@@ -44,6 +47,10 @@ func (t *Trie) Prove(key []byte) (MerkleProof, error) {
 		if err != nil {
 			return nil, err
 		}
+		// the leaf may have empty path after a branch.
+		if len(curRoute) == 0 && flag != leaf {
+			return nil, ErrNotFound
+		}
 		switch flag {
 		case branch:
 			proof = append(proof, rootNode.Val)
@@ -71,7 +78,6 @@ func (t *Trie) Prove(key []byte) (MerkleProof, error) {
 			return nil, ErrNotFound
 		}
 	}
-	return nil, ErrNotFound
 }
 
 // Verify whether the merkle proof from root to the associated node is right
@@ -119,3 +125,51 @@ func (t *Trie) Verify(rootHash []byte, key []byte, proof MerkleProof) error {
 	}
 	return nil
 }
+
+// VerifyProof check the merkle proof from root to the node of key, return the value in the node.
+// Unlike Verify, it doesn't need a trie, can be used by light clients.
+func VerifyProof(rootHash []byte, key []byte, proof MerkleProof) ([]byte, error) {
+	curRoute := keyToRoute(key)
+	wantHash := rootHash
+	for _, val := range proof {
+		n := &node{Val: val}
+		pb, err := n.ToProto()
+		if err != nil {
+			return nil, err
+		}
+		data, err := proto.Marshal(pb)
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(wantHash, hash.Sha3256(data)) {
+			return nil, ErrInvalidProof
+		}
+		flag, err := n.Type()
+		if err != nil {
+			return nil, err
+		}
+		switch flag {
+		case branch:
+			if len(curRoute) == 0 {
+				return nil, ErrInvalidProof
+			}
+			wantHash = val[curRoute[0]]
+			curRoute = curRoute[1:]
+		case ext:
+			path := val[1]
+			if len(path) > len(curRoute) || !bytes.Equal(path, curRoute[:len(path)]) {
+				return nil, ErrInvalidProof
+			}
+			wantHash = val[2]
+			curRoute = curRoute[len(path):]
+		case leaf:
+			if !bytes.Equal(val[1], curRoute) {
+				return nil, ErrInvalidProof
+			}
+			return val[2], nil
+		default:
+			return nil, ErrInvalidProof
+		}
+	}
+	return nil, ErrInvalidProof
+}
@@ -0,0 +1,85 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package trie
+
+import (
+	"bytes"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/common/trie/pb"
+	"github.com/nebulasio/go-nebulas/crypto/hash"
+	"github.com/nebulasio/go-nebulas/storage"
+)
+
+// MarkNodes mark the nodes reachable from the root hash in storage.
+// Nodes already marked are skipped with their sub-tries, so marking many
+// versions of a trie only walks the nodes changed between versions.
+// visit is called with the value of each newly marked leaf, can be nil.
+func MarkNodes(stor storage.Reader, rootHash []byte, marked map[string]bool, visit func(value []byte) error) error {
+	if len(rootHash) == 0 || marked[string(rootHash)] {
+		return nil
+	}
+	ir, err := stor.Get(rootHash)
+	if err != nil {
+		return err
+	}
+	pb := new(triepb.Node)
+	if err := proto.Unmarshal(ir, pb); err != nil {
+		return err
+	}
+	n := new(node)
+	if err := n.FromProto(pb); err != nil {
+		return err
+	}
+	marked[string(rootHash)] = true
+
+	flag, err := n.Type()
+	if err != nil {
+		return err
+	}
+	switch flag {
+	case branch:
+		for _, child := range n.Val {
+			if err := MarkNodes(stor, child, marked, visit); err != nil {
+				return err
+			}
+		}
+	case ext:
+		return MarkNodes(stor, n.Val[2], marked, visit)
+	case leaf:
+		if visit != nil {
+			return visit(n.Val[2])
+		}
+	}
+	return nil
+}
+
+// IsNode return whether the entry in storage is a trie node,
+// whose key is the hash of its value.
+func IsNode(key []byte, value []byte) bool {
+	if !bytes.Equal(key, hash.Sha3256(value)) {
+		return false
+	}
+	pb := new(triepb.Node)
+	if err := proto.Unmarshal(value, pb); err != nil {
+		return false
+	}
+	_, err := (&node{Val: pb.Val}).Type()
+	return err == nil
+}
@@ -0,0 +1,97 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package trie
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/crypto/hash"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarkNodes(t *testing.T) {
+	stor, _ := storage.NewMemoryStorage()
+	tr, err := NewTrie(nil, stor, false)
+	assert.Nil(t, err)
+
+	keys := [][]byte{}
+	for _, s := range []string{"key1", "key2", "key3", "other", "otherkey"} {
+		keys = append(keys, hash.Sha3256([]byte(s)))
+	}
+	for _, key := range keys[:3] {
+		_, err := tr.Put(key, []byte("old"))
+		assert.Nil(t, err)
+	}
+	oldRoot := tr.RootHash()
+	for _, key := range keys[1:] {
+		_, err := tr.Put(key, []byte("new"))
+		assert.Nil(t, err)
+	}
+	root := tr.RootHash()
+	assert.Nil(t, stor.Put([]byte("key"), []byte("value")))
+
+	marked := make(map[string]bool)
+	leaves := 0
+	assert.Nil(t, MarkNodes(stor, root, marked, func(value []byte) error {
+		leaves++
+		return nil
+	}))
+	assert.Equal(t, 5, leaves)
+	assert.True(t, marked[string(root)])
+	assert.False(t, marked[string(oldRoot)])
+
+	// marked nodes are skipped.
+	assert.Nil(t, MarkNodes(stor, root, marked, func(value []byte) error {
+		leaves++
+		return nil
+	}))
+	assert.Equal(t, 5, leaves)
+
+	iter := stor.Iterator(nil)
+	garbage := [][]byte{}
+	for iter.Next() {
+		if !marked[string(iter.Key())] && IsNode(iter.Key(), iter.Value()) {
+			garbage = append(garbage, append([]byte{}, iter.Key()...))
+		}
+	}
+	iter.Release()
+	assert.NotEmpty(t, garbage)
+	for _, key := range garbage {
+		assert.Nil(t, stor.Del(key))
+	}
+
+	tr, err = NewTrie(root, stor, false)
+	assert.Nil(t, err)
+	for i, key := range keys {
+		value, err := tr.Get(key)
+		assert.Nil(t, err)
+		if i == 0 {
+			assert.Equal(t, []byte("old"), value)
+		} else {
+			assert.Equal(t, []byte("new"), value)
+		}
+	}
+	_, err = NewTrie(oldRoot, stor, false)
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+
+	value, err := stor.Get([]byte("key"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value"), value)
+}
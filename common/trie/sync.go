@@ -18,14 +18,151 @@
 
 package trie
 
-// SyncTrie data from other servers
-// Sync whole trie to build snapshot
-func (t *Trie) SyncTrie(rootHash []byte) error {
-	return nil
+import (
+	"errors"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/common/trie/pb"
+	"github.com/nebulasio/go-nebulas/crypto/hash"
+	"github.com/nebulasio/go-nebulas/storage"
+)
+
+// Errors
+var (
+	ErrUnrequestedNode = errors.New("the trie node is not requested")
+	ErrInvalidNode     = errors.New("invalid trie node")
+)
+
+// LeafCallback return the roots of sub-tries referred by the leaf value,
+// such as the variables trie of an account.
+type LeafCallback func(value []byte) ([][]byte, error)
+
+type syncRequest struct {
+	hash     []byte
+	callback LeafCallback
+}
+
+// Sync retrieves the tries missing in storage from other servers, node by node.
+// Each received node is checked against its hash and written to storage,
+// then its children are scheduled. Sync is not thread safe.
+type Sync struct {
+	storage   storage.Storage
+	queue     []*syncRequest
+	requested map[string]*syncRequest
+	scheduled map[string]bool
+}
+
+// NewSync create a new Sync writing nodes to the storage
+func NewSync(stor storage.Storage) *Sync {
+	return &Sync{
+		storage:   stor,
+		queue:     []*syncRequest{},
+		requested: make(map[string]*syncRequest),
+		scheduled: make(map[string]bool),
+	}
 }
 
-// SyncPath from rootHash to key node from other servers
-// Useful for verification quickly
-func (t *Trie) SyncPath(rootHash []byte, key []byte) error {
+// AddRoot schedule the trie of the root hash, callback can be nil.
+// The nodes already in storage are walked locally.
+func (s *Sync) AddRoot(rootHash []byte, callback LeafCallback) error {
+	return s.schedule(&syncRequest{hash: rootHash, callback: callback})
+}
+
+func (s *Sync) schedule(req *syncRequest) error {
+	if len(req.hash) == 0 || s.scheduled[string(req.hash)] {
+		return nil
+	}
+	s.scheduled[string(req.hash)] = true
+
+	data, err := s.storage.Get(req.hash)
+	if err == storage.ErrKeyNotFound {
+		s.queue = append(s.queue, req)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return s.process(req, data)
+}
+
+// Missing return at most max hashes of nodes to retrieve, they are marked as requested.
+func (s *Sync) Missing(max int) [][]byte {
+	if max > len(s.queue) {
+		max = len(s.queue)
+	}
+	hashes := make([][]byte, max)
+	for i, req := range s.queue[:max] {
+		hashes[i] = req.hash
+		s.requested[string(req.hash)] = req
+	}
+	s.queue = s.queue[max:]
+	return hashes
+}
+
+// Retry reschedule the requested nodes not received yet.
+func (s *Sync) Retry(hashes [][]byte) {
+	for _, h := range hashes {
+		if req, ok := s.requested[string(h)]; ok {
+			delete(s.requested, string(h))
+			s.queue = append(s.queue, req)
+		}
+	}
+}
+
+// Process verify the received node data, write it to storage and schedule its children.
+func (s *Sync) Process(data []byte) error {
+	key := hash.Sha3256(data)
+	req, ok := s.requested[string(key)]
+	if !ok {
+		return ErrUnrequestedNode
+	}
+	if err := s.process(req, data); err != nil {
+		return err
+	}
+	delete(s.requested, string(key))
+	return s.storage.Put(key, data)
+}
+
+func (s *Sync) process(req *syncRequest, data []byte) error {
+	pb := new(triepb.Node)
+	if err := proto.Unmarshal(data, pb); err != nil {
+		return err
+	}
+	n := &node{Val: pb.Val}
+	flag, err := n.Type()
+	if err != nil {
+		return err
+	}
+
+	switch flag {
+	case branch:
+		for _, child := range n.Val {
+			if err := s.schedule(&syncRequest{hash: child, callback: req.callback}); err != nil {
+				return err
+			}
+		}
+	case ext:
+		return s.schedule(&syncRequest{hash: n.Val[2], callback: req.callback})
+	case leaf:
+		if req.callback == nil {
+			return nil
+		}
+		roots, err := req.callback(n.Val[2])
+		if err != nil {
+			return err
+		}
+		for _, root := range roots {
+			if err := s.schedule(&syncRequest{hash: root}); err != nil {
+				return err
+			}
+		}
+	default:
+		return ErrInvalidNode
+	}
 	return nil
 }
+
+// Pending return the count of nodes not retrieved yet.
+func (s *Sync) Pending() int {
+	return len(s.queue) + len(s.requested)
+}
@@ -0,0 +1,95 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package trie
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/crypto/hash"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSync(t *testing.T) {
+	from, _ := storage.NewMemoryStorage()
+	tr, err := NewTrie(nil, from, false)
+	assert.Nil(t, err)
+	sub, err := NewTrie(nil, from, false)
+	assert.Nil(t, err)
+	_, err = sub.Put(hash.Sha3256([]byte("sub")), []byte("value"))
+	assert.Nil(t, err)
+
+	keys := [][]byte{}
+	for i := 0; i < 100; i++ {
+		key := hash.Sha3256([]byte{byte(i)})
+		keys = append(keys, key)
+		_, err := tr.Put(key, sub.RootHash())
+		assert.Nil(t, err)
+	}
+
+	to, _ := storage.NewMemoryStorage()
+	s := NewSync(to)
+	subRoots := 0
+	assert.Nil(t, s.AddRoot(tr.RootHash(), func(value []byte) ([][]byte, error) {
+		subRoots++
+		return [][]byte{value}, nil
+	}))
+	assert.Equal(t, 1, s.Pending())
+
+	_, err = NewTrie(tr.RootHash(), to, false)
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+
+	for s.Pending() > 0 {
+		hashes := s.Missing(10)
+		assert.NotEmpty(t, hashes)
+		assert.True(t, len(hashes) <= 10)
+
+		// only the first half is answered, the rest are retried.
+		answered := hashes[:(len(hashes)+1)/2]
+		for _, h := range answered {
+			data, err := from.Get(h)
+			assert.Nil(t, err)
+			assert.Nil(t, s.Process(data))
+		}
+		s.Retry(hashes)
+	}
+	assert.Equal(t, 100, subRoots)
+
+	synced, err := NewTrie(tr.RootHash(), to, false)
+	assert.Nil(t, err)
+	for _, key := range keys {
+		value, err := synced.Get(key)
+		assert.Nil(t, err)
+		assert.Equal(t, sub.RootHash(), value)
+	}
+	synced, err = NewTrie(sub.RootHash(), to, false)
+	assert.Nil(t, err)
+	value, err := synced.Get(hash.Sha3256([]byte("sub")))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value"), value)
+
+	// nodes not requested or already in storage are rejected.
+	data, _ := from.Get(tr.RootHash())
+	assert.Equal(t, ErrUnrequestedNode, s.Process(data))
+
+	// the tries already in storage are walked locally.
+	s = NewSync(to)
+	assert.Nil(t, s.AddRoot(tr.RootHash(), nil))
+	assert.Equal(t, 0, s.Pending())
+}
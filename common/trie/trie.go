@@ -31,6 +31,7 @@ import (
 var (
 	ErrNotFound           = storage.ErrKeyNotFound
 	ErrInvalidProtoToNode = errors.New("Pb Message cannot be converted into Trie Node")
+	ErrInvalidProof       = errors.New("invalid merkle proof")
 )
 
 // Action represents operation types in Trie
@@ -22,17 +22,14 @@ import (
 	"errors"
 	"time"
 
-	"github.com/nebulasio/go-nebulas/rpc"
-	"github.com/nebulasio/go-nebulas/rpc/pb"
-	"golang.org/x/net/context"
-
 	"github.com/nebulasio/go-nebulas/core/state"
-	"github.com/nebulasio/go-nebulas/crypto/keystore"
 
 	lru "github.com/hashicorp/golang-lru"
+	"github.com/nebulasio/go-nebulas/consensus"
 	"github.com/nebulasio/go-nebulas/core"
 	metrics "github.com/nebulasio/go-nebulas/metrics"
 	"github.com/nebulasio/go-nebulas/net"
+	"github.com/nebulasio/go-nebulas/util"
 	"github.com/nebulasio/go-nebulas/util/byteutils"
 	"github.com/nebulasio/go-nebulas/util/logging"
 	"github.com/sirupsen/logrus"
@@ -43,19 +40,22 @@ const (
 	DefaultMaxUnlockDuration time.Duration = 1<<63 - 1
 )
 
+// EvidenceGasLimit of the transaction submitting double mint evidence
+var EvidenceGasLimit = util.NewUint128FromUint(100000)
+
 // Errors in PoW Consensus
 var (
 	ErrInvalidBlockTimestamp      = errors.New("invalid block timestamp, should be same as consensus's timestamp")
 	ErrInvalidBlockInterval       = errors.New("invalid block interval")
 	ErrMissingConfigForDpos       = errors.New("missing configuration for Dpos")
-	ErrInvalidBlockProposer       = errors.New("invalid block proposer")
+	ErrInvalidBlockProposer       = consensus.ErrInvalidBlockProposer
 	ErrCannotMintWhenPending      = errors.New("cannot mint block now, waiting for cancel pending again")
 	ErrCannotMintWhenDisable      = errors.New("cannot mint block now, waiting for enable it again")
-	ErrWaitingBlockInLastSlot     = errors.New("cannot mint block now, waiting for last block")
-	ErrBlockMintedInNextSlot      = errors.New("cannot mint block now, there is a block minted in current slot")
+	ErrWaitingBlockInLastSlot     = consensus.ErrWaitingBlockInLastSlot
+	ErrBlockMintedInNextSlot      = consensus.ErrBlockMintedInNextSlot
 	ErrGenerateNextConsensusState = errors.New("Failed to generate next consensus state")
 	ErrDoubleBlockMinted          = errors.New("double block minted")
-	ErrAppendNewBlockFailed       = errors.New("failed to append new block to real chain")
+	ErrAppendNewBlockFailed       = consensus.ErrAppendNewBlockFailed
 )
 
 // Metrics
@@ -170,72 +170,32 @@ func (dpos *Dpos) Enable() bool {
 	return dpos.enable
 }
 
-func less(a *core.Block, b *core.Block) bool {
-	if a.Height() != b.Height() {
-		return a.Height() < b.Height()
-	}
-	return byteutils.Less(a.Hash(), b.Hash())
-}
-
 // ForkChoice select new tail
 func (dpos *Dpos) ForkChoice() error {
-	bc := dpos.chain
-	tailBlock := bc.TailBlock()
-	detachedTailBlocks := bc.DetachedTailBlocks()
-
-	// find the max depth.
-	newTailBlock := tailBlock
-
-	for _, v := range detachedTailBlocks {
-		if less(newTailBlock, v) {
-			newTailBlock = v
-		}
-	}
-
-	if newTailBlock.Hash().Equals(tailBlock.Hash()) {
-		logging.VLog().WithFields(logrus.Fields{
-			"old tail": tailBlock,
-			"new tail": newTailBlock,
-		}).Info("Current tail is best, no need to change.")
-		return nil
-	}
-
-	err := bc.SetTailBlock(newTailBlock)
-	if err != nil {
-		logging.VLog().WithFields(logrus.Fields{
-			"new tail": newTailBlock,
-			"old tail": tailBlock,
-			"err":      err,
-		}).Error("Failed to set new tail block.")
-		return err
-	}
-
-	logging.VLog().WithFields(logrus.Fields{
-		"new tail": newTailBlock,
-		"old tail": tailBlock,
-	}).Info("change to new tail.")
-	return nil
+	return consensus.ForkChoice(dpos.chain)
 }
 
 // UpdateLIB update the latest irrversible block
 func (dpos *Dpos) UpdateLIB() {
 	lib := dpos.chain.LIB()
 	tail := dpos.chain.TailBlock()
+	conf := dposConf(dpos.chain)
+	limit := consensusSize(conf)
 	cur := tail
 	miners := make(map[string]bool)
 	dynasty := int64(-1)
 	for !cur.Hash().Equals(lib.Hash()) {
-		curDynasty := cur.Timestamp() * SecondInMs / DynastyIntervalInMs
+		curDynasty := cur.Timestamp() * SecondInMs / conf.DynastyIntervalInMs
 		if curDynasty != dynasty {
 			miners = make(map[string]bool)
 			dynasty = curDynasty
 		}
 		// fast prune
-		if int(cur.Height())-int(lib.Height()) < ConsensusSize-len(miners) {
+		if int(cur.Height())-int(lib.Height()) < limit-len(miners) {
 			return
 		}
 		miners[byteutils.Hex(cur.ConsensusRoot().Proposer)] = true
-		if len(miners) >= ConsensusSize {
+		if len(miners) >= limit {
 			if err := dpos.chain.StoreLIBHashToStorage(cur); err != nil {
 				logging.VLog().WithFields(logrus.Fields{
 					"tail": tail,
@@ -247,7 +207,7 @@ func (dpos *Dpos) UpdateLIB() {
 				"lib.new":          cur,
 				"lib.old":          lib,
 				"tail":             tail,
-				"miners.limit":     ConsensusSize,
+				"miners.limit":     limit,
 				"miners.supported": len(miners),
 			}).Info("Succeed to update latest irreversible block.")
 			dpos.chain.SetLIB(cur)
@@ -276,7 +236,7 @@ func (dpos *Dpos) UpdateLIB() {
 		"lib":              lib,
 		"tail":             tail,
 		"err":              "supported miners is not enough",
-		"miners.limit":     ConsensusSize,
+		"miners.limit":     limit,
 		"miners.supported": len(miners),
 	}).Warn("Failed to update latest irreversible block.")
 }
@@ -298,36 +258,13 @@ func (dpos *Dpos) ResumeMining() {
 	dpos.pending = false
 }
 
-func verifyBlockSign(miner *core.Address, block *core.Block) error {
-	signer, err := core.RecoverSignerFromSignature(block.Alg(), block.Hash(), block.Signature())
-	if err != nil {
-		logging.VLog().WithFields(logrus.Fields{
-			"signer": signer,
-			"err":    err,
-			"block":  block,
-		}).Error("Failed to recover block's miner.")
-		return err
-	}
-	if !miner.Equals(signer) {
-		logging.CLog().WithFields(logrus.Fields{
-			"signer": signer,
-			"miner":  miner,
-			"block":  block,
-		}).Debug("Failed to verify block's sign.")
-		return ErrInvalidBlockProposer
-	}
-	return nil
-}
-
 // VerifyBlock verify the block
 func (dpos *Dpos) VerifyBlock(block *core.Block) error {
-	tail := dpos.chain.TailBlock()
 	// check timestamp
 	if block.Timestamp() != block.ConsensusRoot().Timestamp {
 		return ErrInvalidBlockTimestamp
 	}
-	elapsedSecondInMs := (block.Timestamp() - tail.Timestamp()) * SecondInMs
-	if (elapsedSecondInMs % BlockIntervalInMs) != 0 {
+	if (block.Timestamp() * SecondInMs % dposConf(dpos.chain).BlockIntervalInMs) != 0 {
 		return ErrInvalidBlockInterval
 	}
 	// check double mint
@@ -336,23 +273,15 @@ func (dpos *Dpos) VerifyBlock(block *core.Block) error {
 			"curBlock": block,
 			"preBlock": preBlock.(*core.Block),
 		}).Warn("Found someone minted multiple blocks at same time.")
+		dpos.reportDoubleMint(preBlock.(*core.Block), block)
 		return ErrDoubleBlockMinted
 	}
 	// check proposer
-	miners, err := tail.WorldState().Dynasty()
+	proposer, err := dpos.blockProposer(block)
 	if err != nil {
 		logging.VLog().WithFields(logrus.Fields{
 			"err":   err,
 			"block": block,
-		}).Debug("Failed to get miners from dynasty.")
-		return err
-	}
-	proposer, err := FindProposer(block.Timestamp(), miners)
-	if err != nil {
-		logging.VLog().WithFields(logrus.Fields{
-			"proposer": proposer,
-			"err":      err,
-			"block":    block,
 		}).Debug("Failed to find proposer.")
 		return err
 	}
@@ -366,132 +295,136 @@ func (dpos *Dpos) VerifyBlock(block *core.Block) error {
 		return err
 	}
 	// check signature
-	if err := verifyBlockSign(miner, block); err != nil {
+	if err := consensus.VerifyBlockSign(miner, block); err != nil {
 		return err
 	}
 	dpos.slot.Add(block.Timestamp(), block)
 	return nil
 }
 
-func (dpos *Dpos) signBlock(block *core.Block) error {
-	if dpos.enableRemoteSignServer == true {
-		conn, err := rpc.Dial(dpos.remoteSignServer)
-		if err != nil {
-			return err
-		}
-		adminService := rpcpb.NewAdminServiceClient(conn)
-		alg := keystore.SECP256K1
-		resp, err := adminService.SignHash(
-			context.Background(),
-			&rpcpb.SignHashRequest{
-				Address: dpos.miner.String(),
-				Hash:    block.Hash(),
-				Alg:     uint32(alg),
-			})
-		conn.Close()
-		if err != nil {
-			return err
+// blockProposer return the proposer scheduled for the block by the dynasty after its parent.
+// The parent of a block received ahead of it is unknown yet, then the proposer in the
+// consensus root is used, which is checked against the parent when the block is executed.
+func (dpos *Dpos) blockProposer(block *core.Block) (byteutils.Hash, error) {
+	parent := dpos.chain.GetBlock(block.ParentHash())
+	if parent == nil {
+		if block.ConsensusRoot().Proposer == nil {
+			return nil, ErrFoundNilProposer
 		}
-		block.SetSignature(alg, resp.Data)
-		return nil
+		return block.ConsensusRoot().Proposer, nil
 	}
-	return dpos.am.SignBlock(dpos.miner, block)
-}
-
-func (dpos *Dpos) unlock(passphrase string) error {
-	if dpos.enableRemoteSignServer == false {
-		return dpos.am.Unlock(dpos.miner, []byte(passphrase), DefaultMaxUnlockDuration)
+	consensusState, err := parent.WorldState().NextConsensusState(block.Timestamp() - parent.Timestamp())
+	if err != nil {
+		return nil, err
 	}
-	return nil
-
+	if consensusState.Proposer() == nil {
+		return nil, ErrFoundNilProposer
+	}
+	return consensusState.Proposer(), nil
 }
 
-func (dpos *Dpos) newBlock(tail *core.Block, consensusState state.ConsensusState, deadlineInMs int64) (*core.Block, error) {
-	startAt := time.Now().Unix()
-	block, err := core.NewBlock(dpos.chain.ChainID(), dpos.coinbase, tail)
+// reportDoubleMint broadcast the evidence of the blocks minted in the same slot
+func (dpos *Dpos) reportDoubleMint(preBlock *core.Block, block *core.Block) {
+	evidence, err := core.NewDoubleMintEvidence(preBlock, block)
 	if err != nil {
-		logging.CLog().WithFields(logrus.Fields{
-			"tail":     tail,
-			"coinbase": dpos.coinbase,
-			"chainid":  dpos.chain.ChainID(),
+		return
+	}
+	if err := dpos.chain.EvidencePool().PushAndBroadcast(evidence); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"evidence": evidence,
 			"err":      err,
-		}).Error("Failed to create new block")
-		return nil, err
+		}).Debug("Failed to push double mint evidence.")
 	}
+}
 
-	logging.CLog().WithFields(logrus.Fields{
-		"coinbase": dpos.coinbase,
-		"reward":   core.BlockReward,
-	}).Info("Rewarded the coinbase.")
-
-	block.WorldState().SetConsensusState(consensusState)
-	block.SetTimestamp(consensusState.TimeStamp())
-	block.CollectTransactions(deadlineInMs)
-	if err = block.Seal(); err != nil {
-		logging.CLog().WithFields(logrus.Fields{
-			"block": block,
-			"err":   err,
-		}).Error("Failed to seal new block")
-		go block.ReturnTransactions()
-		return nil, err
+// submitEvidences submit the pending double mint evidences on chain to slash the offenders
+func (dpos *Dpos) submitEvidences() {
+	if !dpos.enable || dpos.miner == nil || dpos.enableRemoteSignServer {
+		return
 	}
-	if err = dpos.signBlock(block); err != nil {
-		logging.CLog().WithFields(logrus.Fields{
-			"miner": dpos.miner,
-			"block": block,
-			"err":   err,
-		}).Error("Failed to sign new block")
-		go block.ReturnTransactions()
-		return nil, err
+	pool := dpos.chain.EvidencePool()
+	evidences := pool.Pending()
+	if len(evidences) == 0 {
+		return
 	}
-	endAt := time.Now().Unix()
 
-	logging.CLog().WithFields(logrus.Fields{
-		"start": startAt,
-		"end":   endAt,
-		"diff":  endAt - startAt,
-		"block": block,
-		"txs":   len(block.Transactions()),
-	}).Info("Packed txs.")
-
-	return block, nil
-}
+	// follow the pending txs of the miner in pool.
+	nonce, err := dpos.chain.TransactionPool().PendingNonce(dpos.miner)
+	if err != nil {
+		return
+	}
+	tail := dpos.chain.TailBlock()
+	for _, evidence := range evidences {
+		offender, err := evidence.Verify(dpos.chain.ChainID(), tail.Timestamp(), tail.WorldState())
+		if err != nil || offender.Equals(dpos.miner) {
+			pool.Del(evidence)
+			continue
+		}
+		payload, err := core.NewEvidencePayload(evidence)
+		if err != nil {
+			continue
+		}
+		data, err := payload.ToBytes()
+		if err != nil {
+			continue
+		}
+		tx, err := core.NewTransaction(dpos.chain.ChainID(), dpos.miner, dpos.miner, util.NewUint128(), nonce+1, core.TxPayloadEvidenceType, data, dpos.chain.GasPrice(), EvidenceGasLimit)
+		if err != nil {
+			logging.VLog().WithFields(logrus.Fields{
+				"evidence": evidence,
+				"err":      err,
+			}).Error("Failed to create evidence transaction.")
+			continue
+		}
+		if err := dpos.am.SignTransaction(dpos.miner, tx); err != nil {
+			logging.VLog().WithFields(logrus.Fields{
+				"evidence": evidence,
+				"err":      err,
+			}).Error("Failed to sign evidence transaction.")
+			continue
+		}
+		if err := dpos.chain.TransactionPool().PushAndBroadcast(tx); err != nil {
+			logging.VLog().WithFields(logrus.Fields{
+				"evidence": evidence,
+				"tx":       tx,
+				"err":      err,
+			}).Error("Failed to push evidence transaction.")
+			continue
+		}
+		// the evidence is kept in pool to be submitted again until pushed.
+		pool.Del(evidence)
+		nonce++
 
-func lastSlot(nowInMs int64) int64 {
-	return int64((nowInMs-SecondInMs)/BlockIntervalInMs) * BlockIntervalInMs
+		logging.CLog().WithFields(logrus.Fields{
+			"evidence": evidence,
+			"offender": offender,
+			"tx":       tx.Hash(),
+		}).Info("Submitted double mint evidence.")
+	}
 }
 
-func nextSlot(nowInMs int64) int64 {
-	return int64((nowInMs+BlockIntervalInMs-SecondInMs)/BlockIntervalInMs) * BlockIntervalInMs
+func (dpos *Dpos) signBlock(block *core.Block) error {
+	return consensus.SignBlock(block, dpos.miner, dpos.am, dpos.enableRemoteSignServer, dpos.remoteSignServer)
 }
 
-func deadline(nowInMs int64) int64 {
-	nextSlotInMs := nextSlot(nowInMs)
-	remainInMs := nextSlotInMs - nowInMs
-	if MaxMintDurationInMs > remainInMs {
-		return nextSlotInMs
+func (dpos *Dpos) unlock(passphrase string) error {
+	if dpos.enableRemoteSignServer == false {
+		return dpos.am.Unlock(dpos.miner, []byte(passphrase), DefaultMaxUnlockDuration)
 	}
-	return nowInMs + MaxMintDurationInMs
+	return nil
+
 }
 
-func (dpos *Dpos) checkDeadline(tail *core.Block, nowInMs int64) (int64, error) {
-	lastSlotInMs := lastSlot(nowInMs)
-	nextSlotInMs := nextSlot(nowInMs)
+func (dpos *Dpos) newBlock(tail *core.Block, consensusState state.ConsensusState, deadlineInMs int64) (*core.Block, error) {
+	return consensus.PackBlock(dpos.chain, dpos.coinbase, tail, consensusState, deadlineInMs, dpos.signBlock)
+}
 
-	if tail.Timestamp()*SecondInMs >= nextSlotInMs {
-		return 0, ErrBlockMintedInNextSlot
-	}
-	if tail.Timestamp()*SecondInMs == lastSlotInMs {
-		return deadline(nowInMs), nil
-	}
-	if nextSlotInMs-nowInMs <= MinMintDurationInMs {
-		return deadline(nowInMs), nil
-	}
-	return 0, ErrWaitingBlockInLastSlot
+func (dpos *Dpos) checkDeadline(tail *core.Block, nowInMs int64) (int64, error) {
+	return consensus.CheckDeadline(tail, nowInMs, dposConf(dpos.chain))
 }
 
 func (dpos *Dpos) checkProposer(tail *core.Block, nowInMs int64) (state.ConsensusState, error) {
-	slotInMs := nextSlot(nowInMs)
+	slotInMs := consensus.NextSlot(nowInMs, dposConf(dpos.chain))
 	elapsedInMs := slotInMs - tail.Timestamp()*SecondInMs
 	consensusState, err := tail.WorldState().NextConsensusState(elapsedInMs / SecondInMs)
 	if err != nil {
@@ -520,24 +453,7 @@ func (dpos *Dpos) checkProposer(tail *core.Block, nowInMs int64) (state.Consensu
 }
 
 func (dpos *Dpos) pushAndBroadcast(tail *core.Block, block *core.Block) error {
-	if err := dpos.chain.BlockPool().PushAndBroadcast(block); err != nil {
-		logging.CLog().WithFields(logrus.Fields{
-			"tail":  tail,
-			"block": block,
-			"err":   err,
-		}).Error("Failed to push new minted block into block pool")
-		return err
-	}
-
-	if !dpos.chain.TailBlock().Hash().Equals(block.Hash()) {
-		return ErrAppendNewBlockFailed
-	}
-
-	logging.CLog().WithFields(logrus.Fields{
-		"tail":  tail,
-		"block": block,
-	}).Info("Broadcasted new block")
-	return nil
+	return consensus.PushAndBroadcast(dpos.chain, tail, block)
 }
 
 func (dpos *Dpos) mintBlock(now int64) error {
@@ -585,7 +501,7 @@ func (dpos *Dpos) mintBlock(now int64) error {
 		return err
 	}
 
-	slotInMs := nextSlot(nowInMs)
+	slotInMs := consensus.NextSlot(nowInMs, dposConf(dpos.chain))
 	currentInMs := time.Now().Unix() * SecondInMs
 	if slotInMs > currentInMs {
 		timer := time.NewTimer(time.Duration(slotInMs-currentInMs) * time.Millisecond).C
@@ -621,6 +537,7 @@ func (dpos *Dpos) blockLoop() {
 		select {
 		case now := <-timeChan:
 			metricsLruPoolSlotBlock.Update(int64(dpos.slot.Len()))
+			dpos.submitEvidences()
 			dpos.mintBlock(now.Unix())
 		case <-dpos.quitCh:
 			logging.CLog().Info("Stopped Dpos Mining.")
@@ -630,20 +547,14 @@ func (dpos *Dpos) blockLoop() {
 }
 
 func (dpos *Dpos) findProposer(now int64) (proposer byteutils.Hash, err error) {
-	miners, err := dpos.chain.TailBlock().WorldState().Dynasty()
-	if err != nil {
-		logging.VLog().WithFields(logrus.Fields{
-			"err": err,
-		}).Debug("Failed to get miners from dynasty.")
-		return nil, err
-	}
-	proposer, err = FindProposer(now, miners)
+	tail := dpos.chain.TailBlock()
+	consensusState, err := tail.WorldState().NextConsensusState(now - tail.Timestamp())
 	if err != nil {
 		logging.VLog().WithFields(logrus.Fields{
-			"proposer": proposer,
-			"err":      err,
-		}).Debug("Failed to find proposer.")
+			"tail": tail,
+			"err":  err,
+		}).Debug("Failed to generate next dynasty context.")
 		return nil, err
 	}
-	return proposer, nil
+	return consensusState.Proposer(), nil
 }
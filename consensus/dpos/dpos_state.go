@@ -21,7 +21,7 @@ package dpos
 import (
 	"errors"
 	"fmt"
-	"strconv"
+	"sort"
 	"time"
 
 	"github.com/nebulasio/go-nebulas/consensus/pb"
@@ -32,28 +32,22 @@ import (
 
 	"github.com/nebulasio/go-nebulas/common/trie"
 	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util"
 	"github.com/nebulasio/go-nebulas/util/byteutils"
 	"github.com/nebulasio/go-nebulas/util/logging"
 	"github.com/sirupsen/logrus"
 )
 
-// Consensus Related Constants
+// Consensus Related Constants, others are set in genesis
 const (
-	SecondInMs               = int64(1000)
-	BlockIntervalInMs        = int64(15000)
-	AcceptedNetWorkDelayInMs = int64(3750)
-	MaxMintDurationInMs      = int64(5250)
-	MinMintDurationInMs      = int64(2250)
-	DynastyIntervalInMs      = int64(3150000)
-	DynastySize              = 6 // TODO(roy): 21
-	ConsensusSize            = DynastySize*2/3 + 1
+	SecondInMs = int64(1000)
 )
 
 // Errors in dpos state
 var (
-	ErrTooFewCandidates        = errors.New("the size of candidates in consensus is un-safe, should be greater than or equal " + strconv.Itoa(ConsensusSize))
-	ErrInitialDynastyNotEnough = errors.New("the size of initial dynasty in genesis block is un-safe, should be greater than or equal " + strconv.Itoa(ConsensusSize))
-	ErrInvalidDynasty          = errors.New("the size of initial dynasty in genesis block is invalid, should be equal " + strconv.Itoa(DynastySize))
+	ErrTooFewCandidates        = errors.New("the size of candidates in consensus is un-safe, should be greater than or equal 2/3 of dynasty size")
+	ErrInitialDynastyNotEnough = errors.New("the size of initial dynasty in genesis block is un-safe, should be greater than or equal 2/3 of dynasty size")
+	ErrInvalidDynasty          = errors.New("the size of initial dynasty in genesis block is invalid, should be equal to dynasty size")
 	ErrCloneDynastyTrie        = errors.New("Failed to clone dynasty trie")
 	ErrCloneNextDynastyTrie    = errors.New("Failed to clone next dynasty trie")
 	ErrCloneDelegateTrie       = errors.New("Failed to clone delegate trie")
@@ -62,6 +56,10 @@ var (
 	ErrCloneMintCntTrie        = errors.New("Failed to clone mint count trie")
 	ErrNotBlockForgTime        = errors.New("now is not time to forg block")
 	ErrFoundNilProposer        = errors.New("found a nil proposer")
+	ErrLogoutNonCandidate      = errors.New("cannot logout a non-candidate")
+	ErrLoginSlashedCandidate   = errors.New("cannot login a slashed candidate")
+	ErrAlreadySlashed          = errors.New("the offender has been slashed")
+	ErrCloneSlashedTrie        = errors.New("Failed to clone slashed trie")
 )
 
 // State carry context in dpos consensus
@@ -69,7 +67,11 @@ type State struct {
 	timestamp int64
 	proposer  byteutils.Hash
 
-	dynastyTrie *trie.Trie // key: delegatee, val: delegatee
+	dynastyTrie    *trie.Trie // key: delegatee, val: delegatee
+	candidatesTrie *trie.Trie // key: candidate, val: candidate
+	delegateTrie   *trie.Trie // key: delegatee + delegator, val: delegator
+	voteTrie       *trie.Trie // key: delegator, val: delegatee
+	slashedTrie    *trie.Trie // key: offender, val: offender
 
 	chain     *core.BlockChain
 	consensus core.Consensus
@@ -77,20 +79,44 @@ type State struct {
 
 // NewState create a new dpos state
 func (dpos *Dpos) NewState(root *consensuspb.ConsensusRoot, stor storage.Storage, needChangeLog bool) (state.ConsensusState, error) {
-	var dynastyRoot byteutils.Hash
+	var dynastyRoot, candidatesRoot, delegateRoot, voteRoot, slashedRoot byteutils.Hash
 	if root != nil {
 		dynastyRoot = root.DynastyRoot
+		candidatesRoot = root.CandidatesRoot
+		delegateRoot = root.DelegateRoot
+		voteRoot = root.VoteRoot
+		slashedRoot = root.SlashedRoot
 	}
 	dynastyTrie, err := trie.NewTrie(dynastyRoot, stor, needChangeLog)
 	if err != nil {
 		return nil, err
 	}
+	candidatesTrie, err := trie.NewTrie(candidatesRoot, stor, needChangeLog)
+	if err != nil {
+		return nil, err
+	}
+	delegateTrie, err := trie.NewTrie(delegateRoot, stor, needChangeLog)
+	if err != nil {
+		return nil, err
+	}
+	voteTrie, err := trie.NewTrie(voteRoot, stor, needChangeLog)
+	if err != nil {
+		return nil, err
+	}
+	slashedTrie, err := trie.NewTrie(slashedRoot, stor, needChangeLog)
+	if err != nil {
+		return nil, err
+	}
 
 	return &State{
 		timestamp: root.Timestamp,
 		proposer:  root.Proposer,
 
-		dynastyTrie: dynastyTrie,
+		dynastyTrie:    dynastyTrie,
+		candidatesTrie: candidatesTrie,
+		delegateTrie:   delegateTrie,
+		voteTrie:       voteTrie,
+		slashedTrie:    slashedTrie,
 
 		chain:     dpos.chain,
 		consensus: dpos,
@@ -111,12 +137,13 @@ func (dpos *Dpos) CheckTimeout(block *core.Block) bool {
 		return false
 	}
 	behindInMs := nowInMs - blockTimeInMs
-	if behindInMs > AcceptedNetWorkDelayInMs {
+	acceptedDelayInMs := dposConf(dpos.chain).AcceptedNetworkDelayInMs
+	if behindInMs > acceptedDelayInMs {
 		logging.VLog().WithFields(logrus.Fields{
 			"block": block,
 			"now":   nowInMs,
 			"diff":  behindInMs,
-			"limit": AcceptedNetWorkDelayInMs,
+			"limit": acceptedDelayInMs,
 			"err":   "timeout - expired block",
 		}).Debug("Found a expired block.")
 		return true
@@ -130,10 +157,26 @@ func (dpos *Dpos) GenesisConsensusState(chain *core.BlockChain, conf *corepb.Gen
 	if err != nil {
 		return nil, err
 	}
-	if len(conf.Consensus.Dpos.Dynasty) < ConsensusSize {
+	candidatesTrie, err := trie.NewTrie(nil, chain.Storage(), false)
+	if err != nil {
+		return nil, err
+	}
+	delegateTrie, err := trie.NewTrie(nil, chain.Storage(), false)
+	if err != nil {
+		return nil, err
+	}
+	voteTrie, err := trie.NewTrie(nil, chain.Storage(), false)
+	if err != nil {
+		return nil, err
+	}
+	slashedTrie, err := trie.NewTrie(nil, chain.Storage(), false)
+	if err != nil {
+		return nil, err
+	}
+	if len(conf.Consensus.Dpos.Dynasty) < consensusSize(conf.Consensus.Dpos) {
 		return nil, ErrInitialDynastyNotEnough
 	}
-	if len(conf.Consensus.Dpos.Dynasty) != DynastySize {
+	if len(conf.Consensus.Dpos.Dynasty) != int(conf.Consensus.Dpos.DynastySize) {
 		return nil, ErrInvalidDynasty
 	}
 	for i := 0; i < len(conf.Consensus.Dpos.Dynasty); i++ {
@@ -146,12 +189,20 @@ func (dpos *Dpos) GenesisConsensusState(chain *core.BlockChain, conf *corepb.Gen
 		if _, err = dynastyTrie.Put(v, v); err != nil {
 			return nil, err
 		}
+		// members in the initial dynasty are candidates by default
+		if _, err = candidatesTrie.Put(v, v); err != nil {
+			return nil, err
+		}
 	}
 	return &State{
 		timestamp: core.GenesisTimestamp,
 		proposer:  nil,
 
-		dynastyTrie: dynastyTrie,
+		dynastyTrie:    dynastyTrie,
+		candidatesTrie: candidatesTrie,
+		delegateTrie:   delegateTrie,
+		voteTrie:       voteTrie,
+		slashedTrie:    slashedTrie,
 
 		chain:     chain,
 		consensus: dpos,
@@ -163,10 +214,14 @@ func (ds *State) String() string {
 	if ds.proposer != nil {
 		proposer = ds.proposer.String()
 	}
-	return fmt.Sprintf(`{"timestamp": %d, "proposer": "%s", "dynasty": "%s"}`,
+	return fmt.Sprintf(`{"timestamp": %d, "proposer": "%s", "dynasty": "%s", "candidates": "%s", "delegate": "%s", "vote": "%s", "slashed": "%s"}`,
 		ds.timestamp,
 		proposer,
 		byteutils.Hex(ds.dynastyTrie.RootHash()),
+		byteutils.Hex(ds.candidatesTrie.RootHash()),
+		byteutils.Hex(ds.delegateTrie.RootHash()),
+		byteutils.Hex(ds.voteTrie.RootHash()),
+		byteutils.Hex(ds.slashedTrie.RootHash()),
 	)
 }
 
@@ -176,6 +231,18 @@ func (ds *State) Replay(done state.ConsensusState) error {
 	if _, err := ds.dynastyTrie.Replay(state.dynastyTrie); err != nil {
 		return err
 	}
+	if _, err := ds.candidatesTrie.Replay(state.candidatesTrie); err != nil {
+		return err
+	}
+	if _, err := ds.delegateTrie.Replay(state.delegateTrie); err != nil {
+		return err
+	}
+	if _, err := ds.voteTrie.Replay(state.voteTrie); err != nil {
+		return err
+	}
+	if _, err := ds.slashedTrie.Replay(state.slashedTrie); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -186,11 +253,31 @@ func (ds *State) Clone() (state.ConsensusState, error) {
 	if err != nil {
 		return nil, ErrCloneDynastyTrie
 	}
+	candidatesTrie, err := ds.candidatesTrie.Clone()
+	if err != nil {
+		return nil, ErrCloneCandidatesTrie
+	}
+	delegateTrie, err := ds.delegateTrie.Clone()
+	if err != nil {
+		return nil, ErrCloneDelegateTrie
+	}
+	voteTrie, err := ds.voteTrie.Clone()
+	if err != nil {
+		return nil, ErrCloneVoteTrie
+	}
+	slashedTrie, err := ds.slashedTrie.Clone()
+	if err != nil {
+		return nil, ErrCloneSlashedTrie
+	}
 	return &State{
 		timestamp: ds.timestamp,
 		proposer:  ds.proposer,
 
-		dynastyTrie: dynastyTrie,
+		dynastyTrie:    dynastyTrie,
+		candidatesTrie: candidatesTrie,
+		delegateTrie:   delegateTrie,
+		voteTrie:       voteTrie,
+		slashedTrie:    slashedTrie,
 
 		chain:     ds.chain,
 		consensus: ds.consensus,
@@ -200,9 +287,13 @@ func (ds *State) Clone() (state.ConsensusState, error) {
 // RootHash hash dpos state
 func (ds *State) RootHash() *consensuspb.ConsensusRoot {
 	return &consensuspb.ConsensusRoot{
-		DynastyRoot: ds.dynastyTrie.RootHash(),
-		Timestamp:   ds.TimeStamp(),
-		Proposer:    ds.Proposer(),
+		DynastyRoot:    ds.dynastyTrie.RootHash(),
+		CandidatesRoot: ds.candidatesTrie.RootHash(),
+		DelegateRoot:   ds.delegateTrie.RootHash(),
+		VoteRoot:       ds.voteTrie.RootHash(),
+		SlashedRoot:    ds.slashedTrie.RootHash(),
+		Timestamp:      ds.TimeStamp(),
+		Proposer:       ds.Proposer(),
 	}
 }
 
@@ -217,14 +308,17 @@ func (ds *State) DynastyRoot() byteutils.Hash {
 }
 
 // FindProposer for now in given dynasty
-func FindProposer(now int64, miners []byteutils.Hash) (proposer byteutils.Hash, err error) {
+func FindProposer(now int64, miners []byteutils.Hash, conf *corepb.GenesisConsensusDpos) (proposer byteutils.Hash, err error) {
 	nowInMs := now * SecondInMs
-	offsetInMs := nowInMs % DynastyIntervalInMs
-	if (offsetInMs % BlockIntervalInMs) != 0 {
+	offsetInMs := nowInMs % conf.DynastyIntervalInMs
+	if (offsetInMs % conf.BlockIntervalInMs) != 0 {
 		return nil, ErrNotBlockForgTime
 	}
-	offset := offsetInMs / BlockIntervalInMs
-	offset %= DynastySize
+	offset := offsetInMs / conf.BlockIntervalInMs
+	if len(miners) > 0 {
+		// slots rotate over the kept members when some are slashed
+		offset %= int64(len(miners))
+	}
 
 	if int(offset) < len(miners) {
 		proposer = miners[offset]
@@ -249,22 +343,359 @@ func (ds *State) TimeStamp() int64 {
 	return ds.timestamp
 }
 
+// Candidates return all registered candidates
+func (ds *State) Candidates() ([]byteutils.Hash, error) {
+	return TraverseDynasty(ds.candidatesTrie)
+}
+
+// LoginCandidate register the address as a candidate for the coming dynasties
+func (ds *State) LoginCandidate(candidate byteutils.Hash) error {
+	slashed, err := ds.isSlashed(candidate)
+	if err != nil {
+		return err
+	}
+	if slashed {
+		return ErrLoginSlashedCandidate
+	}
+	if _, err := ds.candidatesTrie.Put(candidate, candidate); err != nil {
+		return err
+	}
+	return nil
+}
+
+// LogoutCandidate remove the address from candidates, the votes on it are kept
+func (ds *State) LogoutCandidate(candidate byteutils.Hash) error {
+	if _, err := ds.candidatesTrie.Get(candidate); err != nil {
+		if err == storage.ErrKeyNotFound {
+			return ErrLogoutNonCandidate
+		}
+		return err
+	}
+	if _, err := ds.candidatesTrie.Del(candidate); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Slash punish the offender, who is removed from candidates and can never login again
+func (ds *State) Slash(offender byteutils.Hash) error {
+	slashed, err := ds.isSlashed(offender)
+	if err != nil {
+		return err
+	}
+	if slashed {
+		return ErrAlreadySlashed
+	}
+	if _, err := ds.slashedTrie.Put(offender, offender); err != nil {
+		return err
+	}
+	_, err = ds.candidatesTrie.Get(offender)
+	if err != nil && err != storage.ErrKeyNotFound {
+		return err
+	}
+	if err == nil {
+		if _, err := ds.candidatesTrie.Del(offender); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// VerifyProposer check the proposer is the miner scheduled at the timestamp of root in its dynasty
+func (ds *State) VerifyProposer(root *consensuspb.ConsensusRoot, proposer byteutils.Hash) error {
+	if root == nil || !proposer.Equals(root.Proposer) {
+		return ErrInvalidBlockProposer
+	}
+	// the dynasty trie of a root never built by the chain is not in storage.
+	dynastyTrie, err := trie.NewTrie(root.DynastyRoot, ds.chain.Storage(), false)
+	if err != nil {
+		return err
+	}
+	miners, err := TraverseDynasty(dynastyTrie)
+	if err != nil {
+		return err
+	}
+	scheduled, err := FindProposer(root.Timestamp, miners, dposConf(ds.chain))
+	if err != nil {
+		return err
+	}
+	if !scheduled.Equals(proposer) {
+		return ErrInvalidBlockProposer
+	}
+	return nil
+}
+
+// EvidenceSince return the start of the dynasty interval before the one of now.
+// Only the dynasties of the current and previous intervals are kept by the pruner,
+// the evidences of earlier slots cannot be verified on every node.
+func (ds *State) EvidenceSince(now int64) int64 {
+	interval := dposConf(ds.chain).DynastyIntervalInMs
+	sinceInMs := (now*SecondInMs/interval - 1) * interval
+	if sinceInMs <= 0 {
+		return 0
+	}
+	return (sinceInMs + SecondInMs - 1) / SecondInMs
+}
+
+func (ds *State) isSlashed(addr byteutils.Hash) (bool, error) {
+	_, err := ds.slashedTrie.Get(addr)
+	if err != nil && err != storage.ErrKeyNotFound {
+		return false, err
+	}
+	return err == nil, nil
+}
+
+// Delegate vote the delegatee by the delegator, the previous vote of the delegator is replaced
+func (ds *State) Delegate(delegator byteutils.Hash, delegatee byteutils.Hash) error {
+	if _, err := ds.candidatesTrie.Get(delegatee); err != nil {
+		if err == storage.ErrKeyNotFound {
+			return core.ErrInvalidDelegateToNonCandidate
+		}
+		return err
+	}
+	preDelegatee, err := ds.voteTrie.Get(delegator)
+	if err != nil && err != storage.ErrKeyNotFound {
+		return err
+	}
+	if err == nil {
+		if _, err := ds.delegateTrie.Del(delegateKey(preDelegatee, delegator)); err != nil {
+			return err
+		}
+	}
+	if _, err := ds.delegateTrie.Put(delegateKey(delegatee, delegator), delegator); err != nil {
+		return err
+	}
+	if _, err := ds.voteTrie.Put(delegator, delegatee); err != nil {
+		return err
+	}
+	return nil
+}
+
+// UnDelegate cancel the vote on the delegatee by the delegator
+func (ds *State) UnDelegate(delegator byteutils.Hash, delegatee byteutils.Hash) error {
+	preDelegatee, err := ds.voteTrie.Get(delegator)
+	if err != nil && err != storage.ErrKeyNotFound {
+		return err
+	}
+	if err == storage.ErrKeyNotFound || !byteutils.Hash(preDelegatee).Equals(delegatee) {
+		return core.ErrInvalidUnDelegateFromNonDelegatee
+	}
+	if _, err := ds.delegateTrie.Del(delegateKey(delegatee, delegator)); err != nil {
+		return err
+	}
+	if _, err := ds.voteTrie.Del(delegator); err != nil {
+		return err
+	}
+	return nil
+}
+
+func delegateKey(delegatee byteutils.Hash, delegator byteutils.Hash) []byte {
+	key := make([]byte, 0, len(delegatee)+len(delegator))
+	key = append(key, delegatee...)
+	return append(key, delegator...)
+}
+
+// CountVotes return the number of delegators voting the delegatee
+func CountVotes(delegateTrie *trie.Trie, delegatee byteutils.Hash) (int, error) {
+	iter, err := delegateTrie.Iterator(delegatee)
+	if err != nil && err != storage.ErrKeyNotFound {
+		return 0, err
+	}
+	if err != nil {
+		return 0, nil
+	}
+	votes := 0
+	exist, err := iter.Next()
+	for exist {
+		votes++
+		exist, err = iter.Next()
+	}
+	if err != nil {
+		return 0, err
+	}
+	return votes, nil
+}
+
+// CountStake return the sum of the balances of the delegators voting the delegatee.
+// The accounts are read from the given world state, which should be a copy
+// since reading a missing account creates it.
+func CountStake(delegateTrie *trie.Trie, delegatee byteutils.Hash, worldState state.WorldState) (*util.Uint128, error) {
+	stake := util.NewUint128()
+	iter, err := delegateTrie.Iterator(delegatee)
+	if err != nil && err != storage.ErrKeyNotFound {
+		return nil, err
+	}
+	if err != nil {
+		return stake, nil
+	}
+	exist, err := iter.Next()
+	for exist {
+		acc, err := worldState.GetOrCreateUserAccount(iter.Value())
+		if err != nil {
+			return nil, err
+		}
+		stake, err = stake.Add(acc.Balance())
+		if err != nil {
+			return nil, err
+		}
+		exist, err = iter.Next()
+	}
+	if err != nil {
+		return nil, err
+	}
+	return stake, nil
+}
+
+type candidateVotes struct {
+	candidate byteutils.Hash
+	stake     *util.Uint128
+}
+
+// ElectDynasty return the candidates with the most stake voted as the next dynasty,
+// each vote weighs the balance of its delegator in the world state.
+// Candidates with equal stake are ordered by address.
+func ElectDynasty(candidatesTrie *trie.Trie, delegateTrie *trie.Trie, worldState state.WorldState, conf *corepb.GenesisConsensusDpos) ([]byteutils.Hash, error) {
+	candidates, err := TraverseDynasty(candidatesTrie)
+	if err != nil {
+		return nil, err
+	}
+	if len(candidates) < consensusSize(conf) {
+		return nil, ErrTooFewCandidates
+	}
+
+	// read accounts in a copy, keep the given world state untouched
+	ws, err := worldState.Clone()
+	if err != nil {
+		return nil, err
+	}
+	ranks := make([]*candidateVotes, 0, len(candidates))
+	for _, candidate := range candidates {
+		stake, err := CountStake(delegateTrie, candidate, ws)
+		if err != nil {
+			return nil, err
+		}
+		ranks = append(ranks, &candidateVotes{candidate: candidate, stake: stake})
+	}
+	sort.Slice(ranks, func(i, j int) bool {
+		if cmp := ranks[i].stake.Cmp(ranks[j].stake); cmp != 0 {
+			return cmp > 0
+		}
+		return byteutils.Less(ranks[i].candidate, ranks[j].candidate)
+	})
+
+	if len(ranks) > int(conf.DynastySize) {
+		ranks = ranks[:conf.DynastySize]
+	}
+	members := []byteutils.Hash{}
+	for _, rank := range ranks {
+		members = append(members, rank.candidate)
+	}
+	return members, nil
+}
+
+func (ds *State) electNextDynasty(worldState state.WorldState) (*trie.Trie, error) {
+	members, err := ElectDynasty(ds.candidatesTrie, ds.delegateTrie, worldState, dposConf(ds.chain))
+	if err != nil {
+		return nil, err
+	}
+	dynastyTrie, err := ds.dynastyTrie.Clone()
+	if err != nil {
+		return nil, err
+	}
+	// clear the previous dynasty before putting the elected members
+	previous, err := TraverseDynasty(dynastyTrie)
+	if err != nil {
+		return nil, err
+	}
+	for _, member := range previous {
+		if _, err := dynastyTrie.Del(member); err != nil {
+			return nil, err
+		}
+	}
+	for _, member := range members {
+		if _, err := dynastyTrie.Put(member, member); err != nil {
+			return nil, err
+		}
+	}
+	return dynastyTrie, nil
+}
+
+// removeSlashed remove the slashed members from the kept dynasty
+func (ds *State) removeSlashed(dynastyTrie *trie.Trie) error {
+	members, err := TraverseDynasty(dynastyTrie)
+	if err != nil {
+		return err
+	}
+	for _, member := range members {
+		slashed, err := ds.isSlashed(member)
+		if err != nil {
+			return err
+		}
+		if slashed {
+			if _, err := dynastyTrie.Del(member); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 // NextConsensusState return the new state after some seconds elapsed
 func (ds *State) NextConsensusState(elapsedSecond int64, worldState state.WorldState) (state.ConsensusState, error) {
+	conf := dposConf(ds.chain)
 	elapsedSecondInMs := elapsedSecond * SecondInMs
-	if elapsedSecondInMs%BlockIntervalInMs != 0 {
+	if elapsedSecondInMs%conf.BlockIntervalInMs != 0 {
 		return nil, ErrNotBlockForgTime
 	}
 
+	timestamp := ds.timestamp + elapsedSecond
 	dynastyTrie, err := ds.dynastyTrie.Clone()
 	if err != nil {
 		return nil, err
 	}
+	// re-elect the dynasty when entering a new dynasty interval
+	if (ds.timestamp*SecondInMs)/conf.DynastyIntervalInMs != (timestamp*SecondInMs)/conf.DynastyIntervalInMs {
+		elected, err := ds.electNextDynasty(worldState)
+		if err != nil && err != ErrTooFewCandidates {
+			return nil, err
+		}
+		if err == ErrTooFewCandidates {
+			logging.VLog().WithFields(logrus.Fields{
+				"timestamp": timestamp,
+				"err":       err,
+			}).Warn("Too few candidates, keep the current dynasty.")
+			if err := ds.removeSlashed(dynastyTrie); err != nil {
+				return nil, err
+			}
+		} else {
+			dynastyTrie = elected
+		}
+	}
+	candidatesTrie, err := ds.candidatesTrie.Clone()
+	if err != nil {
+		return nil, err
+	}
+	delegateTrie, err := ds.delegateTrie.Clone()
+	if err != nil {
+		return nil, err
+	}
+	voteTrie, err := ds.voteTrie.Clone()
+	if err != nil {
+		return nil, err
+	}
+	slashedTrie, err := ds.slashedTrie.Clone()
+	if err != nil {
+		return nil, err
+	}
 
 	consensusState := &State{
-		timestamp: ds.timestamp + elapsedSecond,
+		timestamp: timestamp,
 
-		dynastyTrie: dynastyTrie,
+		dynastyTrie:    dynastyTrie,
+		candidatesTrie: candidatesTrie,
+		delegateTrie:   delegateTrie,
+		voteTrie:       voteTrie,
+		slashedTrie:    slashedTrie,
 
 		chain:     ds.chain,
 		consensus: ds.consensus,
@@ -274,13 +705,23 @@ func (ds *State) NextConsensusState(elapsedSecond int64, worldState state.WorldS
 	if err != nil {
 		return nil, err
 	}
-	consensusState.proposer, err = FindProposer(consensusState.timestamp, miners)
+	consensusState.proposer, err = FindProposer(consensusState.timestamp, miners, conf)
 	if err != nil {
 		return nil, err
 	}
 	return consensusState, nil
 }
 
+// dposConf return the dpos parameters of the chain, which are set in genesis
+func dposConf(chain *core.BlockChain) *corepb.GenesisConsensusDpos {
+	return chain.Genesis().Consensus.Dpos
+}
+
+// consensusSize return the min number of miners to reach consensus
+func consensusSize(conf *corepb.GenesisConsensusDpos) int {
+	return int(conf.DynastySize)*2/3 + 1
+}
+
 // TraverseDynasty return all members in the dynasty
 func TraverseDynasty(dynasty *trie.Trie) ([]byteutils.Hash, error) {
 	members := []byteutils.Hash{}
@@ -24,12 +24,14 @@ import (
 	"github.com/nebulasio/go-nebulas/consensus/pb"
 
 	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/state"
 	"github.com/nebulasio/go-nebulas/crypto/keystore"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/nebulasio/go-nebulas/common/trie"
 	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util"
 	"github.com/nebulasio/go-nebulas/util/byteutils"
 )
 
@@ -167,3 +169,143 @@ func TestCheckGenesisAndDBConsense(t *testing.T) {
 	assert.Equal(t, err, core.ErrGenesisNotEqualTokenLenInDB)
 
 }
+
+func TestElectDynasty(t *testing.T) {
+	stor, err := storage.NewMemoryStorage()
+	assert.Nil(t, err)
+	candidatesTrie, err := trie.NewTrie(nil, stor, false)
+	assert.Nil(t, err)
+	delegateTrie, err := trie.NewTrie(nil, stor, false)
+	assert.Nil(t, err)
+
+	ws, err := state.NewWorldState(NewDpos(), stor)
+	assert.Nil(t, err)
+
+	conf := MockGenesisConf().Consensus.Dpos
+	_, err = ElectDynasty(candidatesTrie, delegateTrie, ws, conf)
+	assert.Equal(t, err, ErrTooFewCandidates)
+
+	candidates := []byteutils.Hash{}
+	for i := 0; i < DynastySize+1; i++ {
+		candidate := byteutils.Hash{byte(i + 1)}
+		candidates = append(candidates, candidate)
+		_, err = candidatesTrie.Put(candidate, candidate)
+		assert.Nil(t, err)
+	}
+
+	// the last candidate gets two votes, the second last one gets one vote with more stake,
+	// the vote of a delegator without balance weighs nothing.
+	last, secondLast := candidates[DynastySize], candidates[DynastySize-1]
+	stakes := map[byte]uint64{0xa1: 1, 0xa2: 1, 0xa3: 5}
+	for delegator, stake := range stakes {
+		acc, err := ws.GetOrCreateUserAccount(byteutils.Hash{delegator})
+		assert.Nil(t, err)
+		assert.Nil(t, acc.AddBalance(util.NewUint128FromUint(stake)))
+	}
+	_, err = delegateTrie.Put(delegateKey(last, []byte{0xa1}), []byte{0xa1})
+	assert.Nil(t, err)
+	_, err = delegateTrie.Put(delegateKey(last, []byte{0xa2}), []byte{0xa2})
+	assert.Nil(t, err)
+	_, err = delegateTrie.Put(delegateKey(secondLast, []byte{0xa3}), []byte{0xa3})
+	assert.Nil(t, err)
+	_, err = delegateTrie.Put(delegateKey(candidates[DynastySize-2], []byte{0xa4}), []byte{0xa4})
+	assert.Nil(t, err)
+
+	votes, err := CountVotes(delegateTrie, last)
+	assert.Nil(t, err)
+	assert.Equal(t, votes, 2)
+	stake, err := CountStake(delegateTrie, last, ws)
+	assert.Nil(t, err)
+	assert.Equal(t, stake.Uint64(), uint64(2))
+
+	members, err := ElectDynasty(candidatesTrie, delegateTrie, ws, conf)
+	assert.Nil(t, err)
+	assert.Equal(t, len(members), DynastySize)
+	assert.Equal(t, members[0], secondLast)
+	assert.Equal(t, members[1], last)
+	assert.Equal(t, members[2], candidates[0])
+	for _, member := range members {
+		assert.NotEqual(t, member, candidates[DynastySize-2])
+	}
+}
+
+func TestState_Delegate(t *testing.T) {
+	neb := mockNeb(t)
+	block := neb.chain.GenesisBlock()
+
+	consensusState, err := block.WorldState().NextConsensusState(BlockIntervalInMs / SecondInMs)
+	assert.Nil(t, err)
+	ds := consensusState.(*State)
+
+	miners, err := ds.Dynasty()
+	assert.Nil(t, err)
+	delegator := byteutils.Hash{0x01}
+	nonCandidate := byteutils.Hash{0x02}
+
+	assert.Equal(t, ds.Delegate(delegator, nonCandidate), core.ErrInvalidDelegateToNonCandidate)
+	assert.Nil(t, ds.Delegate(delegator, miners[0]))
+	votes, err := CountVotes(ds.delegateTrie, miners[0])
+	assert.Nil(t, err)
+	assert.Equal(t, votes, 1)
+
+	// vote another delegatee, the previous vote is moved
+	assert.Nil(t, ds.Delegate(delegator, miners[1]))
+	votes, err = CountVotes(ds.delegateTrie, miners[0])
+	assert.Nil(t, err)
+	assert.Equal(t, votes, 0)
+	votes, err = CountVotes(ds.delegateTrie, miners[1])
+	assert.Nil(t, err)
+	assert.Equal(t, votes, 1)
+
+	assert.Equal(t, ds.UnDelegate(delegator, miners[0]), core.ErrInvalidUnDelegateFromNonDelegatee)
+	assert.Nil(t, ds.UnDelegate(delegator, miners[1]))
+	votes, err = CountVotes(ds.delegateTrie, miners[1])
+	assert.Nil(t, err)
+	assert.Equal(t, votes, 0)
+
+	assert.Equal(t, ds.LogoutCandidate(nonCandidate), ErrLogoutNonCandidate)
+	assert.Nil(t, ds.LoginCandidate(nonCandidate))
+	assert.Nil(t, ds.Delegate(delegator, nonCandidate))
+	assert.Nil(t, ds.LogoutCandidate(nonCandidate))
+	candidates, err := ds.Candidates()
+	assert.Nil(t, err)
+	assert.Equal(t, len(candidates), len(miners))
+}
+
+func TestState_Slash(t *testing.T) {
+	neb := mockNeb(t)
+	block := neb.chain.GenesisBlock()
+
+	consensusState, err := block.WorldState().NextConsensusState(BlockIntervalInMs / SecondInMs)
+	assert.Nil(t, err)
+	ds := consensusState.(*State)
+
+	miners, err := ds.Dynasty()
+	assert.Nil(t, err)
+	offender := miners[0]
+
+	assert.Nil(t, ds.Slash(offender))
+	assert.Equal(t, ds.Slash(offender), ErrAlreadySlashed)
+	assert.Equal(t, ds.LoginCandidate(offender), ErrLoginSlashedCandidate)
+	assert.Equal(t, ds.Delegate(byteutils.Hash{0x01}, offender), core.ErrInvalidDelegateToNonCandidate)
+	candidates, err := ds.Candidates()
+	assert.Nil(t, err)
+	assert.Equal(t, len(candidates), len(miners)-1)
+
+	// the offender is excluded from the kept dynasty
+	dynastyTrie, err := ds.dynastyTrie.Clone()
+	assert.Nil(t, err)
+	assert.Nil(t, ds.removeSlashed(dynastyTrie))
+	members, err := TraverseDynasty(dynastyTrie)
+	assert.Nil(t, err)
+	assert.Equal(t, len(members), len(miners)-1)
+	for _, member := range members {
+		assert.NotEqual(t, member, offender)
+	}
+
+	// the slashed record survives cloning and root loading
+	cloned, err := ds.Clone()
+	assert.Nil(t, err)
+	assert.Equal(t, cloned.(*State).Slash(offender), ErrAlreadySlashed)
+	assert.Equal(t, ds.RootHash().SlashedRoot, cloned.RootHash().SlashedRoot)
+}
@@ -29,6 +29,7 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/nebulasio/go-nebulas/account"
+	"github.com/nebulasio/go-nebulas/consensus"
 	"github.com/nebulasio/go-nebulas/core"
 	"github.com/nebulasio/go-nebulas/core/pb"
 	"github.com/nebulasio/go-nebulas/crypto/keystore"
@@ -36,6 +37,7 @@ import (
 	"github.com/nebulasio/go-nebulas/net"
 	"github.com/nebulasio/go-nebulas/nf/nvm"
 	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -136,6 +138,14 @@ func (n *Neb) Nvm() core.NVM {
 	return n.nvm
 }
 
+func (n *Neb) Nr() core.NR {
+	return nil
+}
+
+func (n *Neb) Dip() core.Dip {
+	return nil
+}
+
 func (n *Neb) StartActiveSync() {}
 
 func (n *Neb) StartPprof(string) error { return nil }
@@ -144,6 +154,13 @@ func (n *Neb) SetGenesis(genesis *corepb.Genesis) {
 	n.genesis = genesis
 }
 
+// consensus parameters in the mock genesis
+const (
+	BlockIntervalInMs   = int64(15000)
+	DynastyIntervalInMs = int64(3150000)
+	DynastySize         = 6
+)
+
 var (
 	DefaultOpenDynasty = []string{
 		"n1FkntVUMPAsESuCAAPK711omQk19JotBjM",
@@ -165,7 +182,10 @@ func MockGenesisConf() *corepb.Genesis {
 		Meta: &corepb.GenesisMeta{ChainId: 0},
 		Consensus: &corepb.GenesisConsensus{
 			Dpos: &corepb.GenesisConsensusDpos{
-				Dynasty: dynasty,
+				Dynasty:             dynasty,
+				BlockIntervalInMs:   BlockIntervalInMs,
+				DynastyIntervalInMs: DynastyIntervalInMs,
+				DynastySize:         DynastySize,
 			},
 		},
 		TokenDistribution: []*corepb.GenesisTokenDistribution{
@@ -342,7 +362,7 @@ func TestForkChoice(t *testing.T) {
 	assert.Nil(t, neb.chain.BlockPool().Push(block12))
 	assert.Equal(t, len(neb.chain.DetachedTailBlocks()), 2)
 	tail := block11.Hash()
-	if less(block11, block12) {
+	if consensus.Less(block11, block12) {
 		tail = block12.Hash()
 	}
 	assert.Equal(t, neb.chain.TailBlock().Hash(), tail)
@@ -413,6 +433,74 @@ func TestVerifyBlock(t *testing.T) {
 	assert.Nil(t, dpos.VerifyBlock(block))
 }
 
+func TestVerifyBlock_AcrossElection(t *testing.T) {
+	neb := mockNeb(t)
+	dpos := neb.consensus
+	manager, _ := account.NewManager(nil)
+	genesis := neb.chain.TailBlock()
+	miners, err := genesis.WorldState().Dynasty()
+	assert.Nil(t, err)
+	unlock := func(addr []byte) *core.Address {
+		miner, err := core.AddressParseFromBytes(addr)
+		assert.Nil(t, err)
+		assert.Nil(t, manager.Unlock(miner, []byte("passphrase"), keystore.YearUnlockDuration))
+		return miner
+	}
+
+	// the first miner logs out, and leaves the dynasty elected in the next interval.
+	leaving := unlock(miners[0])
+	logout, err := core.NewCandidatePayload(core.LogoutAction)
+	assert.Nil(t, err)
+	payload, err := logout.ToBytes()
+	assert.Nil(t, err)
+	gasLimit, _ := util.NewUint128FromInt(200000)
+	tx, err := core.NewTransaction(neb.chain.ChainID(), leaving, leaving, util.NewUint128(), 1, core.TxPayloadCandidateType, payload, core.TransactionGasPrice, gasLimit)
+	assert.Nil(t, err)
+	assert.Nil(t, manager.SignTransaction(leaving, tx))
+	assert.Nil(t, neb.chain.TransactionPool().Push(tx))
+
+	consensusState, err := genesis.WorldState().NextConsensusState(BlockIntervalInMs / SecondInMs)
+	assert.Nil(t, err)
+	proposer := unlock(consensusState.Proposer())
+	block, err := core.NewBlock(neb.chain.ChainID(), proposer, genesis)
+	assert.Nil(t, err)
+	block.WorldState().SetConsensusState(consensusState)
+	block.SetTimestamp(consensusState.TimeStamp())
+	block.CollectTransactions((time.Now().Unix() + 1) * SecondInMs)
+	assert.Equal(t, 1, len(block.Transactions()))
+	assert.Nil(t, block.Seal())
+	assert.Nil(t, manager.SignBlock(proposer, block))
+	assert.Nil(t, neb.chain.BlockPool().Push(block))
+	tail := neb.chain.TailBlock()
+	assert.Equal(t, block.Hash(), tail.Hash())
+
+	// the second slot of the next interval belongs to another miner after the election.
+	timestamp := (DynastyIntervalInMs + BlockIntervalInMs) / SecondInMs
+	consensusState, err = tail.WorldState().NextConsensusState(timestamp - tail.Timestamp())
+	assert.Nil(t, err)
+	elected, err := consensusState.Dynasty()
+	assert.Nil(t, err)
+	assert.Equal(t, len(elected), len(miners)-1)
+	previous, err := FindProposer(timestamp, miners, dposConf(neb.chain))
+	assert.Nil(t, err)
+	assert.NotEqual(t, previous, consensusState.Proposer())
+
+	for _, signer := range []byteutils.Hash{previous, consensusState.Proposer()} {
+		miner := unlock(signer)
+		block, err := core.NewBlock(neb.chain.ChainID(), miner, tail)
+		assert.Nil(t, err)
+		block.WorldState().SetConsensusState(consensusState)
+		block.SetTimestamp(timestamp)
+		assert.Nil(t, block.Seal())
+		assert.Nil(t, manager.SignBlock(miner, block))
+		if signer.Equals(previous) {
+			assert.Equal(t, dpos.VerifyBlock(block), ErrInvalidBlockProposer)
+		} else {
+			assert.Nil(t, dpos.VerifyBlock(block))
+		}
+	}
+}
+
 func TestDpos_MintBlock(t *testing.T) {
 	neb := mockNeb(t)
 	dpos := neb.consensus.(*Dpos)
@@ -465,7 +553,7 @@ func TestDposContracts(t *testing.T) {
 	source := `"use strict";var DepositeContent=function(text){if(text){var o=JSON.parse(text);this.balance=new BigNumber(o.balance);this.expiryHeight=new BigNumber(o.expiryHeight)}else{this.balance=new BigNumber(0);this.expiryHeight=new BigNumber(0)}};DepositeContent.prototype={toString:function(){return JSON.stringify(this)}};var BankVaultContract=function(){LocalContractStorage.defineMapProperty(this,"bankVault",{parse:function(text){return new DepositeContent(text)},stringify:function(o){return o.toString()}})};BankVaultContract.prototype={init:function(){},save:function(height){var from=Blockchain.transaction.from;var value=Blockchain.transaction.value;var bk_height=new BigNumber(Blockchain.block.height);var orig_deposit=this.bankVault.get(from);if(orig_deposit){value=value.plus(orig_deposit.balance)}var deposit=new DepositeContent();deposit.balance=value;deposit.expiryHeight=bk_height.plus(height);this.bankVault.put(from,deposit)},takeout:function(value){var from=Blockchain.transaction.from;var bk_height=new BigNumber(Blockchain.block.height);var amount=new BigNumber(value);var deposit=this.bankVault.get(from);if(!deposit){throw new Error("No deposit before.")}if(bk_height.lt(deposit.expiryHeight)){throw new Error("Can not takeout before expiryHeight.")}if(amount.gt(deposit.balance)){throw new Error("Insufficient balance.")}var result=Blockchain.transfer(from,amount);if(result!=0){throw new Error("transfer failed.")}Event.Trigger("BankVault",{Transfer:{from:Blockchain.transaction.to,to:from,value:amount.toString()}});deposit.balance=deposit.balance.sub(amount);this.bankVault.put(from,deposit)},balanceOf:function(){var from=Blockchain.transaction.from;return this.bankVault.get(from)}};module.exports=BankVaultContract;`
 	sourceType := "js"
 	argsDeploy := ""
-	deploy, _ := core.NewDeployPayload(source, sourceType, argsDeploy)
+	deploy, _ := core.NewDeployPayload(source, sourceType, argsDeploy, "", false)
 	payloadDeploy, _ := deploy.ToBytes()
 
 	j := 2
@@ -606,4 +694,104 @@ func TestDoubleMint(t *testing.T) {
 	block11.Seal()
 	am.SignBlock(addr0, block11)
 	assert.Equal(t, chain.BlockPool().Push(block11), ErrDoubleBlockMinted)
+
+	// the double mint is reported as an evidence against the miner
+	evidences := chain.EvidencePool().Pending()
+	assert.Equal(t, len(evidences), 1)
+	offender, err := evidences[0].Verify(chain.ChainID(), chain.TailBlock().Timestamp(), chain.TailBlock().WorldState())
+	assert.Nil(t, err)
+	assert.Equal(t, offender, addr0)
+
+	// only the proposer scheduled in the slot can be slashed, not other miners in dynasty.
+	other, _ := core.AddressParse("n1Kjom3J4KPsHKKzZ2xtt8Lc9W5pRDjeLcW")
+	root := *block11.ConsensusRoot()
+	assert.Equal(t, ErrInvalidBlockProposer, chain.TailBlock().WorldState().VerifyProposer(&root, other.Bytes()))
+	root.Proposer = other.Bytes()
+	assert.Equal(t, ErrInvalidBlockProposer, chain.TailBlock().WorldState().VerifyProposer(&root, other.Bytes()))
+
+	data, err := evidences[0].ToBytes()
+	assert.Nil(t, err)
+	evidence, err := core.LoadDoubleMintEvidence(data)
+	assert.Nil(t, err)
+	assert.Equal(t, evidence.Hash(), evidences[0].Hash())
+	assert.Equal(t, chain.EvidencePool().Push(evidence), core.ErrDuplicatedEvidence)
+}
+
+// newSlotBlock create a block on parent at the timestamp signed by the scheduled proposer,
+// the coinbase tells blocks in the same slot apart.
+func newSlotBlock(t *testing.T, neb *Neb, manager *account.Manager, parent *core.Block, coinbase *core.Address, timestamp int64) *core.Block {
+	consensusState, err := parent.WorldState().NextConsensusState(timestamp - parent.Timestamp())
+	assert.Nil(t, err)
+	proposer, err := core.AddressParseFromBytes(consensusState.Proposer())
+	assert.Nil(t, err)
+	assert.Nil(t, manager.Unlock(proposer, []byte("passphrase"), keystore.YearUnlockDuration))
+	if coinbase == nil {
+		coinbase = proposer
+	}
+	block, err := core.NewBlock(neb.chain.ChainID(), coinbase, parent)
+	assert.Nil(t, err)
+	block.WorldState().SetConsensusState(consensusState)
+	block.SetTimestamp(timestamp)
+	assert.Nil(t, block.Seal())
+	assert.Nil(t, manager.SignBlock(proposer, block))
+	return block
+}
+
+func TestEvidencePayload_Pruned(t *testing.T) {
+	neb := mockNeb(t)
+	chain := neb.chain
+	manager, _ := account.NewManager(nil)
+	// the outsider is not a miner, it tells the blocks in the same slot apart and reports them.
+	outsider, _ := core.AddressParse("n1NHcbEus81PJxybnyg4aJgHAaSLDx9Vtf8")
+
+	// double mint in the first slot, which is two dynasty intervals before the tail.
+	genesis := chain.TailBlock()
+	interval := DynastyIntervalInMs / SecondInMs
+	slot := BlockIntervalInMs / SecondInMs
+	first := newSlotBlock(t, neb, manager, genesis, nil, slot)
+	assert.Nil(t, chain.BlockPool().Push(first))
+	expired, err := core.NewDoubleMintEvidence(first, newSlotBlock(t, neb, manager, genesis, outsider, slot))
+	assert.Nil(t, err)
+
+	for _, timestamp := range []int64{interval + slot, interval*2 + slot, interval*2 + slot*2} {
+		block := newSlotBlock(t, neb, manager, chain.TailBlock(), nil, timestamp)
+		assert.Nil(t, chain.BlockPool().Push(block))
+	}
+	tail := chain.TailBlock()
+	parent := chain.GetBlock(tail.ParentHash())
+	recent, err := core.NewDoubleMintEvidence(tail, newSlotBlock(t, neb, manager, parent, outsider, tail.Timestamp()))
+	assert.Nil(t, err)
+
+	// the blocks in the previous dynasty interval are kept for evidences.
+	chain.SetLIB(tail)
+	_, err = core.NewPruner(chain, 0).Prune()
+	assert.Nil(t, err)
+	assert.Equal(t, first.Height(), chain.PrunedHeight())
+	assert.True(t, chain.GetBlock(first.Hash()).StatePruned())
+
+	consensusState, err := tail.WorldState().NextConsensusState(slot)
+	assert.Nil(t, err)
+	block, err := core.NewBlock(chain.ChainID(), outsider, tail)
+	assert.Nil(t, err)
+	block.WorldState().SetConsensusState(consensusState)
+	block.SetTimestamp(tail.Timestamp() + slot)
+	assert.Nil(t, block.Begin())
+	defer block.RollBack()
+	for _, e := range []struct {
+		evidence *core.DoubleMintEvidence
+		err      error
+	}{
+		{expired, core.ErrEvidenceExpired},
+		{recent, nil},
+	} {
+		payload, err := core.NewEvidencePayload(e.evidence)
+		assert.Nil(t, err)
+		data, err := payload.ToBytes()
+		assert.Nil(t, err)
+		gasLimit, _ := util.NewUint128FromInt(200000)
+		tx, err := core.NewTransaction(chain.ChainID(), outsider, outsider, util.NewUint128(), 1, core.TxPayloadEvidenceType, data, core.TransactionGasPrice, gasLimit)
+		assert.Nil(t, err)
+		_, _, err = payload.Execute(gasLimit, tx, block, block.WorldState())
+		assert.Equal(t, e.err, err)
+	}
 }
@@ -0,0 +1,253 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package consensus
+
+import (
+	"errors"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/rpc"
+	"github.com/nebulasio/go-nebulas/rpc/pb"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+)
+
+// Errors in minting blocks, shared by consensus engines
+var (
+	ErrInvalidBlockProposer   = errors.New("invalid block proposer")
+	ErrWaitingBlockInLastSlot = errors.New("cannot mint block now, waiting for last block")
+	ErrBlockMintedInNextSlot  = errors.New("cannot mint block now, there is a block minted in current slot")
+	ErrAppendNewBlockFailed   = errors.New("failed to append new block to real chain")
+)
+
+const secondInMs = int64(1000)
+
+// LastSlot return the start of the slot before now
+func LastSlot(nowInMs int64, conf *corepb.GenesisConsensusDpos) int64 {
+	return int64((nowInMs-secondInMs)/conf.BlockIntervalInMs) * conf.BlockIntervalInMs
+}
+
+// NextSlot return the start of the slot after now
+func NextSlot(nowInMs int64, conf *corepb.GenesisConsensusDpos) int64 {
+	return int64((nowInMs+conf.BlockIntervalInMs-secondInMs)/conf.BlockIntervalInMs) * conf.BlockIntervalInMs
+}
+
+// Deadline return the deadline to pack txs for the next slot
+func Deadline(nowInMs int64, conf *corepb.GenesisConsensusDpos) int64 {
+	nextSlotInMs := NextSlot(nowInMs, conf)
+	remainInMs := nextSlotInMs - nowInMs
+	if conf.MaxMintDurationInMs > remainInMs {
+		return nextSlotInMs
+	}
+	return nowInMs + conf.MaxMintDurationInMs
+}
+
+// CheckDeadline return the deadline to mint a block on tail for the next slot
+func CheckDeadline(tail *core.Block, nowInMs int64, conf *corepb.GenesisConsensusDpos) (int64, error) {
+	lastSlotInMs := LastSlot(nowInMs, conf)
+	nextSlotInMs := NextSlot(nowInMs, conf)
+
+	if tail.Timestamp()*secondInMs >= nextSlotInMs {
+		return 0, ErrBlockMintedInNextSlot
+	}
+	if tail.Timestamp()*secondInMs == lastSlotInMs {
+		return Deadline(nowInMs, conf), nil
+	}
+	if nextSlotInMs-nowInMs <= conf.MinMintDurationInMs {
+		return Deadline(nowInMs, conf), nil
+	}
+	return 0, ErrWaitingBlockInLastSlot
+}
+
+// VerifyBlockSign check the block is signed by the miner
+func VerifyBlockSign(miner *core.Address, block *core.Block) error {
+	signer, err := core.RecoverSignerFromSignature(block.Alg(), block.Hash(), block.Signature())
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"signer": signer,
+			"err":    err,
+			"block":  block,
+		}).Error("Failed to recover block's miner.")
+		return err
+	}
+	if !miner.Equals(signer) {
+		logging.CLog().WithFields(logrus.Fields{
+			"signer": signer,
+			"miner":  miner,
+			"block":  block,
+		}).Debug("Failed to verify block's sign.")
+		return ErrInvalidBlockProposer
+	}
+	return nil
+}
+
+// Less return whether the chain ending at a is shorter than the one ending at b,
+// the one with smaller hash is shorter if they are at the same height
+func Less(a *core.Block, b *core.Block) bool {
+	if a.Height() != b.Height() {
+		return a.Height() < b.Height()
+	}
+	return byteutils.Less(a.Hash(), b.Hash())
+}
+
+// ForkChoice select the longest chain as tail
+func ForkChoice(bc *core.BlockChain) error {
+	tailBlock := bc.TailBlock()
+	detachedTailBlocks := bc.DetachedTailBlocks()
+
+	// find the max depth.
+	newTailBlock := tailBlock
+
+	for _, v := range detachedTailBlocks {
+		if Less(newTailBlock, v) {
+			newTailBlock = v
+		}
+	}
+
+	if newTailBlock.Hash().Equals(tailBlock.Hash()) {
+		logging.VLog().WithFields(logrus.Fields{
+			"old tail": tailBlock,
+			"new tail": newTailBlock,
+		}).Info("Current tail is best, no need to change.")
+		return nil
+	}
+
+	err := bc.SetTailBlock(newTailBlock)
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"new tail": newTailBlock,
+			"old tail": tailBlock,
+			"err":      err,
+		}).Error("Failed to set new tail block.")
+		return err
+	}
+
+	logging.VLog().WithFields(logrus.Fields{
+		"new tail": newTailBlock,
+		"old tail": tailBlock,
+	}).Info("change to new tail.")
+	return nil
+}
+
+// SignBlock sign the block by miner in account manager, or in the remote sign server if enabled
+func SignBlock(block *core.Block, miner *core.Address, am core.AccountManager, enableRemoteSignServer bool, remoteSignServer string) error {
+	if enableRemoteSignServer == true {
+		conn, err := rpc.Dial(remoteSignServer)
+		if err != nil {
+			return err
+		}
+		adminService := rpcpb.NewAdminServiceClient(conn)
+		alg := keystore.SECP256K1
+		resp, err := adminService.SignHash(
+			context.Background(),
+			&rpcpb.SignHashRequest{
+				Address: miner.String(),
+				Hash:    block.Hash(),
+				Alg:     uint32(alg),
+			})
+		conn.Close()
+		if err != nil {
+			return err
+		}
+		block.SetSignature(alg, resp.Data)
+		return nil
+	}
+	return am.SignBlock(miner, block)
+}
+
+// PackBlock create a block on tail with the consensus state, pack txs until deadline, then seal and sign it
+func PackBlock(chain *core.BlockChain, coinbase *core.Address, tail *core.Block, consensusState state.ConsensusState, deadlineInMs int64, sign func(*core.Block) error) (*core.Block, error) {
+	startAt := time.Now().Unix()
+	block, err := core.NewBlock(chain.ChainID(), coinbase, tail)
+	if err != nil {
+		logging.CLog().WithFields(logrus.Fields{
+			"tail":     tail,
+			"coinbase": coinbase,
+			"chainid":  chain.ChainID(),
+			"err":      err,
+		}).Error("Failed to create new block")
+		return nil, err
+	}
+
+	logging.CLog().WithFields(logrus.Fields{
+		"coinbase": coinbase,
+		"reward":   core.BlockReward,
+	}).Info("Rewarded the coinbase.")
+
+	block.WorldState().SetConsensusState(consensusState)
+	block.SetTimestamp(consensusState.TimeStamp())
+	block.CollectTransactions(deadlineInMs)
+	if err = block.Seal(); err != nil {
+		logging.CLog().WithFields(logrus.Fields{
+			"block": block,
+			"err":   err,
+		}).Error("Failed to seal new block")
+		go block.ReturnTransactions()
+		return nil, err
+	}
+	if err = sign(block); err != nil {
+		logging.CLog().WithFields(logrus.Fields{
+			"proposer": byteutils.Hex(consensusState.Proposer()),
+			"block":    block,
+			"err":      err,
+		}).Error("Failed to sign new block")
+		go block.ReturnTransactions()
+		return nil, err
+	}
+	endAt := time.Now().Unix()
+
+	logging.CLog().WithFields(logrus.Fields{
+		"start": startAt,
+		"end":   endAt,
+		"diff":  endAt - startAt,
+		"block": block,
+		"txs":   len(block.Transactions()),
+	}).Info("Packed txs.")
+
+	return block, nil
+}
+
+// PushAndBroadcast push the minted block into block pool and broadcast it,
+// the block must become the new tail
+func PushAndBroadcast(chain *core.BlockChain, tail *core.Block, block *core.Block) error {
+	if err := chain.BlockPool().PushAndBroadcast(block); err != nil {
+		logging.CLog().WithFields(logrus.Fields{
+			"tail":  tail,
+			"block": block,
+			"err":   err,
+		}).Error("Failed to push new minted block into block pool")
+		return err
+	}
+
+	if !chain.TailBlock().Hash().Equals(block.Hash()) {
+		return ErrAppendNewBlockFailed
+	}
+
+	logging.CLog().WithFields(logrus.Fields{
+		"tail":  tail,
+		"block": block,
+	}).Info("Broadcasted new block")
+	return nil
+}
@@ -28,9 +28,13 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
 type ConsensusRoot struct {
-	Timestamp   int64  `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Proposer    []byte `protobuf:"bytes,2,opt,name=proposer,proto3" json:"proposer,omitempty"`
-	DynastyRoot []byte `protobuf:"bytes,3,opt,name=dynasty_root,json=dynastyRoot,proto3" json:"dynasty_root,omitempty"`
+	Timestamp      int64  `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Proposer       []byte `protobuf:"bytes,2,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	DynastyRoot    []byte `protobuf:"bytes,3,opt,name=dynasty_root,json=dynastyRoot,proto3" json:"dynasty_root,omitempty"`
+	CandidatesRoot []byte `protobuf:"bytes,4,opt,name=candidates_root,json=candidatesRoot,proto3" json:"candidates_root,omitempty"`
+	DelegateRoot   []byte `protobuf:"bytes,5,opt,name=delegate_root,json=delegateRoot,proto3" json:"delegate_root,omitempty"`
+	VoteRoot       []byte `protobuf:"bytes,6,opt,name=vote_root,json=voteRoot,proto3" json:"vote_root,omitempty"`
+	SlashedRoot    []byte `protobuf:"bytes,7,opt,name=slashed_root,json=slashedRoot,proto3" json:"slashed_root,omitempty"`
 }
 
 func (m *ConsensusRoot) Reset()                    { *m = ConsensusRoot{} }
@@ -59,6 +63,34 @@ func (m *ConsensusRoot) GetDynastyRoot() []byte {
 	return nil
 }
 
+func (m *ConsensusRoot) GetCandidatesRoot() []byte {
+	if m != nil {
+		return m.CandidatesRoot
+	}
+	return nil
+}
+
+func (m *ConsensusRoot) GetDelegateRoot() []byte {
+	if m != nil {
+		return m.DelegateRoot
+	}
+	return nil
+}
+
+func (m *ConsensusRoot) GetVoteRoot() []byte {
+	if m != nil {
+		return m.VoteRoot
+	}
+	return nil
+}
+
+func (m *ConsensusRoot) GetSlashedRoot() []byte {
+	if m != nil {
+		return m.SlashedRoot
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ConsensusRoot)(nil), "consensuspb.ConsensusRoot")
 }
@@ -66,14 +98,18 @@ func init() {
 func init() { proto.RegisterFile("state.proto", fileDescriptorState) }
 
 var fileDescriptorState = []byte{
-	// 134 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x2e, 0x2e, 0x49, 0x2c,
-	0x49, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x4e, 0xce, 0xcf, 0x2b, 0x4e, 0xcd, 0x2b,
-	0x2e, 0x2d, 0x2e, 0x48, 0x52, 0xca, 0xe1, 0xe2, 0x75, 0x86, 0x71, 0x83, 0xf2, 0xf3, 0x4b, 0x84,
-	0x64, 0xb8, 0x38, 0x4b, 0x32, 0x73, 0x53, 0x8b, 0x4b, 0x12, 0x73, 0x0b, 0x24, 0x18, 0x15, 0x18,
-	0x35, 0x98, 0x83, 0x10, 0x02, 0x42, 0x52, 0x5c, 0x1c, 0x05, 0x45, 0xf9, 0x05, 0xf9, 0xc5, 0xa9,
-	0x45, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0x3c, 0x41, 0x70, 0xbe, 0x90, 0x22, 0x17, 0x4f, 0x4a, 0x65,
-	0x5e, 0x62, 0x71, 0x49, 0x65, 0x7c, 0x51, 0x7e, 0x7e, 0x89, 0x04, 0x33, 0x58, 0x9e, 0x1b, 0x2a,
-	0x06, 0x32, 0x3c, 0x89, 0x0d, 0xec, 0x02, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x27, 0xd5,
-	0xcf, 0x41, 0x90, 0x00, 0x00, 0x00,
+	// 200 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x90, 0xc1, 0x4e, 0x85, 0x30,
+	0x10, 0x45, 0x53, 0x9f, 0x3e, 0x7d, 0x43, 0x9f, 0x26, 0x5d, 0x11, 0x75, 0x01, 0xba, 0x90, 0x95,
+	0x1b, 0x3f, 0xc1, 0x3f, 0xe0, 0x07, 0x4c, 0xa1, 0x13, 0x25, 0x81, 0x4e, 0xd3, 0x19, 0x49, 0xf8,
+	0x75, 0x57, 0xc6, 0x16, 0x64, 0x79, 0xcf, 0x3d, 0x99, 0xdc, 0x0c, 0x14, 0x2c, 0x56, 0xf0, 0x35,
+	0x44, 0x12, 0x32, 0x45, 0x4f, 0x9e, 0xd1, 0xf3, 0x37, 0x87, 0xee, 0xe9, 0x47, 0xc1, 0xf9, 0x7d,
+	0xcb, 0x2d, 0x91, 0x98, 0x47, 0x38, 0xc9, 0x30, 0x21, 0x8b, 0x9d, 0x42, 0xa9, 0x2a, 0xd5, 0x1c,
+	0xda, 0x1d, 0x98, 0x7b, 0xb8, 0x09, 0x91, 0x02, 0x31, 0xc6, 0xf2, 0xa2, 0x52, 0x8d, 0x6e, 0xff,
+	0xb3, 0xa9, 0x41, 0xbb, 0xc5, 0x5b, 0x96, 0xe5, 0x23, 0x12, 0x49, 0x79, 0x48, 0x7d, 0xb1, 0xb2,
+	0x74, 0xfc, 0x05, 0xee, 0x7a, 0xeb, 0xdd, 0xe0, 0xac, 0x20, 0x67, 0xeb, 0x32, 0x59, 0xb7, 0x3b,
+	0x4e, 0xe2, 0x33, 0x9c, 0x1d, 0x8e, 0xf8, 0x69, 0x05, 0xb3, 0x76, 0x95, 0x34, 0xbd, 0xc1, 0x24,
+	0x3d, 0xc0, 0x69, 0xa6, 0x4d, 0x38, 0xe6, 0x35, 0x33, 0xad, 0x65, 0x0d, 0x9a, 0x47, 0xcb, 0x5f,
+	0xe8, 0x72, 0x7f, 0x9d, 0xd7, 0xac, 0xec, 0x4f, 0xe9, 0x8e, 0xe9, 0x21, 0x6f, 0xbf, 0x03, 0x00,
+	0xc2, 0xdf, 0x8c, 0x18, 0x1f, 0x01, 0x00, 0x00,
 }
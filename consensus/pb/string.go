@@ -26,9 +26,13 @@ import (
 
 // ToString return a string of consensus root
 func (m *ConsensusRoot) ToString() string {
-	return fmt.Sprintf(`{"proposer": %s, "timestamp": "%d", "dynasty": "%s"}`,
+	return fmt.Sprintf(`{"proposer": %s, "timestamp": "%d", "dynasty": "%s", "candidates": "%s", "delegate": "%s", "vote": "%s", "slashed": "%s"}`,
 		byteutils.Hex(m.Proposer),
 		m.Timestamp,
 		byteutils.Hex(m.DynastyRoot),
+		byteutils.Hex(m.CandidatesRoot),
+		byteutils.Hex(m.DelegateRoot),
+		byteutils.Hex(m.VoteRoot),
+		byteutils.Hex(m.SlashedRoot),
 	)
 }
@@ -0,0 +1,460 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package pod
+
+import (
+	"errors"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/core/state"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/nebulasio/go-nebulas/consensus"
+	"github.com/nebulasio/go-nebulas/core"
+	metrics "github.com/nebulasio/go-nebulas/metrics"
+	"github.com/nebulasio/go-nebulas/net"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// const
+const (
+	DefaultMaxUnlockDuration time.Duration = 1<<63 - 1
+)
+
+// Errors in PoD Consensus
+var (
+	ErrInvalidBlockTimestamp      = errors.New("invalid block timestamp, should be same as consensus's timestamp")
+	ErrInvalidBlockInterval       = errors.New("invalid block interval")
+	ErrInvalidBlockProposer       = consensus.ErrInvalidBlockProposer
+	ErrCannotMintWhenPending      = errors.New("cannot mint block now, waiting for cancel pending again")
+	ErrCannotMintWhenDisable      = errors.New("cannot mint block now, waiting for enable it again")
+	ErrWaitingBlockInLastSlot     = consensus.ErrWaitingBlockInLastSlot
+	ErrBlockMintedInNextSlot      = consensus.ErrBlockMintedInNextSlot
+	ErrGenerateNextConsensusState = errors.New("Failed to generate next consensus state")
+	ErrDoubleBlockMinted          = errors.New("double block minted")
+	ErrAppendNewBlockFailed       = consensus.ErrAppendNewBlockFailed
+)
+
+// Metrics
+var (
+	metricsBlockPackingTime = metrics.NewGauge("neb.block.pod.packing")
+	metricsBlockWaitingTime = metrics.NewGauge("neb.block.pod.waiting")
+	metricsLruPoolSlotBlock = metrics.NewGauge("neb.block.pod.lru.poolslot")
+)
+
+// Pod Proof-of-Devotion, whose proposers are weighted by stake
+type Pod struct {
+	quitCh chan bool
+
+	chain *core.BlockChain
+	ns    net.Service
+	am    core.AccountManager
+
+	coinbase               *core.Address
+	miner                  *core.Address
+	enableRemoteSignServer bool
+	remoteSignServer       string
+
+	slot *lru.Cache
+
+	enable  bool
+	pending bool
+}
+
+// NewPod create Pod instance.
+func NewPod() *Pod {
+	pod := &Pod{
+		quitCh:  make(chan bool, 5),
+		enable:  false,
+		pending: true,
+	}
+	return pod
+}
+
+// Setup a pod consensus handler
+func (pod *Pod) Setup(neblet core.Neblet) error {
+	pod.chain = neblet.BlockChain()
+	pod.ns = neblet.NetService()
+	pod.am = neblet.AccountManager()
+
+	chainConfig := neblet.Config().Chain
+	if chainConfig.StartMine {
+		coinbase, err := core.AddressParse(chainConfig.Coinbase)
+		if err != nil {
+			logging.CLog().WithFields(logrus.Fields{
+				"address": chainConfig.Coinbase,
+				"err":     err,
+			}).Error("Failed to parse coinbase address.")
+			return err
+		}
+		miner, err := core.AddressParse(chainConfig.Miner)
+		if err != nil {
+			logging.CLog().WithFields(logrus.Fields{
+				"address": chainConfig.Miner,
+				"err":     err,
+			}).Error("Failed to parse miner address.")
+			return err
+		}
+		pod.coinbase = coinbase
+		pod.miner = miner
+		pod.enableRemoteSignServer = chainConfig.EnableRemoteSignServer
+		pod.remoteSignServer = chainConfig.RemoteSignServer
+	}
+
+	slot, err := lru.New(128)
+	if err != nil {
+		return err
+	}
+	pod.slot = slot
+	return nil
+}
+
+// Start start pod service.
+func (pod *Pod) Start() {
+	logging.CLog().Info("Starting Pod Mining...")
+	go pod.blockLoop()
+}
+
+// Stop stop pod service.
+func (pod *Pod) Stop() {
+	logging.CLog().Info("Stopping Pod Mining...")
+	pod.DisableMining()
+	pod.quitCh <- true
+}
+
+// EnableMining start the consensus
+func (pod *Pod) EnableMining(passphrase string) error {
+	if err := pod.unlock(passphrase); err != nil {
+		return err
+	}
+	pod.enable = true
+	logging.CLog().Info("Enabled Pod Mining...")
+	return nil
+}
+
+// DisableMining stop the consensus
+func (pod *Pod) DisableMining() error {
+	if err := pod.am.Lock(pod.miner); err != nil {
+		return err
+	}
+	pod.enable = false
+	logging.CLog().Info("Disable Pod Mining...")
+	return nil
+}
+
+// Enable returns is mining
+func (pod *Pod) Enable() bool {
+	return pod.enable
+}
+
+// ForkChoice select new tail
+func (pod *Pod) ForkChoice() error {
+	return consensus.ForkChoice(pod.chain)
+}
+
+// UpdateLIB update the latest irrversible block
+func (pod *Pod) UpdateLIB() {
+	lib := pod.chain.LIB()
+	tail := pod.chain.TailBlock()
+	validators, err := tail.WorldState().Dynasty()
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"tail": tail,
+			"err":  err,
+		}).Debug("Failed to get validators.")
+		return
+	}
+	// the validators are fixed, a block is irreversible once
+	// more than 2/3 of them have minted blocks on it
+	limit := consensusSize(len(validators))
+	cur := tail
+	miners := make(map[string]bool)
+	for !cur.Hash().Equals(lib.Hash()) {
+		// fast prune
+		if int(cur.Height())-int(lib.Height()) < limit-len(miners) {
+			return
+		}
+		miners[byteutils.Hex(cur.ConsensusRoot().Proposer)] = true
+		if len(miners) >= limit {
+			if err := pod.chain.StoreLIBHashToStorage(cur); err != nil {
+				logging.VLog().WithFields(logrus.Fields{
+					"tail": tail,
+					"lib":  cur,
+				}).Debug("Failed to store latest irreversible block.")
+				return
+			}
+			logging.VLog().WithFields(logrus.Fields{
+				"lib.new":          cur,
+				"lib.old":          lib,
+				"tail":             tail,
+				"miners.limit":     limit,
+				"miners.supported": len(miners),
+			}).Info("Succeed to update latest irreversible block.")
+			pod.chain.SetLIB(cur)
+
+			e := &state.Event{
+				Topic: core.TopicLibBlock,
+				Data:  pod.chain.LIB().String(),
+			}
+			pod.chain.EventEmitter().Trigger(e)
+			return
+		}
+
+		tmp := cur
+		cur = pod.chain.GetBlock(cur.ParentHash())
+		if cur == nil || core.CheckGenesisBlock(cur) {
+			logging.VLog().WithFields(logrus.Fields{
+				"tail": tail,
+				"cur":  tmp,
+			}).Debug("Failed to find latest irreversible block.")
+			return
+		}
+	}
+
+	logging.VLog().WithFields(logrus.Fields{
+		"cur":              cur,
+		"lib":              lib,
+		"tail":             tail,
+		"err":              "supported miners is not enough",
+		"miners.limit":     limit,
+		"miners.supported": len(miners),
+	}).Warn("Failed to update latest irreversible block.")
+}
+
+// Pending return if consensus can do mining now
+func (pod *Pod) Pending() bool {
+	return pod.pending
+}
+
+// SuspendMining pend pod mining
+func (pod *Pod) SuspendMining() {
+	logging.CLog().Info("Suspended Pod Mining.")
+	pod.pending = true
+}
+
+// ResumeMining continue pod mining
+func (pod *Pod) ResumeMining() {
+	logging.CLog().Info("Resumed Pod Mining.")
+	pod.pending = false
+}
+
+// VerifyBlock verify the block
+func (pod *Pod) VerifyBlock(block *core.Block) error {
+	tail := pod.chain.TailBlock()
+	// check timestamp
+	if block.Timestamp() != block.ConsensusRoot().Timestamp {
+		return ErrInvalidBlockTimestamp
+	}
+	elapsedSecondInMs := (block.Timestamp() - tail.Timestamp()) * SecondInMs
+	if (elapsedSecondInMs % podConf(pod.chain).BlockIntervalInMs) != 0 {
+		return ErrInvalidBlockInterval
+	}
+	// check double mint
+	if preBlock, exist := pod.slot.Get(block.Timestamp()); exist {
+		logging.VLog().WithFields(logrus.Fields{
+			"curBlock": block,
+			"preBlock": preBlock.(*core.Block),
+		}).Warn("Found someone minted multiple blocks at same time.")
+		return ErrDoubleBlockMinted
+	}
+	// check proposer, the stake scores depend on the parent's state,
+	// so the proposer itself is checked again with the consensus root in block execution
+	proposer := block.ConsensusRoot().Proposer
+	validators, err := tail.WorldState().Dynasty()
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"err":   err,
+			"block": block,
+		}).Debug("Failed to get validators.")
+		return err
+	}
+	isValidator := false
+	for _, v := range validators {
+		if v.Equals(proposer) {
+			isValidator = true
+			break
+		}
+	}
+	if !isValidator {
+		logging.VLog().WithFields(logrus.Fields{
+			"proposer": proposer,
+			"block":    block,
+		}).Debug("Found a proposer not in validators.")
+		return ErrInvalidBlockProposer
+	}
+	miner, err := core.AddressParseFromBytes(proposer)
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"proposer": proposer,
+			"err":      err,
+			"block":    block,
+		}).Debug("Failed to parse proposer.")
+		return err
+	}
+	// check signature
+	if err := consensus.VerifyBlockSign(miner, block); err != nil {
+		return err
+	}
+	pod.slot.Add(block.Timestamp(), block)
+	return nil
+}
+
+func (pod *Pod) signBlock(block *core.Block) error {
+	return consensus.SignBlock(block, pod.miner, pod.am, pod.enableRemoteSignServer, pod.remoteSignServer)
+}
+
+func (pod *Pod) unlock(passphrase string) error {
+	if pod.enableRemoteSignServer == false {
+		return pod.am.Unlock(pod.miner, []byte(passphrase), DefaultMaxUnlockDuration)
+	}
+	return nil
+
+}
+
+func (pod *Pod) newBlock(tail *core.Block, consensusState state.ConsensusState, deadlineInMs int64) (*core.Block, error) {
+	return consensus.PackBlock(pod.chain, pod.coinbase, tail, consensusState, deadlineInMs, pod.signBlock)
+}
+
+func (pod *Pod) checkDeadline(tail *core.Block, nowInMs int64) (int64, error) {
+	return consensus.CheckDeadline(tail, nowInMs, podConf(pod.chain))
+}
+
+func (pod *Pod) checkProposer(tail *core.Block, nowInMs int64) (state.ConsensusState, error) {
+	slotInMs := consensus.NextSlot(nowInMs, podConf(pod.chain))
+	elapsedInMs := slotInMs - tail.Timestamp()*SecondInMs
+	consensusState, err := tail.WorldState().NextConsensusState(elapsedInMs / SecondInMs)
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"tail":    tail,
+			"elapsed": elapsedInMs,
+			"err":     err,
+		}).Debug("Failed to generate next dynasty context.")
+		return nil, ErrGenerateNextConsensusState
+	}
+	if consensusState.Proposer() == nil || !consensusState.Proposer().Equals(pod.miner.Bytes()) {
+		proposer := "nil"
+		if consensusState.Proposer() != nil {
+			proposer = consensusState.Proposer().Base58()
+		}
+		logging.VLog().WithFields(logrus.Fields{
+			"tail":     tail,
+			"now":      nowInMs,
+			"slot":     slotInMs,
+			"expected": proposer,
+			"actual":   pod.miner,
+		}).Debug("Not my turn, waiting...")
+		return nil, ErrInvalidBlockProposer
+	}
+	return consensusState, nil
+}
+
+func (pod *Pod) pushAndBroadcast(tail *core.Block, block *core.Block) error {
+	return consensus.PushAndBroadcast(pod.chain, tail, block)
+}
+
+func (pod *Pod) mintBlock(now int64) error {
+	metricsBlockPackingTime.Update(0)
+	metricsBlockWaitingTime.Update(0)
+
+	nowInMs := now * SecondInMs
+	// check mining enable
+	if !pod.enable {
+		return ErrCannotMintWhenDisable
+	}
+
+	// check mining pending
+	if pod.pending {
+		return ErrCannotMintWhenPending
+	}
+
+	tail := pod.chain.TailBlock()
+
+	deadlineInMs, err := pod.checkDeadline(tail, nowInMs)
+	if err != nil {
+		return err
+	}
+
+	consensusState, err := pod.checkProposer(tail, nowInMs)
+	if err != nil {
+		return err
+	}
+
+	miner := "nil"
+	if pod.miner != nil {
+		miner = pod.miner.String()
+	}
+	logging.CLog().WithFields(logrus.Fields{
+		"tail":     tail,
+		"start":    nowInMs,
+		"deadline": deadlineInMs,
+		"expected": consensusState.Proposer().Hex(),
+		"actual":   miner,
+	}).Info("My turn to mint block")
+	metricsBlockPackingTime.Update(deadlineInMs - nowInMs)
+
+	block, err := pod.newBlock(tail, consensusState, deadlineInMs)
+	if err != nil {
+		return err
+	}
+
+	slotInMs := consensus.NextSlot(nowInMs, podConf(pod.chain))
+	currentInMs := time.Now().Unix() * SecondInMs
+	if slotInMs > currentInMs {
+		timer := time.NewTimer(time.Duration(slotInMs-currentInMs) * time.Millisecond).C
+		<-timer
+		metricsBlockWaitingTime.Update(slotInMs - currentInMs)
+	}
+
+	logging.CLog().WithFields(logrus.Fields{
+		"tail":     tail,
+		"block":    block,
+		"start":    nowInMs,
+		"packed":   currentInMs,
+		"deadline": deadlineInMs,
+		"slot":     slotInMs,
+		"end":      time.Now().Unix(),
+	}).Info("Minted new block")
+
+	// try to push the new block on chain
+	// if failed, return all txs back
+
+	if err := pod.pushAndBroadcast(tail, block); err != nil {
+		go block.ReturnTransactions()
+		return err
+	}
+
+	return nil
+}
+
+func (pod *Pod) blockLoop() {
+	logging.CLog().Info("Started Pod Mining.")
+	timeChan := time.NewTicker(time.Second).C
+	for { // ToRefine: change loop logic, try more times second
+		select {
+		case now := <-timeChan:
+			metricsLruPoolSlotBlock.Update(int64(pod.slot.Len()))
+			pod.mintBlock(now.Unix())
+		case <-pod.quitCh:
+			logging.CLog().Info("Stopped Pod Mining.")
+			return
+		}
+	}
+}
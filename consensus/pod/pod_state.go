@@ -0,0 +1,336 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package pod
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/consensus/pb"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/crypto/hash"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// Consensus Related Constants, others are set in genesis
+const (
+	SecondInMs = int64(1000)
+)
+
+// Errors in pod state
+var (
+	ErrInitialValidatorsNotEnough = errors.New("the size of initial validators in genesis block is invalid, should be equal to dynasty size")
+	ErrCloneValidatorsTrie        = errors.New("Failed to clone validators trie")
+	ErrNotBlockForgTime           = errors.New("now is not time to forg block")
+	ErrNoValidators               = errors.New("no validators to propose blocks")
+)
+
+// State carry context in pod consensus
+type State struct {
+	timestamp int64
+	proposer  byteutils.Hash
+
+	validatorsTrie *trie.Trie // key: validator, val: validator
+
+	chain     *core.BlockChain
+	consensus core.Consensus
+}
+
+// NewState create a new pod state
+func (pod *Pod) NewState(root *consensuspb.ConsensusRoot, stor storage.Storage, needChangeLog bool) (state.ConsensusState, error) {
+	var validatorsRoot byteutils.Hash
+	if root != nil {
+		validatorsRoot = root.DynastyRoot
+	}
+	validatorsTrie, err := trie.NewTrie(validatorsRoot, stor, needChangeLog)
+	if err != nil {
+		return nil, err
+	}
+
+	return &State{
+		timestamp: root.Timestamp,
+		proposer:  root.Proposer,
+
+		validatorsTrie: validatorsTrie,
+
+		chain:     pod.chain,
+		consensus: pod,
+	}, nil
+}
+
+// CheckTimeout check whether the block is timeout
+func (pod *Pod) CheckTimeout(block *core.Block) bool {
+	nowInMs := time.Now().Unix() * SecondInMs
+	blockTimeInMs := block.Timestamp() * SecondInMs
+	if nowInMs < blockTimeInMs {
+		logging.VLog().WithFields(logrus.Fields{
+			"block": block,
+			"now":   nowInMs,
+			"diff":  blockTimeInMs - nowInMs,
+			"err":   "timeout - future block",
+		}).Debug("Found a future block.")
+		return false
+	}
+	behindInMs := nowInMs - blockTimeInMs
+	acceptedDelayInMs := podConf(pod.chain).AcceptedNetworkDelayInMs
+	if behindInMs > acceptedDelayInMs {
+		logging.VLog().WithFields(logrus.Fields{
+			"block": block,
+			"now":   nowInMs,
+			"diff":  behindInMs,
+			"limit": acceptedDelayInMs,
+			"err":   "timeout - expired block",
+		}).Debug("Found a expired block.")
+		return true
+	}
+	return false
+}
+
+// GenesisConsensusState create a new genesis pod state,
+// the initial dynasty in genesis are the validators
+func (pod *Pod) GenesisConsensusState(chain *core.BlockChain, conf *corepb.Genesis) (state.ConsensusState, error) {
+	validatorsTrie, err := trie.NewTrie(nil, chain.Storage(), false)
+	if err != nil {
+		return nil, err
+	}
+	if len(conf.Consensus.Dpos.Dynasty) == 0 || len(conf.Consensus.Dpos.Dynasty) != int(conf.Consensus.Dpos.DynastySize) {
+		return nil, ErrInitialValidatorsNotEnough
+	}
+	for _, addr := range conf.Consensus.Dpos.Dynasty {
+		validator, err := core.AddressParse(addr)
+		if err != nil {
+			return nil, err
+		}
+		v := validator.Bytes()
+		if _, err = validatorsTrie.Put(v, v); err != nil {
+			return nil, err
+		}
+	}
+	return &State{
+		timestamp: core.GenesisTimestamp,
+		proposer:  nil,
+
+		validatorsTrie: validatorsTrie,
+
+		chain:     chain,
+		consensus: pod,
+	}, nil
+}
+
+func (ps *State) String() string {
+	proposer := ""
+	if ps.proposer != nil {
+		proposer = ps.proposer.String()
+	}
+	return fmt.Sprintf(`{"timestamp": %d, "proposer": "%s", "validators": "%s"}`,
+		ps.timestamp,
+		proposer,
+		byteutils.Hex(ps.validatorsTrie.RootHash()),
+	)
+}
+
+// Replay a state
+func (ps *State) Replay(done state.ConsensusState) error {
+	state := done.(*State)
+	if _, err := ps.validatorsTrie.Replay(state.validatorsTrie); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Clone a pod state
+func (ps *State) Clone() (state.ConsensusState, error) {
+	validatorsTrie, err := ps.validatorsTrie.Clone()
+	if err != nil {
+		return nil, ErrCloneValidatorsTrie
+	}
+	return &State{
+		timestamp: ps.timestamp,
+		proposer:  ps.proposer,
+
+		validatorsTrie: validatorsTrie,
+
+		chain:     ps.chain,
+		consensus: ps.consensus,
+	}, nil
+}
+
+// RootHash hash pod state
+func (ps *State) RootHash() *consensuspb.ConsensusRoot {
+	return &consensuspb.ConsensusRoot{
+		DynastyRoot: ps.validatorsTrie.RootHash(),
+		Timestamp:   ps.TimeStamp(),
+		Proposer:    ps.Proposer(),
+	}
+}
+
+// Dynasty return the validators
+func (ps *State) Dynasty() ([]byteutils.Hash, error) {
+	return TraverseValidators(ps.validatorsTrie)
+}
+
+// DynastyRoot return the roothash of validators
+func (ps *State) DynastyRoot() byteutils.Hash {
+	return ps.validatorsTrie.RootHash()
+}
+
+// Proposer return the current proposer
+func (ps *State) Proposer() byteutils.Hash {
+	return ps.proposer
+}
+
+// TimeStamp return the current timestamp
+func (ps *State) TimeStamp() int64 {
+	return ps.timestamp
+}
+
+// NextConsensusState return the new state after some seconds elapsed
+func (ps *State) NextConsensusState(elapsedSecond int64, worldState state.WorldState) (state.ConsensusState, error) {
+	elapsedSecondInMs := elapsedSecond * SecondInMs
+	if elapsedSecondInMs%podConf(ps.chain).BlockIntervalInMs != 0 {
+		return nil, ErrNotBlockForgTime
+	}
+
+	validatorsTrie, err := ps.validatorsTrie.Clone()
+	if err != nil {
+		return nil, err
+	}
+	consensusState := &State{
+		timestamp: ps.timestamp + elapsedSecond,
+
+		validatorsTrie: validatorsTrie,
+
+		chain:     ps.chain,
+		consensus: ps.consensus,
+	}
+
+	validators, err := TraverseValidators(validatorsTrie)
+	if err != nil {
+		return nil, err
+	}
+	scores, err := StakeScores(validators, worldState)
+	if err != nil {
+		return nil, err
+	}
+	consensusState.proposer, err = FindProposer(consensusState.timestamp, validators, scores)
+	if err != nil {
+		return nil, err
+	}
+	return consensusState, nil
+}
+
+// StakeUnit is the balance counted as one score, 1 NAS.
+var StakeUnit = util.NewUint128FromUint(1000000000000000000)
+
+// StakeScores return the scores of the validators, which are weighted by stake only.
+// The score of a validator is its balance in StakeUnit plus one, so that every validator
+// has a chance to propose blocks. No on-chain activity is counted.
+func StakeScores(validators []byteutils.Hash, worldState state.WorldState) ([]uint64, error) {
+	scores := make([]uint64, len(validators))
+	for i := range scores {
+		scores[i] = 1
+	}
+	if worldState == nil {
+		return scores, nil
+	}
+	// read accounts in a copy, keep the given world state untouched
+	ws, err := worldState.Clone()
+	if err != nil {
+		return nil, err
+	}
+	for i, validator := range validators {
+		acc, err := ws.GetOrCreateUserAccount(validator)
+		if err != nil {
+			return nil, err
+		}
+		stake, err := acc.Balance().Div(StakeUnit)
+		if err != nil {
+			return nil, err
+		}
+		scores[i] += stake.Uint64()
+	}
+	return scores, nil
+}
+
+// FindProposer pick a validator for the slot at now, the chance of each validator
+// is in proportion to its score
+func FindProposer(now int64, validators []byteutils.Hash, scores []uint64) (byteutils.Hash, error) {
+	if len(validators) == 0 || len(validators) != len(scores) {
+		return nil, ErrNoValidators
+	}
+	total := new(big.Int)
+	for _, score := range scores {
+		total.Add(total, new(big.Int).SetUint64(score))
+	}
+	if total.Sign() == 0 {
+		return nil, ErrNoValidators
+	}
+
+	// every node gets the same seed for the same slot
+	seed := new(big.Int).SetBytes(hash.Sha3256(byteutils.FromInt64(now)))
+	point := seed.Mod(seed, total)
+	for i, score := range scores {
+		point.Sub(point, new(big.Int).SetUint64(score))
+		if point.Sign() < 0 {
+			return validators[i], nil
+		}
+	}
+	return validators[len(validators)-1], nil
+}
+
+// TraverseValidators return all validators
+func TraverseValidators(validators *trie.Trie) ([]byteutils.Hash, error) {
+	members := []byteutils.Hash{}
+	iter, err := validators.Iterator(nil)
+	if err != nil && err != storage.ErrKeyNotFound {
+		return nil, err
+	}
+	if err != nil {
+		return members, nil
+	}
+	exist, err := iter.Next()
+	for exist {
+		members = append(members, iter.Value())
+		exist, err = iter.Next()
+	}
+	if err != nil {
+		return nil, err
+	}
+	return members, nil
+}
+
+// podConf return the consensus parameters of the chain,
+// pod shares the consensus section with dpos in genesis
+func podConf(chain *core.BlockChain) *corepb.GenesisConsensusDpos {
+	return chain.Genesis().Consensus.Dpos
+}
+
+// consensusSize return the min number of validators to reach consensus
+func consensusSize(validators int) int {
+	return validators*2/3 + 1
+}
@@ -0,0 +1,109 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package pod
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindProposer(t *testing.T) {
+	validators := []byteutils.Hash{{0x01}, {0x02}, {0x03}}
+
+	_, err := FindProposer(15, nil, nil)
+	assert.Equal(t, err, ErrNoValidators)
+	_, err = FindProposer(15, validators, []uint64{1})
+	assert.Equal(t, err, ErrNoValidators)
+	_, err = FindProposer(15, validators, []uint64{0, 0, 0})
+	assert.Equal(t, err, ErrNoValidators)
+
+	// same slot, same proposer
+	scores := []uint64{1, 1, 1}
+	proposer, err := FindProposer(15, validators, scores)
+	assert.Nil(t, err)
+	again, err := FindProposer(15, validators, scores)
+	assert.Nil(t, err)
+	assert.Equal(t, proposer, again)
+
+	// validators without score never propose
+	for now := int64(0); now < 300; now += 15 {
+		proposer, err := FindProposer(now, validators, []uint64{0, 5, 0})
+		assert.Nil(t, err)
+		assert.Equal(t, proposer, validators[1])
+	}
+
+	// validators with higher scores propose more blocks
+	counts := make(map[byteutils.HexHash]int)
+	for now := int64(0); now < 15000; now += 15 {
+		proposer, err := FindProposer(now, validators, []uint64{1, 1, 8})
+		assert.Nil(t, err)
+		counts[proposer.Hex()]++
+	}
+	assert.True(t, counts[validators[2].Hex()] > counts[validators[0].Hex()])
+	assert.True(t, counts[validators[2].Hex()] > counts[validators[1].Hex()])
+}
+
+func TestStakeScores(t *testing.T) {
+	validators := []byteutils.Hash{{0x01}, {0x02}}
+	scores, err := StakeScores(validators, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, scores, []uint64{1, 1})
+
+	// the balance counts, sending transactions does not.
+	stor, err := storage.NewMemoryStorage()
+	assert.Nil(t, err)
+	ws, err := state.NewWorldState(NewPod(), stor)
+	assert.Nil(t, err)
+	acc, err := ws.GetOrCreateUserAccount(validators[0])
+	assert.Nil(t, err)
+	balance, err := StakeUnit.Mul(util.NewUint128FromUint(3))
+	assert.Nil(t, err)
+	assert.Nil(t, acc.AddBalance(balance))
+	acc, err = ws.GetOrCreateUserAccount(validators[1])
+	assert.Nil(t, err)
+	acc.IncrNonce()
+	acc.IncrNonce()
+	scores, err = StakeScores(validators, ws)
+	assert.Nil(t, err)
+	assert.Equal(t, scores, []uint64{4, 1})
+}
+
+func TestTraverseValidators(t *testing.T) {
+	stor, err := storage.NewMemoryStorage()
+	assert.Nil(t, err)
+	validatorsTrie, err := trie.NewTrie(nil, stor, false)
+	assert.Nil(t, err)
+	validators, err := TraverseValidators(validatorsTrie)
+	assert.Nil(t, err)
+	assert.Equal(t, validators, []byteutils.Hash{})
+
+	for _, v := range []byteutils.Hash{{0x02}, {0x01}} {
+		_, err := validatorsTrie.Put(v, v)
+		assert.Nil(t, err)
+	}
+	validators, err = TraverseValidators(validatorsTrie)
+	assert.Nil(t, err)
+	assert.Equal(t, validators, []byteutils.Hash{{0x01}, {0x02}})
+}
@@ -0,0 +1,313 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"bytes"
+
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// const
+const (
+	// AddressTxPrefix the prefix of address transaction key in storage,
+	// followed by address, block height and tx index in block.
+	AddressTxPrefix = "address_tx_"
+
+	// AddressIndexHeight Key in storage
+	AddressIndexHeight = "address_index_height"
+
+	// AddressIndexBlockPrefix the prefix of indexed block key in storage, followed by block height.
+	AddressIndexBlockPrefix = "address_index_block_"
+)
+
+// AddressTx is a transaction sent from or to an address.
+type AddressTx struct {
+	Height uint64
+	Index  uint32
+	Hash   byteutils.Hash
+}
+
+// AddressIndexer indexes the transactions of blocks on canonical chain by their from and to addresses.
+type AddressIndexer struct {
+	chain *BlockChain
+
+	eventSub *EventSubscriber
+	quitCh   chan int
+}
+
+// NewAddressIndexer create a new AddressIndexer.
+func NewAddressIndexer(chain *BlockChain) *AddressIndexer {
+	return &AddressIndexer{
+		chain:    chain,
+		eventSub: NewEventSubscriber(1024, []string{TopicNewTailBlock, TopicRevertBlock}),
+		quitCh:   make(chan int, 1),
+	}
+}
+
+// Start start loop.
+func (idx *AddressIndexer) Start() {
+	logging.CLog().Info("Starting AddressIndexer...")
+
+	idx.chain.eventEmitter.Register(idx.eventSub)
+	go idx.loop()
+}
+
+// Stop stop loop.
+func (idx *AddressIndexer) Stop() {
+	logging.CLog().Info("Stopping AddressIndexer...")
+
+	idx.chain.eventEmitter.Deregister(idx.eventSub)
+	idx.quitCh <- 0
+}
+
+func (idx *AddressIndexer) loop() {
+	logging.CLog().Info("Started AddressIndexer.")
+
+	// index the blocks linked while the indexer is stopped.
+	idx.rewind()
+	idx.catchUp(idx.chain.TailBlock().Height())
+	for {
+		select {
+		case <-idx.quitCh:
+			logging.CLog().Info("Stopped AddressIndexer.")
+			return
+		case e := <-idx.eventSub.EventChan():
+			if e.Block == nil {
+				continue
+			}
+			if e.Topic == TopicRevertBlock {
+				idx.revert(e.Block)
+				continue
+			}
+			idx.catchUp(e.Block.Height())
+		case <-idx.eventSub.OverflowChan():
+			// the dropped events may have reverted blocks.
+			idx.rewind()
+			idx.catchUp(idx.chain.TailBlock().Height())
+		}
+	}
+}
+
+// catchUp index the blocks on canonical chain after the indexed height until the height.
+func (idx *AddressIndexer) catchUp(height uint64) {
+	bc := idx.chain
+	indexed, err := idx.IndexedHeight()
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"err": err,
+		}).Error("Failed to load indexed height of addresses.")
+		return
+	}
+	for h := indexed + 1; h <= height; h++ {
+		// the blocks before state sync tail are not in storage.
+		block := bc.GetBlockOnCanonicalChainByHeight(h)
+		if block != nil {
+			if err := idx.putBlock(block); err != nil {
+				logging.VLog().WithFields(logrus.Fields{
+					"block": block,
+					"err":   err,
+				}).Error("Failed to index transactions by address.")
+				return
+			}
+		}
+		if err := idx.storeIndexedHeight(h); err != nil {
+			logging.VLog().WithFields(logrus.Fields{
+				"height": h,
+				"err":    err,
+			}).Error("Failed to store indexed height of addresses.")
+			return
+		}
+	}
+}
+
+// rewind revert the indexed blocks no longer on canonical chain from the indexed height,
+// until the one still on canonical chain.
+func (idx *AddressIndexer) rewind() {
+	bc := idx.chain
+	indexed, err := idx.IndexedHeight()
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"err": err,
+		}).Error("Failed to load indexed height of addresses.")
+		return
+	}
+	tail := bc.TailBlock().Height()
+	for h := indexed; h > 0; h-- {
+		hash, err := bc.storage.Get(indexedBlockKey(h))
+		if err == storage.ErrKeyNotFound {
+			// no block indexed at and before the height.
+			return
+		}
+		if err != nil {
+			logging.VLog().WithFields(logrus.Fields{
+				"height": h,
+				"err":    err,
+			}).Error("Failed to load indexed block of addresses.")
+			return
+		}
+		if h <= tail {
+			canonical, err := bc.storage.Get(byteutils.FromUint64(h))
+			if err == nil && bytes.Equal(canonical, hash) {
+				return
+			}
+		}
+		block := bc.GetBlock(hash)
+		if block == nil {
+			logging.VLog().WithFields(logrus.Fields{
+				"height": h,
+				"hash":   byteutils.Hash(hash).Hex(),
+			}).Error("Failed to find reverted block indexed by address.")
+			return
+		}
+		idx.revert(block)
+	}
+}
+
+// revert remove the transactions of reverted block, the blocks linked at its height
+// and after are indexed again on next catch up.
+func (idx *AddressIndexer) revert(block *Block) {
+	for i, tx := range block.transactions {
+		for _, key := range addressTxKeys(tx, block.Height(), uint32(i)) {
+			if err := idx.chain.storage.Del(key); err != nil && err != storage.ErrKeyNotFound {
+				logging.VLog().WithFields(logrus.Fields{
+					"block": block,
+					"err":   err,
+				}).Error("Failed to revert transactions indexed by address.")
+				return
+			}
+		}
+	}
+	hash, err := idx.chain.storage.Get(indexedBlockKey(block.Height()))
+	if err == nil && block.Hash().Equals(hash) {
+		if err := idx.chain.storage.Del(indexedBlockKey(block.Height())); err != nil {
+			logging.VLog().WithFields(logrus.Fields{
+				"block": block,
+				"err":   err,
+			}).Error("Failed to revert indexed block of addresses.")
+			return
+		}
+	}
+
+	indexed, err := idx.IndexedHeight()
+	if err != nil || indexed < block.Height() {
+		return
+	}
+	if err := idx.storeIndexedHeight(block.Height() - 1); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"block": block,
+			"err":   err,
+		}).Error("Failed to store indexed height of addresses.")
+	}
+}
+
+func (idx *AddressIndexer) putBlock(block *Block) error {
+	for i, tx := range block.transactions {
+		for _, key := range addressTxKeys(tx, block.Height(), uint32(i)) {
+			if err := idx.chain.storage.Put(key, tx.Hash()); err != nil {
+				return err
+			}
+		}
+	}
+	return idx.chain.storage.Put(indexedBlockKey(block.Height()), block.Hash())
+}
+
+// IndexedHeight return the height until which blocks are indexed.
+func (idx *AddressIndexer) IndexedHeight() (uint64, error) {
+	value, err := idx.chain.storage.Get([]byte(AddressIndexHeight))
+	if err == storage.ErrKeyNotFound {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return byteutils.Uint64(value), nil
+}
+
+func (idx *AddressIndexer) storeIndexedHeight(height uint64) error {
+	return idx.chain.storage.Put([]byte(AddressIndexHeight), byteutils.FromUint64(height))
+}
+
+// GetTransactions return at most limit transactions of address after the cursor in
+// ascending order, and the cursor of the last one. Nil cursor starts from the first one.
+func (idx *AddressIndexer) GetTransactions(addr *Address, cursor []byte, limit int) ([]*AddressTx, []byte, error) {
+	iterable, ok := idx.chain.storage.(storage.Iterable)
+	if !ok {
+		return nil, nil, ErrStorageNotIterable
+	}
+
+	prefix := addressTxPrefix(addr)
+	start := append(append([]byte{}, prefix...), cursor...)
+	if cursor != nil {
+		// the next key after cursor.
+		start = append(start, 0)
+	}
+	iter := iterable.RangeIterator(start, prefixLimit(prefix))
+	defer iter.Release()
+
+	txs := []*AddressTx{}
+	var last []byte
+	for len(txs) < limit && iter.Next() {
+		key := iter.Key()[len(prefix):]
+		if len(key) != 12 {
+			continue
+		}
+		txs = append(txs, &AddressTx{
+			Height: byteutils.Uint64(key[:8]),
+			Index:  byteutils.Uint32(key[8:]),
+			Hash:   append(byteutils.Hash{}, iter.Value()...),
+		})
+		last = append([]byte{}, key...)
+	}
+	if err := iter.Error(); err != nil {
+		return nil, nil, err
+	}
+	return txs, last, nil
+}
+
+func indexedBlockKey(height uint64) []byte {
+	return append([]byte(AddressIndexBlockPrefix), byteutils.FromUint64(height)...)
+}
+
+func addressTxPrefix(addr *Address) []byte {
+	return append([]byte(AddressTxPrefix), addr.Bytes()...)
+}
+
+func addressTxKeys(tx *Transaction, height uint64, index uint32) [][]byte {
+	suffix := append(byteutils.FromUint64(height), byteutils.FromUint32(index)...)
+	keys := [][]byte{append(addressTxPrefix(tx.From()), suffix...)}
+	if !tx.From().Equals(tx.To()) {
+		keys = append(keys, append(addressTxPrefix(tx.To()), suffix...))
+	}
+	return keys
+}
+
+// prefixLimit return the smallest key larger than all keys with the prefix.
+func prefixLimit(prefix []byte) []byte {
+	limit := bytes.TrimRight(prefix, "\xff")
+	if len(limit) == 0 {
+		return nil
+	}
+	limit = append([]byte{}, limit...)
+	limit[len(limit)-1]++
+	return limit
+}
@@ -0,0 +1,89 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddressIndexer(t *testing.T) {
+	neb := testNeb(t)
+	bc := neb.chain
+	idx := bc.AddressIndexer()
+
+	coinbase, _ := AddressParse("n1JNHZJEUvfBYfjDRD14Q73FX62nJAzXkMR")
+	block, err := bc.NewBlock(coinbase)
+	assert.Nil(t, err)
+
+	tx1 := mockNormalTransaction(bc.chainID, 1)
+	tx2 := mockNormalTransaction(bc.chainID, 2)
+	tx2.from = tx1.from
+	tx3 := mockNormalTransaction(bc.chainID, 3)
+	tx3.from, tx3.to = tx1.from, tx1.from
+	block.transactions = append(block.transactions, tx1, tx2, tx3)
+	assert.Nil(t, idx.putBlock(block))
+
+	// list all the transactions of sender by pages.
+	txs, cursor, err := idx.GetTransactions(tx1.from, nil, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(txs))
+	assert.Equal(t, tx1.Hash(), txs[0].Hash)
+	assert.Equal(t, tx2.Hash(), txs[1].Hash)
+	assert.Equal(t, block.Height(), txs[1].Height)
+	assert.Equal(t, uint32(1), txs[1].Index)
+
+	txs, cursor, err = idx.GetTransactions(tx1.from, cursor, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(txs))
+	assert.Equal(t, tx3.Hash(), txs[0].Hash)
+
+	txs, _, err = idx.GetTransactions(tx1.from, cursor, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(txs))
+
+	txs, _, err = idx.GetTransactions(tx2.to, nil, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(txs))
+	assert.Equal(t, tx2.Hash(), txs[0].Hash)
+
+	// revert the block.
+	assert.Nil(t, idx.storeIndexedHeight(block.Height()))
+	idx.revert(block)
+	txs, _, err = idx.GetTransactions(tx1.from, nil, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(txs))
+	height, err := idx.IndexedHeight()
+	assert.Nil(t, err)
+	assert.Equal(t, block.Height()-1, height)
+
+	// rewind the indexed block not on canonical chain, whose revert event is missed.
+	block.header.hash = []byte("reverted block")
+	bc.cachedBlocks.Add(block.Hash().Hex(), block)
+	assert.Nil(t, idx.putBlock(block))
+	assert.Nil(t, idx.storeIndexedHeight(block.Height()))
+	idx.rewind()
+	txs, _, err = idx.GetTransactions(tx1.from, nil, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(txs))
+	height, err = idx.IndexedHeight()
+	assert.Nil(t, err)
+	assert.Equal(t, block.Height()-1, height)
+}
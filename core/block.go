@@ -137,12 +137,17 @@ type Block struct {
 	sealed bool
 	height uint64
 
-	worldState state.WorldState
+	worldState  state.WorldState
+	statePruned bool
 
 	txPool       *TransactionPool
 	eventEmitter *EventEmitter
 	nvm          NVM
+	dip          Dip
 	storage      storage.Storage
+
+	// trace is set when the block replays a transaction in tracing mode.
+	trace *TransactionTrace
 }
 
 // ToProto converts domain Block into proto Block
@@ -238,6 +243,7 @@ func NewBlock(chainID uint32, coinbase *Address, parent *Block) (*Block, error)
 		txPool:       parent.txPool,
 		eventEmitter: parent.eventEmitter,
 		nvm:          parent.nvm,
+		dip:          parent.dip,
 		storage:      parent.storage,
 	}
 
@@ -340,6 +346,11 @@ func (block *Block) ConsensusRoot() *consensuspb.ConsensusRoot {
 	return block.header.consensusRoot
 }
 
+// StatePruned return whether the states of block are pruned.
+func (block *Block) StatePruned() bool {
+	return block.statePruned
+}
+
 // ParentHash return parent hash.
 func (block *Block) ParentHash() byteutils.Hash {
 	return block.header.parentHash
@@ -378,6 +389,7 @@ func (block *Block) LinkParentBlock(chain *BlockChain, parentBlock *Block) error
 	block.storage = parentBlock.storage
 	block.eventEmitter = parentBlock.eventEmitter
 	block.nvm = parentBlock.nvm
+	block.dip = parentBlock.dip
 
 	return nil
 }
@@ -724,6 +736,9 @@ func (block *Block) Seal() error {
 
 	defer block.RollBack()
 
+	if err := block.recordContractCalls(); err != nil {
+		return err
+	}
 	if err := block.rewardCoinbaseForGas(); err != nil {
 		return err
 	}
@@ -979,6 +994,9 @@ func (block *Block) execute() error {
 		metricsTxVerifiedTime.Update(0)
 	}
 
+	if err := block.recordContractCalls(); err != nil {
+		return err
+	}
 	if err := block.rewardCoinbaseForGas(); err != nil {
 		return err
 	}
@@ -1055,6 +1073,13 @@ func (block *Block) rewardCoinbaseForMint() error {
 	return coinbaseAcc.AddBalance(BlockReward)
 }
 
+func (block *Block) recordContractCalls() error {
+	if block.dip == nil {
+		return nil
+	}
+	return block.dip.RecordContractCalls(block)
+}
+
 func (block *Block) rewardCoinbaseForGas() error {
 	worldState := block.WorldState()
 	coinbaseAddr := (byteutils.Hash)(block.Coinbase().Bytes())
@@ -1139,6 +1164,39 @@ func (block *Block) CheckContract(addr *Address) (state.Account, error) {
 	return CheckContract(addr, worldState)
 }
 
+// ContractVersions returns the source versions of the contract
+func (block *Block) ContractVersions(addr *Address) ([]*ContractVersion, error) {
+	worldState, err := block.worldState.Clone()
+	if err != nil {
+		return nil, err
+	}
+	contract, err := CheckContract(addr, worldState)
+	if err != nil {
+		return nil, err
+	}
+	return ContractVersions(contract, worldState)
+}
+
+// ContractABI returns the abi declared by the contract
+func (block *Block) ContractABI(addr *Address) (*ContractABI, error) {
+	worldState, err := block.worldState.Clone()
+	if err != nil {
+		return nil, err
+	}
+	contract, err := CheckContract(addr, worldState)
+	if err != nil {
+		return nil, err
+	}
+	abi, err := ContractABIOf(contract)
+	if err != nil {
+		return nil, err
+	}
+	if abi == nil {
+		return nil, ErrContractABINotFound
+	}
+	return abi, nil
+}
+
 // GetTransaction from txs Trie
 func (block *Block) GetTransaction(hash byteutils.Hash) (*Transaction, error) {
 	worldState, err := block.worldState.Clone()
@@ -1150,34 +1208,49 @@ func (block *Block) GetTransaction(hash byteutils.Hash) (*Transaction, error) {
 
 // CalHash calculate the hash of block.
 func (block *Block) calHash() (byteutils.Hash, error) {
-	hasher := sha3.New256()
-
-	consensusRoot, err := proto.Marshal(block.ConsensusRoot())
+	header, err := block.header.ToProto()
 	if err != nil {
 		return nil, err
 	}
-
 	pbDep, err := block.dependency.ToProto()
 	if err != nil {
 		return nil, err
 	}
-	dependency, err := proto.Marshal(pbDep)
+	txHashes := make([][]byte, len(block.transactions))
+	for idx, tx := range block.transactions {
+		txHashes[idx] = tx.Hash()
+	}
+	return HashBlockHeader(header.(*corepb.BlockHeader), pbDep.(*dagpb.Dag), txHashes)
+}
+
+// HashBlockHeader return the block hash computed from the header, the dependency and the hashes of txs.
+func HashBlockHeader(header *corepb.BlockHeader, dependency *dagpb.Dag, txHashes [][]byte) (byteutils.Hash, error) {
+	if header == nil || header.ConsensusRoot == nil || dependency == nil {
+		return nil, ErrNilArgument
+	}
+	hasher := sha3.New256()
+
+	consensusRoot, err := proto.Marshal(header.ConsensusRoot)
+	if err != nil {
+		return nil, err
+	}
+	dep, err := proto.Marshal(dependency)
 	if err != nil {
 		return nil, err
 	}
 
-	hasher.Write(block.ParentHash())
-	hasher.Write(block.StateRoot())
-	hasher.Write(block.TxsRoot())
-	hasher.Write(block.EventsRoot())
+	hasher.Write(header.ParentHash)
+	hasher.Write(header.StateRoot)
+	hasher.Write(header.TxsRoot)
+	hasher.Write(header.EventsRoot)
 	hasher.Write(consensusRoot)
-	hasher.Write(dependency)
-	hasher.Write(block.header.coinbase.address)
-	hasher.Write(byteutils.FromInt64(block.header.timestamp))
-	hasher.Write(byteutils.FromUint32(block.header.chainID))
+	hasher.Write(dep)
+	hasher.Write(header.Coinbase)
+	hasher.Write(byteutils.FromInt64(header.Timestamp))
+	hasher.Write(byteutils.FromUint32(header.ChainId))
 
-	for _, tx := range block.transactions {
-		hasher.Write(tx.Hash())
+	for _, hash := range txHashes {
+		hasher.Write(hash)
 	}
 
 	return hasher.Sum(nil), nil
@@ -1214,22 +1287,28 @@ func LoadBlockFromStorage(hash byteutils.Hash, chain *BlockChain) (*Block, error
 	if err != nil {
 		return nil, err
 	}
-	if err := block.WorldState().LoadAccountsRoot(block.StateRoot()); err != nil {
-		return nil, err
-	}
-	if err := block.WorldState().LoadTxsRoot(block.TxsRoot()); err != nil {
-		return nil, err
-	}
-	if err := block.WorldState().LoadEventsRoot(block.EventsRoot()); err != nil {
-		return nil, err
-	}
-	if err := block.WorldState().LoadConsensusRoot(block.ConsensusRoot()); err != nil {
-		return nil, err
+	// the states of pruned blocks are deleted, leave their world state empty
+	if block.height <= chain.prunedHeight && !byteutils.Equal(block.Hash(), GenesisHash) {
+		block.statePruned = true
+	} else {
+		if err := block.WorldState().LoadAccountsRoot(block.StateRoot()); err != nil {
+			return nil, err
+		}
+		if err := block.WorldState().LoadTxsRoot(block.TxsRoot()); err != nil {
+			return nil, err
+		}
+		if err := block.WorldState().LoadEventsRoot(block.EventsRoot()); err != nil {
+			return nil, err
+		}
+		if err := block.WorldState().LoadConsensusRoot(block.ConsensusRoot()); err != nil {
+			return nil, err
+		}
 	}
 	block.sealed = true
 	block.txPool = chain.txPool
 	block.eventEmitter = chain.eventEmitter
 	block.nvm = chain.nvm
+	block.dip = chain.dip
 	block.storage = chain.storage
 	return block, nil
 }
@@ -290,6 +290,14 @@ func (n *mockNeb) Nvm() NVM {
 	return n.nvm
 }
 
+func (n *mockNeb) Nr() NR {
+	return nil
+}
+
+func (n *mockNeb) Dip() Dip {
+	return nil
+}
+
 func (n *mockNeb) StartPprof(string) error {
 	return nil
 }
@@ -319,6 +327,12 @@ func (nvm *mockEngine) Call(source, sourceType, function, args string) (string,
 func (nvm *mockEngine) ExecutionInstructions() uint64 {
 	return uint64(100)
 }
+func (nvm *mockEngine) ExecutionRefund() uint64 {
+	return 0
+}
+func (nvm *mockEngine) SetTrace(trace *TransactionTrace) {
+
+}
 
 func testNeb(t *testing.T) *mockNeb {
 	storage, err := storage.NewMemoryStorage()
@@ -19,6 +19,7 @@
 package core
 
 import (
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -38,6 +39,8 @@ import (
 // scheme -> scheme version
 // genesis hash -> genesis block
 // blockchain_tail -> tail block hash
+// blockchain_pruned -> height of the latest block whose states are pruned
+// genesis_dpos_conf -> dpos parameters in genesis
 // block hash -> block
 // height -> block hash
 
@@ -50,8 +53,9 @@ type BlockChain struct {
 	genesisBlock *Block
 	tailBlock    *Block
 
-	bkPool *BlockPool
-	txPool *TransactionPool
+	bkPool       *BlockPool
+	txPool       *TransactionPool
+	evidencePool *EvidencePool
 
 	consensusHandler Consensus
 	syncService      SyncService
@@ -62,12 +66,21 @@ type BlockChain struct {
 	// latest irreversible block
 	lib *Block
 
+	// the states of blocks not higher than it are pruned, except genesis
+	prunedHeight uint64
+	pruner       *Pruner
+
+	addressIndexer *AddressIndexer
+	gasPriceOracle *GasPriceOracle
+
 	storage storage.Storage
 
 	eventEmitter *EventEmitter
 
 	nvm NVM
 
+	dip Dip
+
 	quitCh chan int
 }
 
@@ -86,6 +99,12 @@ const (
 
 	// LIB (latest irreversible block) in storage
 	LIB = "blockchain_lib"
+
+	// PrunedHeight Key in storage
+	PrunedHeight = "blockchain_pruned"
+
+	// GenesisDposConf Key in storage
+	GenesisDposConf = "genesis_dpos_conf"
 )
 
 // NewBlockChain create new #BlockChain instance.
@@ -128,16 +147,31 @@ func NewBlockChain(neb Neblet) (*BlockChain, error) {
 	if err := txPool.SetGasConfig(gasPrice, gasLimit); err != nil {
 		return nil, err
 	}
+	txPool.SetLimitConfig(time.Duration(neb.Config().Chain.TxPoolTtl)*time.Second, int(neb.Config().Chain.TxPoolSenderLimit))
+	if journal := neb.Config().Chain.TxPoolJournal; len(journal) > 0 {
+		if !filepath.IsAbs(journal) {
+			journal = filepath.Join(neb.Config().Chain.Datadir, journal)
+		}
+		txPool.SetJournal(journal)
+	}
 	txPool.RegisterInNetwork(neb.NetService())
 
+	evidencePool, err := NewEvidencePool(128)
+	if err != nil {
+		return nil, err
+	}
+	evidencePool.RegisterInNetwork(neb.NetService())
+
 	var bc = &BlockChain{
 		chainID:      neb.Config().Chain.ChainId,
 		genesis:      neb.Genesis(),
 		bkPool:       blockPool,
 		txPool:       txPool,
+		evidencePool: evidencePool,
 		storage:      neb.Storage(),
 		eventEmitter: neb.EventEmitter(),
 		nvm:          neb.Nvm(),
+		dip:          neb.Dip(),
 		quitCh:       make(chan int, 1),
 	}
 
@@ -151,8 +185,13 @@ func NewBlockChain(neb Neblet) (*BlockChain, error) {
 		return nil, err
 	}
 
+	bc.pruner = NewPruner(bc, neb.Config().Chain.PruneKeepBlocks)
+	bc.addressIndexer = NewAddressIndexer(bc)
+	bc.gasPriceOracle = NewGasPriceOracle(bc, int(neb.Config().Chain.GasPriceOracleBlocks))
+
 	bc.bkPool.setBlockChain(bc)
 	bc.txPool.setBlockChain(bc)
+	bc.evidencePool.setBlockChain(bc)
 
 	return bc, nil
 }
@@ -166,6 +205,11 @@ func (bc *BlockChain) Setup(neb Neblet) error {
 	}
 
 	var err error
+	bc.prunedHeight, err = bc.LoadPrunedHeightFromStorage()
+	if err != nil {
+		return err
+	}
+
 	bc.genesisBlock, err = bc.LoadGenesisFromStorage()
 	if err != nil {
 		return err
@@ -221,6 +265,12 @@ func (bc *BlockChain) loop() {
 
 // CheckGenesisConfig check if the genesis and config is valid
 func (bc *BlockChain) CheckGenesisConfig(neb Neblet) error {
+	if neb.Genesis() != nil {
+		if err := CheckGenesisDposConf(neb.Genesis()); err != nil {
+			return err
+		}
+	}
+
 	genesis, err := DumpGenesis(bc)
 	//db.genesis has and config lack
 	if neb.Genesis() == nil && err == nil {
@@ -240,17 +290,28 @@ func (bc *BlockChain) CheckGenesisConfig(neb Neblet) error {
 			return ErrInvalidConfigChainID
 		}
 
-		return CheckGenesisConfByDB(genesis, neb.Genesis())
+		if err := CheckGenesisConfByDB(genesis, neb.Genesis()); err != nil {
+			return err
+		}
 	}
 
+	bc.genesis = neb.Genesis()
 	logging.CLog().WithFields(logrus.Fields{
 		"meta.chainid":           neb.Genesis().Meta.ChainId,
 		"consensus.dpos.dynasty": neb.Genesis().Consensus.Dpos.Dynasty,
+		"consensus.dpos.size":    neb.Genesis().Consensus.Dpos.DynastySize,
+		"consensus.dpos.block":   neb.Genesis().Consensus.Dpos.BlockIntervalInMs,
+		"consensus.dpos.round":   neb.Genesis().Consensus.Dpos.DynastyIntervalInMs,
 		"token.distribution":     neb.Genesis().TokenDistribution,
 	}).Info("Genesis Configuration.")
 	return nil
 }
 
+// Genesis return the genesis configuration of the chain.
+func (bc *BlockChain) Genesis() *corepb.Genesis {
+	return bc.genesis
+}
+
 // ChainID return the chainID.
 func (bc *BlockChain) ChainID() uint32 {
 	return bc.chainID
@@ -281,16 +342,34 @@ func (bc *BlockChain) SetLIB(lib *Block) {
 	bc.lib = lib
 }
 
+// PrunedHeight return the height of the latest block whose states are pruned.
+func (bc *BlockChain) PrunedHeight() uint64 {
+	return bc.prunedHeight
+}
+
+// Pruner return the state pruner.
+func (bc *BlockChain) Pruner() *Pruner {
+	return bc.pruner
+}
+
+// AddressIndexer return the indexer of transactions by address.
+func (bc *BlockChain) AddressIndexer() *AddressIndexer {
+	return bc.addressIndexer
+}
+
 // EventEmitter return the eventEmitter.
 func (bc *BlockChain) EventEmitter() *EventEmitter {
 	return bc.eventEmitter
 }
 
-func (bc *BlockChain) triggerRevertBlockEvent(blocks []string) {
+func (bc *BlockChain) triggerRevertBlockEvent(blocks []*Block) {
 	for i := len(blocks) - 1; i >= 0; i-- {
-		bc.eventEmitter.Trigger(&state.Event{
-			Topic: TopicRevertBlock,
-			Data:  blocks[i],
+		bc.eventEmitter.TriggerChainEvent(&ChainEvent{
+			Event: &state.Event{
+				Topic: TopicRevertBlock,
+				Data:  blocks[i].String(),
+			},
+			Block: blocks[i],
 		})
 	}
 }
@@ -298,7 +377,7 @@ func (bc *BlockChain) triggerRevertBlockEvent(blocks []string) {
 func (bc *BlockChain) revertBlocks(from *Block, to *Block) error {
 	reverted := to
 	var revertTimes int64
-	blocks := []string{}
+	blocks := []*Block{}
 	for revertTimes = 0; !reverted.Hash().Equals(from.Hash()); {
 		if reverted.Hash().Equals(bc.lib.Hash()) {
 			return ErrCannotRevertLIB
@@ -309,7 +388,7 @@ func (bc *BlockChain) revertBlocks(from *Block, to *Block) error {
 			"block": reverted,
 		}).Warn("A block is reverted.")
 		revertTimes++
-		blocks = append(blocks, reverted.String())
+		blocks = append(blocks, reverted)
 
 		reverted = bc.GetBlock(reverted.header.parentHash)
 		if reverted == nil {
@@ -333,19 +412,16 @@ func (bc *BlockChain) dropTxsInBlockFromTxPool(block *Block) {
 
 func (bc *BlockChain) triggerNewTailEvent(blocks []*Block) {
 	for i := len(blocks) - 1; i >= 0; i-- {
-		block := blocks[i]
-		bc.eventEmitter.Trigger(&state.Event{
-			Topic: TopicNewTailBlock,
-			Data:  block.String(),
-		})
-
-		for _, v := range block.transactions {
-			events, err := block.FetchEvents(v.hash)
-			if err == nil {
-				for _, e := range events {
-					bc.eventEmitter.Trigger(e)
-				}
-			}
+		events, err := BlockEvents(blocks[i])
+		if err != nil {
+			logging.VLog().WithFields(logrus.Fields{
+				"block": blocks[i],
+				"err":   err,
+			}).Error("Failed to fetch events of new tail block.")
+			continue
+		}
+		for _, e := range events {
+			bc.eventEmitter.TriggerChainEvent(e)
 		}
 	}
 }
@@ -357,6 +433,9 @@ func (bc *BlockChain) buildIndexByBlockHeight(from *Block, to *Block) error {
 		if err != nil {
 			return err
 		}
+		if err := bc.indexEvents(to); err != nil {
+			return err
+		}
 		blocks = append(blocks, to)
 		go bc.dropTxsInBlockFromTxPool(to)
 		to = bc.GetBlock(to.header.parentHash)
@@ -414,6 +493,91 @@ func (bc *BlockChain) SetTailBlock(newTail *Block) error {
 	return nil
 }
 
+// SetStateSyncTail set the block whose states are synced from peers as tail and LIB.
+// The blocks before it are not synced, their states are treated as pruned.
+func (bc *BlockChain) SetStateSyncTail(block *Block) error {
+	if block == nil {
+		return ErrNilArgument
+	}
+
+	bc.bkPool.mu.Lock()
+	defer bc.bkPool.mu.Unlock()
+
+	if bc.tailBlock.Height() != bc.genesisBlock.Height() || block.Height() <= bc.genesisBlock.Height() {
+		return ErrChainNotEmpty
+	}
+	if err := bc.verifyStateSyncTail(block); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"block": block,
+			"err":   err,
+		}).Debug("Failed to verify the tail synced from peers.")
+		return ErrInvalidStateSyncTail
+	}
+
+	if err := bc.StoreBlockToStorage(block); err != nil {
+		return err
+	}
+	if err := bc.storage.Put(byteutils.FromUint64(block.Height()), block.Hash()); err != nil {
+		return err
+	}
+	if err := bc.StorePrunedHeightToStorage(block.Height() - 1); err != nil {
+		return err
+	}
+	bc.prunedHeight = block.Height() - 1
+
+	// load the synced states of block.
+	tail, err := LoadBlockFromStorage(block.Hash(), bc)
+	if err != nil {
+		return err
+	}
+	if err := bc.StoreLIBHashToStorage(tail); err != nil {
+		return err
+	}
+	if err := bc.StoreTailHashToStorage(tail); err != nil {
+		return err
+	}
+	bc.cachedBlocks.Add(tail.Hash().Hex(), tail)
+	bc.lib = tail
+	bc.tailBlock = tail
+
+	logging.CLog().WithFields(logrus.Fields{
+		"tail": tail,
+	}).Info("Set the tail synced from peers.")
+	return nil
+}
+
+// verifyStateSyncTail check the block is signed by the proposer scheduled
+// in the dynasty of its consensus states, which are synced with the block.
+func (bc *BlockChain) verifyStateSyncTail(block *Block) error {
+	if block.ChainID() != bc.chainID {
+		return ErrInvalidChainID
+	}
+	wantedHash, err := block.calHash()
+	if err != nil {
+		return err
+	}
+	if !wantedHash.Equals(block.Hash()) {
+		return ErrInvalidBlockHash
+	}
+	root := block.ConsensusRoot()
+	if root == nil || root.Timestamp != block.Timestamp() {
+		return ErrInvalidStateSyncTail
+	}
+	signer, err := RecoverSignerFromSignature(block.Alg(), block.Hash(), block.Signature())
+	if err != nil {
+		return err
+	}
+
+	worldState, err := state.NewWorldState(bc.ConsensusHandler(), bc.storage)
+	if err != nil {
+		return err
+	}
+	if err := worldState.LoadConsensusRoot(root); err != nil {
+		return err
+	}
+	return worldState.VerifyProposer(root, signer.Bytes())
+}
+
 // GetBlockOnCanonicalChainByHeight return block in given height
 func (bc *BlockChain) GetBlockOnCanonicalChainByHeight(height uint64) *Block {
 
@@ -509,6 +673,11 @@ func (bc *BlockChain) TransactionPool() *TransactionPool {
 	return bc.txPool
 }
 
+// EvidencePool return evidence pool.
+func (bc *BlockChain) EvidencePool() *EvidencePool {
+	return bc.evidencePool
+}
+
 // SetConsensusHandler set consensus handler.
 func (bc *BlockChain) SetConsensusHandler(handler Consensus) {
 	bc.consensusHandler = handler
@@ -624,35 +793,14 @@ func (bc *BlockChain) GetTransaction(hash byteutils.Hash) (*Transaction, error)
 	return tx, nil
 }
 
-// GasPrice returns the lowest transaction gas price.
+// GasPrice returns the standard gas price suggested by the gas price oracle.
 func (bc *BlockChain) GasPrice() *util.Uint128 {
-	gasPrice := TransactionMaxGasPrice
-	tailBlock := bc.TailBlock()
-	// search latest block who has transactions, try 128 times at most
-	for i := 0; i < 128; i++ {
-		// if the block is genesis, stop find the parent block
-		if CheckGenesisBlock(tailBlock) {
-			break
-		}
-
-		if len(tailBlock.transactions) > 0 {
-			break
-		}
-		tailBlock = bc.GetBlock(tailBlock.ParentHash())
-	}
-
-	if len(tailBlock.transactions) > 0 {
-		for _, tx := range tailBlock.transactions {
-			if tx.gasPrice.Cmp(gasPrice) < 0 {
-				gasPrice = tx.gasPrice
-			}
-		}
-	} else {
-		// if no transactions have been submitted, use the default gasPrice
-		gasPrice = TransactionGasPrice
-	}
+	return bc.gasPriceOracle.Suggest().Standard
+}
 
-	return gasPrice
+// GasPriceOracle return the gas price oracle.
+func (bc *BlockChain) GasPriceOracle() *GasPriceOracle {
+	return bc.gasPriceOracle
 }
 
 // SimulateResult the result of simulating transaction execution
@@ -664,12 +812,20 @@ type SimulateResult struct {
 
 // SimulateTransactionExecution execute transaction in sandbox and rollback all changes, used to EstimateGas and Call api.
 func (bc *BlockChain) SimulateTransactionExecution(tx *Transaction) (*SimulateResult, error) {
-	if tx == nil {
+	return bc.SimulateTransactionExecutionAt(tx, bc.TailBlock())
+}
+
+// SimulateTransactionExecutionAt execute transaction in sandbox on top of the state of parent block.
+func (bc *BlockChain) SimulateTransactionExecutionAt(tx *Transaction, parent *Block) (*SimulateResult, error) {
+	if tx == nil || parent == nil {
 		return nil, ErrInvalidArgument
 	}
+	if parent.StatePruned() {
+		return nil, ErrStatePruned
+	}
 
 	// create block.
-	block, err := bc.NewBlock(GenesisCoinbase)
+	block, err := bc.NewBlockFromParent(GenesisCoinbase, parent)
 	if err != nil {
 		return nil, err
 	}
@@ -722,6 +878,47 @@ func (bc *BlockChain) StoreLIBHashToStorage(block *Block) error {
 	return bc.storage.Put([]byte(LIB), block.Hash())
 }
 
+// StorePrunedHeightToStorage store the pruned height
+func (bc *BlockChain) StorePrunedHeightToStorage(height uint64) error {
+	return bc.storage.Put([]byte(PrunedHeight), byteutils.FromUint64(height))
+}
+
+// StoreGenesisDposConfToStorage store the dpos parameters in genesis
+func (bc *BlockChain) StoreGenesisDposConfToStorage(conf *corepb.GenesisConsensusDpos) error {
+	// the dynasty is kept in the genesis block
+	params := &corepb.GenesisConsensusDpos{
+		BlockIntervalInMs:        conf.BlockIntervalInMs,
+		DynastyIntervalInMs:      conf.DynastyIntervalInMs,
+		DynastySize:              conf.DynastySize,
+		AcceptedNetworkDelayInMs: conf.AcceptedNetworkDelayInMs,
+		MinMintDurationInMs:      conf.MinMintDurationInMs,
+		MaxMintDurationInMs:      conf.MaxMintDurationInMs,
+	}
+	fillGenesisDposConf(params)
+	value, err := proto.Marshal(params)
+	if err != nil {
+		return err
+	}
+	return bc.storage.Put([]byte(GenesisDposConf), value)
+}
+
+// LoadGenesisDposConfFromStorage load the dpos parameters in genesis,
+// chains created before the parameters were configurable use the default values
+func (bc *BlockChain) LoadGenesisDposConfFromStorage() (*corepb.GenesisConsensusDpos, error) {
+	conf := new(corepb.GenesisConsensusDpos)
+	value, err := bc.storage.Get([]byte(GenesisDposConf))
+	if err != nil && err != storage.ErrKeyNotFound {
+		return nil, err
+	}
+	if err == nil {
+		if err := proto.Unmarshal(value, conf); err != nil {
+			return nil, err
+		}
+	}
+	fillGenesisDposConf(conf)
+	return conf, nil
+}
+
 // LoadTailFromStorage load tail block
 func (bc *BlockChain) LoadTailFromStorage() (*Block, error) {
 	hash, err := bc.storage.Get([]byte(Tail))
@@ -758,6 +955,9 @@ func (bc *BlockChain) LoadGenesisFromStorage() (*Block, error) { // ToRefine, re
 		if err := bc.StoreBlockToStorage(genesis); err != nil {
 			return nil, err
 		}
+		if err := bc.StoreGenesisDposConfToStorage(bc.genesis.Consensus.Dpos); err != nil {
+			return nil, err
+		}
 		heightKey := byteutils.FromUint64(genesis.height)
 		if err := bc.storage.Put(heightKey, genesis.Hash()); err != nil {
 			return nil, err
@@ -766,6 +966,18 @@ func (bc *BlockChain) LoadGenesisFromStorage() (*Block, error) { // ToRefine, re
 	return genesis, nil
 }
 
+// LoadPrunedHeightFromStorage load the pruned height, 0 if never pruned
+func (bc *BlockChain) LoadPrunedHeightFromStorage() (uint64, error) {
+	value, err := bc.storage.Get([]byte(PrunedHeight))
+	if err == storage.ErrKeyNotFound {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return byteutils.Uint64(value), nil
+}
+
 // LoadLIBFromStorage load LIB
 func (bc *BlockChain) LoadLIBFromStorage() (*Block, error) {
 	hash, err := bc.storage.Get([]byte(LIB))
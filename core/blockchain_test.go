@@ -165,6 +165,34 @@ func TestBlockChain_SimulateTransactionExecution(t *testing.T) {
 	assert.Equal(t, expectedGasUsed, result.GasUsed)
 }
 
+func TestBlockChain_SimulateTransactionExecutionAt(t *testing.T) {
+	priv := secp256k1.GeneratePrivateKey()
+	pubdata, _ := priv.PublicKey().Encoded()
+	from, _ := NewAddressFromPublicKey(pubdata)
+	to := &Address{from.address}
+
+	payload, err := NewBinaryPayload(nil).ToBytes()
+	assert.Nil(t, err)
+
+	neb := testNeb(t)
+	bc := neb.chain
+	gasLimit, _ := util.NewUint128FromInt(200000)
+	tx, _ := NewTransaction(bc.ChainID(), from, to, util.NewUint128(), 1, TxPayloadBinaryType, payload, TransactionGasPrice, gasLimit)
+
+	_, err = bc.SimulateTransactionExecutionAt(tx, nil)
+	assert.Equal(t, ErrInvalidArgument, err)
+
+	result, err := bc.SimulateTransactionExecutionAt(tx, bc.GenesisBlock())
+	assert.Nil(t, err)
+	assert.Equal(t, ErrInsufficientBalance, result.Err)
+
+	pruned := bc.GenesisBlock()
+	pruned.statePruned = true
+	defer func() { pruned.statePruned = false }()
+	_, err = bc.SimulateTransactionExecutionAt(tx, pruned)
+	assert.Equal(t, ErrStatePruned, err)
+}
+
 func TestTailBlock(t *testing.T) {
 	neb := testNeb(t)
 	bc := neb.chain
@@ -261,5 +289,6 @@ func TestGetPrice(t *testing.T) {
 	block.Seal()
 	block.Sign(signature)
 	bc.SetTailBlock(block)
-	assert.Equal(t, bc.GasPrice(), lowerGasPrice)
+	// not lower than the lowest gas price of pool.
+	assert.Equal(t, bc.GasPrice(), TransactionGasPrice)
 }
@@ -0,0 +1,189 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"encoding/json"
+
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// types of contract function args declared in abi.
+const (
+	ContractArgTypeString = "string"
+	ContractArgTypeNumber = "number"
+	ContractArgTypeBool   = "bool"
+	ContractArgTypeObject = "object"
+	ContractArgTypeArray  = "array"
+	ContractArgTypeAny    = "any"
+)
+
+// ContractABIHeight is the height from which the abi in deploy payloads is stored,
+// the abi of the contracts deployed before it is ignored.
+var ContractABIHeight uint64 = 2000000
+
+// ContractFunction is a function declared in a contract abi.
+type ContractFunction struct {
+	Name string `json:"name"`
+	// Args are the types of the function args.
+	Args []string `json:"args"`
+	// ReadOnly functions cannot change the storage of the contract, calls writing it fail.
+	ReadOnly bool `json:"read_only"`
+	Payable  bool `json:"payable"`
+}
+
+// ContractABI is the interface a contract declares at deploy time.
+type ContractABI struct {
+	Functions []*ContractFunction `json:"functions"`
+}
+
+// ParseContractABI parse and check a json abi
+func ParseContractABI(abi string) (*ContractABI, error) {
+	contractABI := &ContractABI{}
+	if err := json.Unmarshal([]byte(abi), contractABI); err != nil {
+		return nil, ErrInvalidContractABI
+	}
+	if len(contractABI.Functions) == 0 {
+		return nil, ErrInvalidContractABI
+	}
+
+	names := make(map[string]bool)
+	for _, fn := range contractABI.Functions {
+		if fn == nil || !PublicFuncNameChecker.MatchString(fn.Name) || names[fn.Name] {
+			return nil, ErrInvalidContractABI
+		}
+		names[fn.Name] = true
+		for _, arg := range fn.Args {
+			switch arg {
+			case ContractArgTypeString, ContractArgTypeNumber, ContractArgTypeBool,
+				ContractArgTypeObject, ContractArgTypeArray, ContractArgTypeAny:
+			default:
+				return nil, ErrInvalidContractABI
+			}
+		}
+	}
+	return contractABI, nil
+}
+
+// Function returns the declared function of name, nil if not found.
+func (abi *ContractABI) Function(name string) *ContractFunction {
+	for _, fn := range abi.Functions {
+		if fn.Name == name {
+			return fn
+		}
+	}
+	return nil
+}
+
+// CheckCall checks the function, args and value of a call against the abi.
+func (abi *ContractABI) CheckCall(function, args string, value *util.Uint128) error {
+	fn := abi.Function(function)
+	if fn == nil {
+		return ErrFunctionNotInContractABI
+	}
+	if !fn.Payable && value != nil && value.Cmp(util.NewUint128()) > 0 {
+		return ErrFunctionNotPayable
+	}
+
+	var argsObj []interface{}
+	if len(args) > 0 {
+		if err := json.Unmarshal([]byte(args), &argsObj); err != nil {
+			return ErrInvalidArgument
+		}
+	}
+	if len(argsObj) != len(fn.Args) {
+		return ErrArgsMismatchContractABI
+	}
+	for i, arg := range argsObj {
+		if !matchContractArgType(fn.Args[i], arg) {
+			return ErrArgsMismatchContractABI
+		}
+	}
+	return nil
+}
+
+func matchContractArgType(argType string, arg interface{}) bool {
+	switch arg.(type) {
+	case string:
+		return argType == ContractArgTypeString || argType == ContractArgTypeAny
+	case float64:
+		return argType == ContractArgTypeNumber || argType == ContractArgTypeAny
+	case bool:
+		return argType == ContractArgTypeBool || argType == ContractArgTypeAny
+	case map[string]interface{}:
+		return argType == ContractArgTypeObject || argType == ContractArgTypeAny
+	case []interface{}:
+		return argType == ContractArgTypeArray || argType == ContractArgTypeAny
+	}
+	return argType == ContractArgTypeAny
+}
+
+// ContractABIOf returns the abi of the contract, nil if the contract declares none.
+func ContractABIOf(contract state.Account) (*ContractABI, error) {
+	bytes, err := contract.Get(contractABIKey)
+	if err == storage.ErrKeyNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return ParseContractABI(string(bytes))
+}
+
+// setContractABI stores the abi of the contract, an empty abi removes the old one.
+func setContractABI(contract state.Account, abi string) error {
+	if len(abi) == 0 {
+		if err := contract.Del(contractABIKey); err != nil && err != storage.ErrKeyNotFound {
+			return err
+		}
+		return nil
+	}
+	return contract.Put(contractABIKey, []byte(abi))
+}
+
+// CheckReadOnlyCall fails the call of a read-only function which changed the storage
+// of the contract, varsHash is the storage root of the contract before the call.
+func CheckReadOnlyCall(contract state.Account, function string, varsHash byteutils.Hash) error {
+	abi, err := ContractABIOf(contract)
+	if err != nil {
+		return err
+	}
+	if abi == nil {
+		return nil
+	}
+	if fn := abi.Function(function); fn != nil && fn.ReadOnly && !varsHash.Equals(contract.VarsHash()) {
+		return ErrReadOnlyFunctionWrite
+	}
+	return nil
+}
+
+// CheckContractCall checks a call of the contract against its abi, if any.
+func CheckContractCall(contract state.Account, function, args string, value *util.Uint128) error {
+	abi, err := ContractABIOf(contract)
+	if err != nil {
+		return err
+	}
+	if abi == nil {
+		return nil
+	}
+	return abi.CheckCall(function, args, value)
+}
@@ -0,0 +1,100 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseContractABI(t *testing.T) {
+	tests := []struct {
+		name    string
+		abi     string
+		wantErr error
+	}{
+		{"valid", `{"functions":[{"name":"balanceOf","args":["string"],"read_only":true},{"name":"deposit","payable":true}]}`, nil},
+		{"invalid json", `{"functions":`, ErrInvalidContractABI},
+		{"no functions", `{"functions":[]}`, ErrInvalidContractABI},
+		{"invalid name", `{"functions":[{"name":"1balance"}]}`, ErrInvalidContractABI},
+		{"duplicated name", `{"functions":[{"name":"get"},{"name":"get"}]}`, ErrInvalidContractABI},
+		{"unknown arg type", `{"functions":[{"name":"get","args":["int"]}]}`, ErrInvalidContractABI},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseContractABI(tt.abi)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestContractABI_CheckCall(t *testing.T) {
+	abi, err := ParseContractABI(`{"functions":[
+		{"name":"transfer","args":["string","number","bool"]},
+		{"name":"save","args":["object","array","any"],"payable":true}
+	]}`)
+	assert.Nil(t, err)
+
+	value, _ := util.NewUint128FromInt(1)
+	tests := []struct {
+		name     string
+		function string
+		args     string
+		value    *util.Uint128
+		wantErr  error
+	}{
+		{"match", "transfer", `["n1", 10, true]`, util.NewUint128(), nil},
+		{"undeclared function", "burn", "", util.NewUint128(), ErrFunctionNotInContractABI},
+		{"missing args", "transfer", `["n1", 10]`, util.NewUint128(), ErrArgsMismatchContractABI},
+		{"no args", "transfer", "", util.NewUint128(), ErrArgsMismatchContractABI},
+		{"wrong type", "transfer", `["n1", "10", true]`, util.NewUint128(), ErrArgsMismatchContractABI},
+		{"not payable", "transfer", `["n1", 10, true]`, value, ErrFunctionNotPayable},
+		{"payable", "save", `[{"a":1}, [1], null]`, value, nil},
+		{"wrong object", "save", `[[1], [1], null]`, value, ErrArgsMismatchContractABI},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantErr, abi.CheckCall(tt.function, tt.args, tt.value))
+		})
+	}
+}
+
+func TestCheckReadOnlyCall(t *testing.T) {
+	stor, _ := storage.NewMemoryStorage()
+	as, err := state.NewAccountState(nil, stor)
+	assert.Nil(t, err)
+	contract, err := as.GetOrCreateUserAccount([]byte("contract"))
+	assert.Nil(t, err)
+
+	// contracts without abi have no read-only functions.
+	varsHash := contract.VarsHash()
+	assert.Nil(t, contract.Put([]byte("key"), []byte("value")))
+	assert.Nil(t, CheckReadOnlyCall(contract, "balanceOf", varsHash))
+
+	assert.Nil(t, setContractABI(contract, `{"functions":[{"name":"balanceOf","read_only":true},{"name":"deposit"}]}`))
+	varsHash = contract.VarsHash()
+	assert.Nil(t, CheckReadOnlyCall(contract, "balanceOf", varsHash))
+	assert.Nil(t, contract.Put([]byte("key"), []byte("changed")))
+	assert.Equal(t, ErrReadOnlyFunctionWrite, CheckReadOnlyCall(contract, "balanceOf", varsHash))
+	assert.Nil(t, CheckReadOnlyCall(contract, "deposit", varsHash))
+}
@@ -0,0 +1,153 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"encoding/json"
+
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+var (
+	// reserved raw keys in the contract storage for the upgrade history, the upgrader and the abi,
+	// they never collide with the hashed keys used by the contract itself.
+	contractUpgradesKey = []byte("contract_upgrades")
+	contractUpgraderKey = []byte("contract_upgrader")
+	contractABIKey      = []byte("contract_abi")
+)
+
+// ContractUpgradeHeight is the height from which contracts can be deployed upgradable,
+// the upgradable flag of the contracts deployed before it is ignored.
+var ContractUpgradeHeight uint64 = 2000000
+
+// ContractVersion is a version of the source of a contract.
+type ContractVersion struct {
+	Version uint64 `json:"version"`
+	// Hash of the transaction deploying or upgrading the source.
+	Hash      string `json:"hash"`
+	From      string `json:"from"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+func contractUpgrades(contract state.Account) ([]*ContractVersion, error) {
+	bytes, err := contract.Get(contractUpgradesKey)
+	if err == storage.ErrKeyNotFound {
+		return []*ContractVersion{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	upgrades := []*ContractVersion{}
+	if err := json.Unmarshal(bytes, &upgrades); err != nil {
+		return nil, err
+	}
+	return upgrades, nil
+}
+
+func recordContractUpgrade(contract state.Account, tx *Transaction) error {
+	upgrades, err := contractUpgrades(contract)
+	if err != nil {
+		return err
+	}
+	upgrades = append(upgrades, &ContractVersion{
+		Version:   uint64(len(upgrades) + 1),
+		Hash:      tx.hash.String(),
+		From:      tx.from.String(),
+		Timestamp: tx.timestamp,
+	})
+	bytes, err := json.Marshal(upgrades)
+	if err != nil {
+		return err
+	}
+	return contract.Put(contractUpgradesKey, bytes)
+}
+
+func setContractUpgrader(contract state.Account, addr *Address) error {
+	return contract.Put(contractUpgraderKey, addr.Bytes())
+}
+
+// checkContractUpgrader returns whether addr deployed the contract with the upgradable flag.
+// The contracts deployed without it cannot be upgraded.
+func checkContractUpgrader(contract state.Account, addr *Address) (bool, error) {
+	upgrader, err := contract.Get(contractUpgraderKey)
+	if err == storage.ErrKeyNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return byteutils.Equal(upgrader, addr.Bytes()), nil
+}
+
+// ContractVersions returns the deployed source of the contract followed by its upgrades.
+func ContractVersions(contract state.Account, ws WorldState) ([]*ContractVersion, error) {
+	birthTx, err := GetTransaction(contract.BirthPlace(), ws)
+	if err != nil {
+		return nil, err
+	}
+	upgrades, err := contractUpgrades(contract)
+	if err != nil {
+		return nil, err
+	}
+	versions := []*ContractVersion{
+		{
+			Version:   0,
+			Hash:      birthTx.hash.String(),
+			From:      birthTx.from.String(),
+			Timestamp: birthTx.timestamp,
+		},
+	}
+	return append(versions, upgrades...), nil
+}
+
+// ContractSource returns the source and source type of the current version of the contract.
+func ContractSource(contract state.Account, ws WorldState) (string, string, error) {
+	hash := contract.BirthPlace()
+	upgrades, err := contractUpgrades(contract)
+	if err != nil {
+		return "", "", err
+	}
+	if len(upgrades) > 0 {
+		if hash, err = byteutils.FromHex(upgrades[len(upgrades)-1].Hash); err != nil {
+			return "", "", err
+		}
+	}
+
+	tx, err := GetTransaction(hash, ws)
+	if err != nil {
+		return "", "", err
+	}
+	switch tx.data.Type {
+	case TxPayloadDeployType:
+		deploy, err := LoadDeployPayload(tx.data.Payload)
+		if err != nil {
+			return "", "", err
+		}
+		return deploy.Source, deploy.SourceType, nil
+	case TxPayloadUpgradeType:
+		upgrade, err := LoadUpgradePayload(tx.data.Payload)
+		if err != nil {
+			return "", "", err
+		}
+		return upgrade.Source, upgrade.SourceType, nil
+	}
+	return "", "", ErrInvalidTxPayloadType
+}
@@ -0,0 +1,120 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/stretchr/testify/assert"
+)
+
+func mockUpgradeTransaction(chainID uint32, nonce uint64, source string) *Transaction {
+	upgradePayload, _ := NewUpgradePayload(source, SourceTypeJavaScript, "")
+	payload, _ := upgradePayload.ToBytes()
+	return mockTransaction(chainID, nonce, TxPayloadUpgradeType, payload)
+}
+
+func TestUpgradePayload_Execute(t *testing.T) {
+	neb := testNeb(t)
+	bc := neb.chain
+	block := bc.tailBlock
+	block.Begin()
+
+	// deploy a contract.
+	deployTx := mockDeployTransaction(bc.chainID, 1)
+	contractAddr, err := deployTx.GenerateContractAddress()
+	assert.Nil(t, err)
+	txWorldState, err := block.WorldState().Prepare(deployTx.Hash().String())
+	assert.Nil(t, err)
+	_, err = AcceptTransaction(deployTx, txWorldState)
+	assert.Nil(t, err)
+	_, err = txWorldState.CreateContractAccount(contractAddr.Bytes(), deployTx.Hash())
+	assert.Nil(t, err)
+	event, err := json.Marshal(&TransactionEvent{Hash: deployTx.Hash().String(), Status: TxExecutionSuccess})
+	assert.Nil(t, err)
+	txWorldState.RecordEvent(deployTx.Hash(), &state.Event{Topic: TopicTransactionExecutionResult, Data: string(event)})
+	_, err = txWorldState.CheckAndUpdate()
+	assert.Nil(t, err)
+
+	upgrade := func(tx *Transaction) error {
+		tx.to = contractAddr
+		payload, err := tx.LoadPayload()
+		assert.Nil(t, err)
+		txWorldState, err := block.WorldState().Prepare(tx.Hash().String())
+		assert.Nil(t, err)
+		_, err = AcceptTransaction(tx, txWorldState)
+		assert.Nil(t, err)
+		_, _, err = payload.Execute(TransactionMaxGas, tx, block, txWorldState)
+		_, updateErr := txWorldState.CheckAndUpdate()
+		assert.Nil(t, updateErr)
+		return err
+	}
+
+	// contracts deployed without the upgradable flag cannot be upgraded.
+	deployerTx := mockUpgradeTransaction(bc.chainID, 2, "v1")
+	deployerTx.from = deployTx.from
+	assert.Equal(t, ErrUnauthorizedContractUpgrade, upgrade(deployerTx))
+
+	// nor by naming an upgrader in the contract storage.
+	strangerTx := mockUpgradeTransaction(bc.chainID, 1, "stranger")
+	contract, err := block.WorldState().GetContractAccount(contractAddr.Bytes())
+	assert.Nil(t, err)
+	upgrader, err := json.Marshal(strangerTx.from.String())
+	assert.Nil(t, err)
+	assert.Nil(t, contract.Put(trie.HashDomains("_", "upgrader"), upgrader))
+	assert.Equal(t, ErrUnauthorizedContractUpgrade, upgrade(strangerTx))
+
+	// only the deployer can upgrade an upgradable contract.
+	contract, err = block.WorldState().GetContractAccount(contractAddr.Bytes())
+	assert.Nil(t, err)
+	assert.Nil(t, setContractUpgrader(contract, deployTx.from))
+	stranger := strangerTx.from
+	strangerTx = mockUpgradeTransaction(bc.chainID, 2, "stranger")
+	strangerTx.from = stranger
+	assert.Equal(t, ErrUnauthorizedContractUpgrade, upgrade(strangerTx))
+
+	deployerTx = mockUpgradeTransaction(bc.chainID, 3, "v1")
+	deployerTx.from = deployTx.from
+	assert.Nil(t, upgrade(deployerTx))
+	lastTx := mockUpgradeTransaction(bc.chainID, 4, "v2")
+	lastTx.from = deployTx.from
+	assert.Nil(t, upgrade(lastTx))
+
+	contract, err = block.WorldState().GetContractAccount(contractAddr.Bytes())
+	assert.Nil(t, err)
+	source, sourceType, err := ContractSource(contract, block.WorldState())
+	assert.Nil(t, err)
+	assert.Equal(t, "v2", source)
+	assert.Equal(t, SourceTypeJavaScript, sourceType)
+
+	versions, err := ContractVersions(contract, block.WorldState())
+	assert.Nil(t, err)
+	hashes := []string{}
+	for i, v := range versions {
+		assert.Equal(t, uint64(i), v.Version)
+		hashes = append(hashes, v.Hash)
+	}
+	assert.Equal(t, []string{deployTx.Hash().String(), deployerTx.Hash().String(), lastTx.Hash().String()}, hashes)
+	assert.Equal(t, deployTx.from.String(), versions[1].From)
+
+	block.RollBack()
+}
@@ -33,6 +33,9 @@ const (
 	// TopicPendingTransaction the topic of pending a transaction in transaction_pool.
 	TopicPendingTransaction = "chain.pendingTransaction"
 
+	// TopicDropTransaction the topic of drop a transaction from transaction_pool.
+	TopicDropTransaction = "chain.dropTransaction"
+
 	// TopicSendTransaction the topic of send a transaction.
 	TopicSendTransaction = "chain.sendTransaction"
 
@@ -48,6 +51,12 @@ const (
 	// TopicCandidate the topic of candidate.
 	TopicCandidate = "chain.candidate"
 
+	// TopicDip the topic of developer incentive reward.
+	TopicDip = "chain.dip"
+
+	// TopicSlash the topic of slashing a double minting miner.
+	TopicSlash = "chain.slash"
+
 	// TopicLinkBlock the topic of link a block.
 	TopicLinkBlock = "chain.linkBlock"
 
@@ -64,31 +73,79 @@ const (
 	TopicRevertBlock = "chain.revertBlock"
 )
 
+// ChainEvent is an event with where it's emitted. The block is nil for events not in blocks,
+// and the transaction is nil for events not emitted by transactions.
+type ChainEvent struct {
+	*state.Event
+
+	Block *Block
+	Tx    *Transaction
+
+	// Index is the position of event in the events of block, see BlockEvents.
+	Index uint64
+}
+
+// Contract return the contract called or deployed by the transaction emitting event, nil if none.
+func (e *ChainEvent) Contract() *Address {
+	if e.Tx == nil {
+		return nil
+	}
+	return eventContract(e.Tx)
+}
+
+// BlockEvents return the events emitted when the block becomes on canonical chain,
+// a TopicNewTailBlock event followed by the events of transactions in order.
+func BlockEvents(block *Block) ([]*ChainEvent, error) {
+	events := []*ChainEvent{
+		{
+			Event: &state.Event{Topic: TopicNewTailBlock, Data: block.String()},
+			Block: block,
+		},
+	}
+	for _, tx := range block.transactions {
+		txEvents, err := block.FetchEvents(tx.hash)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range txEvents {
+			events = append(events, &ChainEvent{Event: e, Block: block, Tx: tx, Index: uint64(len(events))})
+		}
+	}
+	return events, nil
+}
+
 // EventSubscriber subscriber object
 type EventSubscriber struct {
-	eventCh chan *state.Event
-	topics  []string
+	eventCh    chan *ChainEvent
+	overflowCh chan bool
+	topics     []string
 }
 
 // NewEventSubscriber returns an EventSubscriber
 func NewEventSubscriber(size int, topics []string) *EventSubscriber {
-	eventCh := make(chan *state.Event, size)
+	eventCh := make(chan *ChainEvent, size)
 	subscriber := &EventSubscriber{
-		eventCh: eventCh,
-		topics:  topics,
+		eventCh:    eventCh,
+		overflowCh: make(chan bool, 1),
+		topics:     topics,
 	}
 	return subscriber
 }
 
 // EventChan returns subscriber's eventCh
-func (s *EventSubscriber) EventChan() chan *state.Event {
+func (s *EventSubscriber) EventChan() chan *ChainEvent {
 	return s.eventCh
 }
 
+// OverflowChan returns a chan notified when events are dropped because eventCh is full.
+func (s *EventSubscriber) OverflowChan() chan bool {
+	return s.overflowCh
+}
+
 // EventEmitter provide event functionality for Nebulas.
 type EventEmitter struct {
 	eventSubs *sync.Map
-	eventCh   chan *state.Event
+	eventCh   chan *ChainEvent
 	quitCh    chan int
 	size      int
 }
@@ -97,7 +154,7 @@ type EventEmitter struct {
 func NewEventEmitter(size int) *EventEmitter {
 	return &EventEmitter{
 		eventSubs: new(sync.Map),
-		eventCh:   make(chan *state.Event, size),
+		eventCh:   make(chan *ChainEvent, size),
 		quitCh:    make(chan int, 1),
 		size:      size,
 	}
@@ -123,6 +180,11 @@ func (emitter *EventEmitter) Stop() {
 
 // Trigger trigger event.
 func (emitter *EventEmitter) Trigger(e *state.Event) {
+	emitter.eventCh <- &ChainEvent{Event: e}
+}
+
+// TriggerChainEvent trigger event with where it's emitted.
+func (emitter *EventEmitter) TriggerChainEvent(e *ChainEvent) {
 	emitter.eventCh <- e
 }
 
@@ -171,12 +233,17 @@ func (emitter *EventEmitter) loop() {
 
 			m, _ := v.(*sync.Map)
 			m.Range(func(key, value interface{}) bool {
+				subscriber := key.(*EventSubscriber)
 				select {
-				case key.(*EventSubscriber).eventCh <- e:
+				case subscriber.eventCh <- e:
 				default:
 					logging.VLog().WithFields(logrus.Fields{
 						"topic": topic,
 					}).Debug("timeout to dispatch event.")
+					select {
+					case subscriber.overflowCh <- true:
+					default:
+					}
 				}
 				return true
 			})
@@ -0,0 +1,98 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// EventFilter matches events by the transactions emitting them and their data fields.
+// Empty conditions match all events.
+type EventFilter struct {
+	Contract *Address
+	From     *Address
+	To       *Address
+
+	// Fields of the JSON data of events, nested fields are joined by ".".
+	Fields map[string]string
+}
+
+// Match return whether the event matches the filter.
+func (f *EventFilter) Match(e *ChainEvent) bool {
+	if f.Contract != nil || f.From != nil || f.To != nil {
+		if e.Tx == nil {
+			return false
+		}
+		if f.From != nil && !f.From.Equals(e.Tx.From()) {
+			return false
+		}
+		if f.To != nil && !f.To.Equals(e.Tx.To()) {
+			return false
+		}
+		if f.Contract != nil && !f.Contract.Equals(eventContract(e.Tx)) {
+			return false
+		}
+	}
+
+	if len(f.Fields) == 0 {
+		return true
+	}
+	var data interface{}
+	if err := json.Unmarshal([]byte(e.Data), &data); err != nil {
+		return false
+	}
+	for field, value := range f.Fields {
+		v := data
+		for _, name := range strings.Split(field, ".") {
+			m, ok := v.(map[string]interface{})
+			if !ok {
+				return false
+			}
+			if v, ok = m[name]; !ok {
+				return false
+			}
+		}
+		if s, ok := v.(string); ok {
+			if s != value {
+				return false
+			}
+			continue
+		}
+		if bytes, err := json.Marshal(v); err != nil || string(bytes) != value {
+			return false
+		}
+	}
+	return true
+}
+
+// eventContract return the contract called or deployed by tx, nil if none.
+func eventContract(tx *Transaction) *Address {
+	switch tx.Type() {
+	case TxPayloadCallType, TxPayloadUpgradeType:
+		return tx.To()
+	case TxPayloadDeployType:
+		addr, err := tx.GenerateContractAddress()
+		if err != nil {
+			return nil
+		}
+		return addr
+	}
+	return nil
+}
@@ -0,0 +1,241 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"github.com/nebulasio/go-nebulas/crypto/hash"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// const
+const (
+	// EventBloomPrefix the prefix of event bloom key in storage.
+	EventBloomPrefix = "event_bloom_"
+
+	// BloomByteLength the byte length of event bloom.
+	BloomByteLength = 256
+
+	bloomBitLength = BloomByteLength * 8
+	bloomHashCount = 3
+)
+
+// Bloom is a bloom filter of the contracts and topics of events in a block.
+type Bloom [BloomByteLength]byte
+
+// Add add data to bloom.
+func (b *Bloom) Add(data []byte) {
+	h := hash.Sha3256(data)
+	for i := 0; i < bloomHashCount; i++ {
+		bit := (uint(h[2*i])<<8 | uint(h[2*i+1])) % bloomBitLength
+		b[bit/8] |= 1 << (bit % 8)
+	}
+}
+
+// Test return whether data may be in bloom.
+func (b *Bloom) Test(data []byte) bool {
+	h := hash.Sha3256(data)
+	for i := 0; i < bloomHashCount; i++ {
+		bit := (uint(h[2*i])<<8 | uint(h[2*i+1])) % bloomBitLength
+		if b[bit/8]&(1<<(bit%8)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// EventsBloom return the bloom of the topics and contracts of events.
+func EventsBloom(events []*ChainEvent) *Bloom {
+	bloom := new(Bloom)
+	for _, e := range events {
+		bloom.Add([]byte(e.Topic))
+		if e.Tx == nil {
+			continue
+		}
+		if contract := eventContract(e.Tx); contract != nil {
+			bloom.Add(contract.Bytes())
+		}
+	}
+	return bloom
+}
+
+// LogFilter matches the events in a block range by contracts and topics.
+// Events match any of the contracts and any of the topics, empty conditions match all events.
+type LogFilter struct {
+	FromHeight uint64
+	ToHeight   uint64
+	Contracts  []*Address
+	Topics     []string
+}
+
+// MayMatch return whether the block of bloom may have matched events.
+func (f *LogFilter) MayMatch(bloom *Bloom) bool {
+	if len(f.Contracts) > 0 {
+		found := false
+		for _, contract := range f.Contracts {
+			if bloom.Test(contract.Bytes()) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if len(f.Topics) > 0 {
+		for _, topic := range f.Topics {
+			if bloom.Test([]byte(topic)) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// Match return whether the event matches the filter.
+func (f *LogFilter) Match(e *ChainEvent) bool {
+	if len(f.Contracts) > 0 {
+		if e.Tx == nil {
+			return false
+		}
+		contract := eventContract(e.Tx)
+		if contract == nil {
+			return false
+		}
+		found := false
+		for _, c := range f.Contracts {
+			if c.Equals(contract) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if len(f.Topics) > 0 {
+		for _, topic := range f.Topics {
+			if topic == e.Topic {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+func eventBloomKey(blockHash byteutils.Hash) []byte {
+	return append([]byte(EventBloomPrefix), blockHash...)
+}
+
+// StoreEventBloomToStorage store the bloom of block's events.
+func (bc *BlockChain) StoreEventBloomToStorage(block *Block, bloom *Bloom) error {
+	return bc.storage.Put(eventBloomKey(block.Hash()), bloom[:])
+}
+
+// LoadEventBloomFromStorage load the bloom of block's events, nil if not indexed.
+func (bc *BlockChain) LoadEventBloomFromStorage(blockHash byteutils.Hash) (*Bloom, error) {
+	value, err := bc.storage.Get(eventBloomKey(blockHash))
+	if err != nil && err != storage.ErrKeyNotFound {
+		return nil, err
+	}
+	if err == storage.ErrKeyNotFound || len(value) != BloomByteLength {
+		return nil, nil
+	}
+	bloom := new(Bloom)
+	copy(bloom[:], value)
+	return bloom, nil
+}
+
+// indexEvents store the bloom of block's events if not indexed yet.
+func (bc *BlockChain) indexEvents(block *Block) error {
+	bloom, err := bc.LoadEventBloomFromStorage(block.Hash())
+	if err != nil || bloom != nil {
+		return err
+	}
+	if block.StatePruned() {
+		return nil
+	}
+	events, err := BlockEvents(block)
+	if err != nil {
+		return err
+	}
+	return bc.StoreEventBloomToStorage(block, EventsBloom(events))
+}
+
+// GetLogs return the events matched by filter in the blocks on canonical chain.
+// The blocks are skipped by their event blooms before loaded, ErrLogRangeTooLarge is returned
+// if the range has more than maxRange blocks, and ErrTooManyLogs if more than limit events matched.
+func (bc *BlockChain) GetLogs(filter *LogFilter, maxRange uint64, limit int) ([]*ChainEvent, error) {
+	tail := bc.TailBlock()
+	if filter.ToHeight == 0 || filter.ToHeight > tail.Height() {
+		filter.ToHeight = tail.Height()
+	}
+	if filter.FromHeight == 0 {
+		filter.FromHeight = filter.ToHeight
+	}
+	if filter.FromHeight > filter.ToHeight {
+		return nil, ErrInvalidLogRange
+	}
+	if filter.ToHeight-filter.FromHeight >= maxRange {
+		return nil, ErrLogRangeTooLarge
+	}
+
+	logs := []*ChainEvent{}
+	for height := filter.FromHeight; height <= filter.ToHeight; height++ {
+		blockHash, err := bc.storage.Get(byteutils.FromUint64(height))
+		if err == storage.ErrKeyNotFound {
+			return nil, ErrCannotFindBlockAtGivenHeight
+		}
+		if err != nil {
+			return nil, err
+		}
+		// blocks not indexed yet are matched by their events.
+		bloom, err := bc.LoadEventBloomFromStorage(blockHash)
+		if err != nil {
+			return nil, err
+		}
+		if bloom != nil && !filter.MayMatch(bloom) {
+			continue
+		}
+
+		block := bc.GetBlock(blockHash)
+		if block == nil {
+			return nil, ErrCannotFindBlockAtGivenHeight
+		}
+		if block.StatePruned() {
+			return nil, ErrStatePruned
+		}
+		events, err := BlockEvents(block)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range events {
+			if !filter.Match(e) {
+				continue
+			}
+			if len(logs) >= limit {
+				return nil, ErrTooManyLogs
+			}
+			logs = append(logs, e)
+		}
+	}
+	return logs, nil
+}
@@ -0,0 +1,74 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBloom(t *testing.T) {
+	bloom := new(Bloom)
+	bloom.Add([]byte("chain.contract.Transfer"))
+	assert.True(t, bloom.Test([]byte("chain.contract.Transfer")))
+	assert.False(t, bloom.Test([]byte("chain.contract.Approve")))
+	assert.False(t, new(Bloom).Test([]byte("chain.contract.Transfer")))
+}
+
+func TestLogFilter(t *testing.T) {
+	call := mockCallTransaction(1, 1, "transfer", "")
+	deploy := mockDeployTransaction(1, 2)
+	contract, err := deploy.GenerateContractAddress()
+	assert.Nil(t, err)
+
+	callEvent := &ChainEvent{Event: &state.Event{Topic: "chain.contract.Transfer", Data: "{}"}, Tx: call}
+	deployEvent := &ChainEvent{Event: &state.Event{Topic: TopicTransactionExecutionResult, Data: "{}"}, Tx: deploy}
+	blockEvent := &ChainEvent{Event: &state.Event{Topic: TopicNewTailBlock, Data: "block"}}
+	events := []*ChainEvent{callEvent, deployEvent, blockEvent}
+	bloom := EventsBloom(events)
+
+	tests := []struct {
+		name   string
+		filter *LogFilter
+		want   []bool
+	}{
+		{"empty", &LogFilter{}, []bool{true, true, true}},
+		{"contract", &LogFilter{Contracts: []*Address{call.To()}}, []bool{true, false, false}},
+		{"contracts", &LogFilter{Contracts: []*Address{call.To(), contract}}, []bool{true, true, false}},
+		{"topic", &LogFilter{Topics: []string{TopicNewTailBlock}}, []bool{false, false, true}},
+		{"contract and topic", &LogFilter{Contracts: []*Address{contract}, Topics: []string{TopicTransactionExecutionResult}}, []bool{false, true, false}},
+		{"unmatched", &LogFilter{Contracts: []*Address{call.To()}, Topics: []string{TopicTransactionExecutionResult}}, []bool{false, false, false}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matched := false
+			for i, e := range events {
+				assert.Equal(t, tt.want[i], tt.filter.Match(e), "event %d", i)
+				matched = matched || tt.want[i]
+			}
+			if matched {
+				assert.True(t, tt.filter.MayMatch(bloom))
+			}
+		})
+	}
+
+	assert.False(t, (&LogFilter{Topics: []string{"chain.contract.Approve"}}).MayMatch(bloom))
+}
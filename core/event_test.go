@@ -180,3 +180,64 @@ func TestEventEmitterWithRunningRegDereg(t *testing.T) {
 	emitter.Stop()
 	time.Sleep(time.Millisecond * 100)
 }
+
+func TestEventSubscriberOverflow(t *testing.T) {
+	emitter := NewEventEmitter(1024)
+	emitter.Start()
+
+	topic := "chain.topic.01"
+	eventSub := NewEventSubscriber(2, []string{topic})
+	emitter.Register(eventSub)
+
+	for i := 0; i < 3; i++ {
+		emitter.Trigger(&state.Event{Topic: topic, Data: fmt.Sprintf("%d", i)})
+	}
+
+	select {
+	case <-eventSub.OverflowChan():
+	case <-time.After(time.Second):
+		t.Fatal("overflow is not notified")
+	}
+	assert.Equal(t, 2, len(eventSub.EventChan()))
+	assert.Equal(t, "0", (<-eventSub.EventChan()).Data)
+	assert.Equal(t, "1", (<-eventSub.EventChan()).Data)
+
+	emitter.Stop()
+	time.Sleep(time.Millisecond * 100)
+}
+
+func TestEventFilter(t *testing.T) {
+	call := mockCallTransaction(1, 1, "transfer", "")
+	deploy := mockDeployTransaction(1, 2)
+	contract, err := deploy.GenerateContractAddress()
+	assert.Nil(t, err)
+
+	data := `{"Transfer":{"from":"a","to":"b","value":10,"ok":true}}`
+	callEvent := &ChainEvent{Event: &state.Event{Topic: "chaincontract.Transfer", Data: data}, Tx: call}
+	deployEvent := &ChainEvent{Event: &state.Event{Topic: TopicTransactionExecutionResult, Data: `{"status":1}`}, Tx: deploy}
+	blockEvent := &ChainEvent{Event: &state.Event{Topic: TopicNewTailBlock, Data: "block"}}
+
+	tests := []struct {
+		name   string
+		filter *EventFilter
+		want   []bool
+	}{
+		{"empty", &EventFilter{}, []bool{true, true, true}},
+		{"contract", &EventFilter{Contract: call.To()}, []bool{true, false, false}},
+		{"deployed contract", &EventFilter{Contract: contract}, []bool{false, true, false}},
+		{"from", &EventFilter{From: deploy.From()}, []bool{false, true, false}},
+		{"to", &EventFilter{To: call.To()}, []bool{true, false, false}},
+		{"string field", &EventFilter{Fields: map[string]string{"Transfer.from": "a", "Transfer.to": "b"}}, []bool{true, false, false}},
+		{"number field", &EventFilter{Fields: map[string]string{"Transfer.value": "10", "Transfer.ok": "true"}}, []bool{true, false, false}},
+		{"status field", &EventFilter{Fields: map[string]string{"status": "1"}}, []bool{false, true, false}},
+		{"wrong field", &EventFilter{Fields: map[string]string{"Transfer.from": "b"}}, []bool{false, false, false}},
+		{"missing field", &EventFilter{Fields: map[string]string{"Transfer.from.a": "a"}}, []bool{false, false, false}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i, e := range []*ChainEvent{callEvent, deployEvent, blockEvent} {
+				assert.Equal(t, tt.want[i], tt.filter.Match(e), "event %d", i)
+			}
+		})
+	}
+}
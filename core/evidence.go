@@ -0,0 +1,209 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/common/dag/pb"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/crypto"
+	"github.com/nebulasio/go-nebulas/crypto/hash"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// DoubleMintEvidence proves that a miner signed two different blocks in the same slot.
+// The two blocks are ordered by hash, so the same pair always makes the same evidence.
+type DoubleMintEvidence struct {
+	first  *corepb.EvidenceHeader
+	second *corepb.EvidenceHeader
+}
+
+// NewDoubleMintEvidence create an evidence from two blocks minted at the same time
+func NewDoubleMintEvidence(a *Block, b *Block) (*DoubleMintEvidence, error) {
+	if a == nil || b == nil {
+		return nil, ErrNilArgument
+	}
+	if a.Timestamp() != b.Timestamp() || a.Hash().Equals(b.Hash()) {
+		return nil, ErrInvalidEvidence
+	}
+	if byteutils.Less(b.Hash(), a.Hash()) {
+		a, b = b, a
+	}
+	first, err := newEvidenceHeader(a)
+	if err != nil {
+		return nil, err
+	}
+	second, err := newEvidenceHeader(b)
+	if err != nil {
+		return nil, err
+	}
+	return &DoubleMintEvidence{first: first, second: second}, nil
+}
+
+func newEvidenceHeader(block *Block) (*corepb.EvidenceHeader, error) {
+	header, err := block.header.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	dependency, err := block.dependency.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	txHashes := make([][]byte, len(block.transactions))
+	for idx, tx := range block.transactions {
+		txHashes[idx] = tx.Hash()
+	}
+	return &corepb.EvidenceHeader{
+		Header:     header.(*corepb.BlockHeader),
+		Dependency: dependency.(*dagpb.Dag),
+		TxHashes:   txHashes,
+	}, nil
+}
+
+// ToProto converts domain DoubleMintEvidence to proto DoubleMintEvidence
+func (e *DoubleMintEvidence) ToProto() (proto.Message, error) {
+	return &corepb.DoubleMintEvidence{
+		First:  e.first,
+		Second: e.second,
+	}, nil
+}
+
+// FromProto converts proto DoubleMintEvidence to domain DoubleMintEvidence
+func (e *DoubleMintEvidence) FromProto(msg proto.Message) error {
+	if msg, ok := msg.(*corepb.DoubleMintEvidence); ok {
+		if msg != nil && validEvidenceHeader(msg.First) && validEvidenceHeader(msg.Second) {
+			e.first = msg.First
+			e.second = msg.Second
+			return nil
+		}
+	}
+	return ErrInvalidProtoToEvidence
+}
+
+func validEvidenceHeader(h *corepb.EvidenceHeader) bool {
+	return h != nil && h.Header != nil && h.Header.ConsensusRoot != nil && h.Dependency != nil
+}
+
+// LoadDoubleMintEvidence from bytes
+func LoadDoubleMintEvidence(data []byte) (*DoubleMintEvidence, error) {
+	pbEvidence := new(corepb.DoubleMintEvidence)
+	if err := proto.Unmarshal(data, pbEvidence); err != nil {
+		return nil, err
+	}
+	evidence := new(DoubleMintEvidence)
+	if err := evidence.FromProto(pbEvidence); err != nil {
+		return nil, err
+	}
+	return evidence, nil
+}
+
+// ToBytes serialize evidence
+func (e *DoubleMintEvidence) ToBytes() ([]byte, error) {
+	pbEvidence, err := e.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(pbEvidence)
+}
+
+// Hash return the hash of the evidence
+func (e *DoubleMintEvidence) Hash() byteutils.Hash {
+	return hash.Sha3256(e.first.Header.Hash, e.second.Header.Hash)
+}
+
+// Timestamp return the time of the slot in which the blocks are minted
+func (e *DoubleMintEvidence) Timestamp() int64 {
+	return e.first.Header.Timestamp
+}
+
+// Verify check the evidence at now and return the miner who minted both blocks,
+// who must be the proposer scheduled by the consensus roots of the blocks in ws.
+func (e *DoubleMintEvidence) Verify(chainID uint32, now int64, ws WorldState) (*Address, error) {
+	if e.first.Header.Timestamp != e.second.Header.Timestamp {
+		return nil, ErrInvalidEvidence
+	}
+	if err := e.checkAge(now, ws); err != nil {
+		return nil, err
+	}
+	if !byteutils.Less(e.first.Header.Hash, e.second.Header.Hash) {
+		return nil, ErrInvalidEvidence
+	}
+	first, err := recoverEvidenceSigner(e.first, chainID)
+	if err != nil {
+		return nil, err
+	}
+	second, err := recoverEvidenceSigner(e.second, chainID)
+	if err != nil {
+		return nil, err
+	}
+	if !first.Equals(second) {
+		return nil, ErrInvalidEvidenceSigner
+	}
+	for _, h := range []*corepb.EvidenceHeader{e.first, e.second} {
+		if h.Header.ConsensusRoot.Timestamp != h.Header.Timestamp {
+			return nil, ErrInvalidEvidenceProposer
+		}
+		if err := ws.VerifyProposer(h.Header.ConsensusRoot, first.Bytes()); err != nil {
+			return nil, ErrInvalidEvidenceProposer
+		}
+	}
+	return first, nil
+}
+
+// checkAge reject the evidence whose dynasty may have been pruned at now.
+func (e *DoubleMintEvidence) checkAge(now int64, ws WorldState) error {
+	since, err := ws.EvidenceSince(now)
+	if err != nil {
+		return err
+	}
+	if e.Timestamp() < since {
+		return ErrEvidenceExpired
+	}
+	return nil
+}
+
+func recoverEvidenceSigner(h *corepb.EvidenceHeader, chainID uint32) (*Address, error) {
+	if h.Header.ChainId != chainID {
+		return nil, ErrInvalidChainID
+	}
+	wantedHash, err := HashBlockHeader(h.Header, h.Dependency, h.TxHashes)
+	if err != nil {
+		return nil, err
+	}
+	if !wantedHash.Equals(h.Header.Hash) {
+		return nil, ErrInvalidEvidenceHash
+	}
+	alg := keystore.Algorithm(h.Header.Alg)
+	if err := crypto.CheckAlgorithm(alg); err != nil {
+		return nil, err
+	}
+	return RecoverSignerFromSignature(alg, wantedHash, h.Header.Sign)
+}
+
+func (e *DoubleMintEvidence) String() string {
+	return fmt.Sprintf(`{"hash": "%s", "timestamp": %d, "first": "%s", "second": "%s"}`,
+		e.Hash(),
+		e.Timestamp(),
+		byteutils.Hash(e.first.Header.Hash),
+		byteutils.Hash(e.second.Header.Hash),
+	)
+}
@@ -0,0 +1,190 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/net"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// EvidencePool cache verified double mint evidences until they are submitted on chain, is thread safe.
+// At most size evidences are pending.
+type EvidencePool struct {
+	receivedMessageCh chan net.Message
+	quitCh            chan int
+
+	size    int
+	seen    *lru.Cache
+	pending map[byteutils.HexHash]*DoubleMintEvidence
+
+	ns net.Service
+	mu sync.RWMutex
+
+	bc *BlockChain
+}
+
+// NewEvidencePool create a new EvidencePool
+func NewEvidencePool(size int) (*EvidencePool, error) {
+	seen, err := lru.New(size)
+	if err != nil {
+		return nil, err
+	}
+	return &EvidencePool{
+		receivedMessageCh: make(chan net.Message, size),
+		quitCh:            make(chan int, 1),
+		size:              size,
+		seen:              seen,
+		pending:           make(map[byteutils.HexHash]*DoubleMintEvidence),
+	}, nil
+}
+
+// RegisterInNetwork register message subscriber in network.
+func (pool *EvidencePool) RegisterInNetwork(ns net.Service) {
+	ns.Register(net.NewSubscriber(pool, pool.receivedMessageCh, true, MessageTypeDoubleMintEvidence, net.MessageWeightNewBlock))
+	pool.ns = ns
+}
+
+func (pool *EvidencePool) setBlockChain(bc *BlockChain) {
+	pool.bc = bc
+}
+
+// Start start loop.
+func (pool *EvidencePool) Start() {
+	logging.CLog().WithFields(logrus.Fields{
+		"size": pool.size,
+	}).Info("Starting EvidencePool...")
+
+	go pool.loop()
+}
+
+// Stop stop loop.
+func (pool *EvidencePool) Stop() {
+	logging.CLog().WithFields(logrus.Fields{
+		"size": pool.size,
+	}).Info("Stop EvidencePool.")
+
+	pool.quitCh <- 0
+}
+
+func (pool *EvidencePool) loop() {
+	for {
+		select {
+		case <-pool.quitCh:
+			logging.CLog().Info("Stopped EvidencePool.")
+			return
+		case msg := <-pool.receivedMessageCh:
+			pbEvidence := new(corepb.DoubleMintEvidence)
+			if err := proto.Unmarshal(msg.Data(), pbEvidence); err != nil {
+				logging.VLog().WithFields(logrus.Fields{
+					"msgType": msg.MessageType(),
+					"msg":     msg,
+					"err":     err,
+				}).Debug("Failed to unmarshal data.")
+				continue
+			}
+			evidence := new(DoubleMintEvidence)
+			if err := evidence.FromProto(pbEvidence); err != nil {
+				logging.VLog().WithFields(logrus.Fields{
+					"msgType": msg.MessageType(),
+					"msg":     msg,
+					"err":     err,
+				}).Debug("Failed to recover an evidence from proto data.")
+				continue
+			}
+			if err := pool.PushAndRelay(evidence); err != nil {
+				logging.VLog().WithFields(logrus.Fields{
+					"evidence": evidence,
+					"err":      err,
+				}).Debug("Failed to push an evidence into evidence pool.")
+			}
+		}
+	}
+}
+
+// PushAndRelay push evidence into pool and relay it
+func (pool *EvidencePool) PushAndRelay(evidence *DoubleMintEvidence) error {
+	if err := pool.Push(evidence); err != nil {
+		return err
+	}
+	pool.ns.Relay(MessageTypeDoubleMintEvidence, evidence, net.MessagePriorityHigh)
+	return nil
+}
+
+// PushAndBroadcast push evidence into pool and broadcast it
+func (pool *EvidencePool) PushAndBroadcast(evidence *DoubleMintEvidence) error {
+	if err := pool.Push(evidence); err != nil {
+		return err
+	}
+	pool.ns.Broadcast(MessageTypeDoubleMintEvidence, evidence, net.MessagePriorityHigh)
+	return nil
+}
+
+// Push evidence into pool after verifying it
+func (pool *EvidencePool) Push(evidence *DoubleMintEvidence) error {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	key := evidence.Hash().Hex()
+	if pool.seen.Contains(key) {
+		return ErrDuplicatedEvidence
+	}
+	if len(pool.pending) >= pool.size {
+		return ErrEvidencePoolFull
+	}
+	tail := pool.bc.TailBlock()
+	offender, err := evidence.Verify(pool.bc.ChainID(), tail.Timestamp(), tail.WorldState())
+	if err != nil {
+		return err
+	}
+	pool.seen.Add(key, true)
+	pool.pending[key] = evidence
+
+	logging.VLog().WithFields(logrus.Fields{
+		"evidence": evidence,
+		"offender": offender,
+	}).Warn("Received a double mint evidence.")
+	return nil
+}
+
+// Pending return the evidences not yet submitted
+func (pool *EvidencePool) Pending() []*DoubleMintEvidence {
+	pool.mu.RLock()
+	defer pool.mu.RUnlock()
+
+	evidences := make([]*DoubleMintEvidence, 0, len(pool.pending))
+	for _, evidence := range pool.pending {
+		evidences = append(evidences, evidence)
+	}
+	return evidences
+}
+
+// Del remove the evidence from pending list, it will not be accepted again
+func (pool *EvidencePool) Del(evidence *DoubleMintEvidence) {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	delete(pool.pending, evidence.Hash().Hex())
+}
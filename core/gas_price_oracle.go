@@ -0,0 +1,153 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// Percentiles of the gas prices in recent blocks suggested by GasPriceOracle.
+const (
+	SlowGasPricePercentile     = 25
+	StandardGasPricePercentile = 60
+	FastGasPricePercentile     = 90
+)
+
+// DefaultGasPriceOracleBlocks is the default count of recent blocks sampled by GasPriceOracle.
+const DefaultGasPriceOracleBlocks = 20
+
+// GasPriceSuggestion is the gas prices suggested for transactions to be packed slowly, normally or fast.
+type GasPriceSuggestion struct {
+	Slow     *util.Uint128
+	Standard *util.Uint128
+	Fast     *util.Uint128
+
+	// Count of sampled blocks and transactions.
+	Blocks       int
+	Transactions int
+
+	// Count of transactions in pool and its capacity.
+	Pending      int
+	PoolCapacity int
+}
+
+// GasPriceOracle suggests gas prices by the percentiles of the gas prices in recent blocks.
+// When the pool is more than half full, the suggestions are raised to at least the median
+// gas price of the transactions to be packed next.
+type GasPriceOracle struct {
+	chain  *BlockChain
+	blocks int
+
+	mu       sync.Mutex
+	lastTail byteutils.HexHash
+	samples  []*util.Uint128
+	sampled  int
+}
+
+// NewGasPriceOracle create a new GasPriceOracle sampling the recent blocks.
+func NewGasPriceOracle(chain *BlockChain, blocks int) *GasPriceOracle {
+	if blocks <= 0 {
+		blocks = DefaultGasPriceOracleBlocks
+	}
+	return &GasPriceOracle{
+		chain:  chain,
+		blocks: blocks,
+	}
+}
+
+// Suggest return the suggested gas prices.
+func (o *GasPriceOracle) Suggest() *GasPriceSuggestion {
+	samples, sampled := o.sample()
+	minGasPrice := o.chain.txPool.minGasPrice
+	pending, capacity, candidates := o.chain.txPool.pressure()
+
+	suggestion := &GasPriceSuggestion{
+		Slow:         percentile(samples, SlowGasPricePercentile, minGasPrice),
+		Standard:     percentile(samples, StandardGasPricePercentile, minGasPrice),
+		Fast:         percentile(samples, FastGasPricePercentile, minGasPrice),
+		Blocks:       sampled,
+		Transactions: len(samples),
+		Pending:      pending,
+		PoolCapacity: capacity,
+	}
+
+	if pending*2 > capacity && len(candidates) > 0 {
+		sortPrices(candidates)
+		median := percentile(candidates, 50, minGasPrice)
+		for _, price := range []**util.Uint128{&suggestion.Slow, &suggestion.Standard, &suggestion.Fast} {
+			if (*price).Cmp(median) < 0 {
+				*price = median
+			}
+		}
+	}
+	return suggestion
+}
+
+// sample return the sorted gas prices of transactions in recent blocks and the count of blocks,
+// cached until the tail changes.
+func (o *GasPriceOracle) sample() ([]*util.Uint128, int) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	tail := o.chain.TailBlock()
+	if o.samples != nil && o.lastTail == tail.Hash().Hex() {
+		return o.samples, o.sampled
+	}
+
+	samples := []*util.Uint128{}
+	sampled := 0
+	block := tail
+	for sampled < o.blocks && block != nil && !CheckGenesisBlock(block) {
+		for _, tx := range block.transactions {
+			samples = append(samples, tx.gasPrice)
+		}
+		sampled++
+		block = o.chain.GetBlock(block.ParentHash())
+	}
+	sortPrices(samples)
+
+	o.lastTail = tail.Hash().Hex()
+	o.samples = samples
+	o.sampled = sampled
+	return samples, sampled
+}
+
+func sortPrices(prices []*util.Uint128) {
+	sort.Slice(prices, func(i, j int) bool { return prices[i].Cmp(prices[j]) < 0 })
+}
+
+// percentile return the nearest-rank percentile of the sorted prices, not lower than min.
+func percentile(prices []*util.Uint128, p int, min *util.Uint128) *util.Uint128 {
+	if len(prices) == 0 {
+		return min
+	}
+	rank := (len(prices)*p + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+	price := prices[rank-1]
+	if price.Cmp(min) < 0 {
+		return min
+	}
+	return price
+}
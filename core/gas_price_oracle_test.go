@@ -0,0 +1,92 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/crypto"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPercentile(t *testing.T) {
+	prices := []*util.Uint128{}
+	for i := 1; i <= 10; i++ {
+		prices = append(prices, util.NewUint128FromUint(uint64(i*10)))
+	}
+	min := util.NewUint128FromUint(15)
+
+	assert.Equal(t, min, percentile(nil, 50, min))
+	assert.Equal(t, min, percentile(prices, 0, min))
+	assert.Equal(t, util.NewUint128FromUint(30), percentile(prices, 25, min))
+	assert.Equal(t, util.NewUint128FromUint(60), percentile(prices, 60, min))
+	assert.Equal(t, util.NewUint128FromUint(90), percentile(prices, 90, min))
+	assert.Equal(t, util.NewUint128FromUint(100), percentile(prices, 100, min))
+}
+
+func TestGasPriceOracle(t *testing.T) {
+	neb := testNeb(t)
+	bc := neb.chain
+	oracle := NewGasPriceOracle(bc, 2)
+
+	suggestion := oracle.Suggest()
+	assert.Equal(t, TransactionGasPrice, suggestion.Slow)
+	assert.Equal(t, TransactionGasPrice, suggestion.Fast)
+	assert.Equal(t, 0, suggestion.Blocks)
+
+	ks := keystore.DefaultKS
+	from := mockAddress()
+	key, err := ks.GetUnlocked(from.String())
+	assert.Nil(t, err)
+	signature, err := crypto.NewSignature(keystore.SECP256K1)
+	assert.Nil(t, err)
+	signature.InitSign(key.(keystore.PrivateKey))
+	gasLimit, _ := util.NewUint128FromInt(200000)
+
+	// the gas prices of txs in the first block are out of window.
+	nonce := uint64(0)
+	for _, multiples := range [][]uint64{{100, 100}, {1, 2, 3, 4, 5}, {6, 7, 8, 9, 10}} {
+		block, err := bc.NewBlock(from)
+		assert.Nil(t, err)
+		for _, m := range multiples {
+			gasPrice, _ := TransactionGasPrice.Mul(util.NewUint128FromUint(m))
+			nonce++
+			tx, _ := NewTransaction(bc.ChainID(), from, from, util.NewUint128(), nonce, TxPayloadBinaryType, []byte("nas"), gasPrice, gasLimit)
+			tx.Sign(signature)
+			block.transactions = append(block.transactions, tx)
+		}
+		block.Seal()
+		block.Sign(signature)
+		assert.Nil(t, bc.StoreBlockToStorage(block))
+		assert.Nil(t, bc.SetTailBlock(block))
+	}
+
+	multiple := func(m uint64) *util.Uint128 {
+		price, _ := TransactionGasPrice.Mul(util.NewUint128FromUint(m))
+		return price
+	}
+	suggestion = oracle.Suggest()
+	assert.Equal(t, 2, suggestion.Blocks)
+	assert.Equal(t, 10, suggestion.Transactions)
+	assert.Equal(t, multiple(3), suggestion.Slow)
+	assert.Equal(t, multiple(6), suggestion.Standard)
+	assert.Equal(t, multiple(9), suggestion.Fast)
+}
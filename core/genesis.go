@@ -40,6 +40,16 @@ var (
 	GenesisCoinbase, _ = NewAddressFromPublicKey(make([]byte, PublicKeyDataLength))
 )
 
+// Default dpos parameters, used when they are absent in genesis
+const (
+	DefaultBlockIntervalInMs        = int64(15000)
+	DefaultDynastyIntervalInMs      = int64(3150000)
+	DefaultDynastySize              = int32(6)
+	DefaultAcceptedNetworkDelayInMs = int64(3750)
+	DefaultMinMintDurationInMs      = int64(2250)
+	DefaultMaxMintDurationInMs      = int64(5250)
+)
+
 // LoadGenesisConf load genesis conf for file
 func LoadGenesisConf(filePath string) (*corepb.Genesis, error) {
 	b, err := ioutil.ReadFile(filePath)
@@ -85,6 +95,7 @@ func NewGenesisBlock(conf *corepb.Genesis, chain *BlockChain) (*Block, error) {
 		storage:      chain.storage,
 		eventEmitter: chain.eventEmitter,
 		nvm:          chain.nvm,
+		dip:          chain.dip,
 		height:       1,
 		sealed:       false,
 	}
@@ -189,15 +200,69 @@ func DumpGenesis(chain *BlockChain) (*corepb.Genesis, error) {
 			Value:   balance.String(),
 		})
 	}
+	dposConf, err := chain.LoadGenesisDposConfFromStorage()
+	if err != nil {
+		return nil, err
+	}
+	dposConf.Dynasty = bootstrap
 	return &corepb.Genesis{
 		Meta: &corepb.GenesisMeta{ChainId: genesis.ChainID()},
 		Consensus: &corepb.GenesisConsensus{
-			Dpos: &corepb.GenesisConsensusDpos{Dynasty: bootstrap},
+			Dpos: dposConf,
 		},
 		TokenDistribution: distribution,
 	}, nil
 }
 
+// CheckGenesisDposConf fill the absent dpos parameters with default values and check them
+func CheckGenesisDposConf(conf *corepb.Genesis) error {
+	if conf.Consensus == nil || conf.Consensus.Dpos == nil {
+		return ErrInvalidGenesisDposConf
+	}
+	dposConf := conf.Consensus.Dpos
+	fillGenesisDposConf(dposConf)
+
+	// blocks are minted at second boundaries
+	if dposConf.BlockIntervalInMs <= 0 || dposConf.BlockIntervalInMs%1000 != 0 {
+		return ErrInvalidGenesisDposConf
+	}
+	if dposConf.DynastyIntervalInMs <= 0 || dposConf.DynastyIntervalInMs%dposConf.BlockIntervalInMs != 0 {
+		return ErrInvalidGenesisDposConf
+	}
+	if dposConf.DynastySize <= 0 {
+		return ErrInvalidGenesisDposConf
+	}
+	if dposConf.AcceptedNetworkDelayInMs <= 0 {
+		return ErrInvalidGenesisDposConf
+	}
+	if dposConf.MinMintDurationInMs <= 0 || dposConf.MaxMintDurationInMs < dposConf.MinMintDurationInMs ||
+		dposConf.MaxMintDurationInMs > dposConf.BlockIntervalInMs {
+		return ErrInvalidGenesisDposConf
+	}
+	return nil
+}
+
+func fillGenesisDposConf(conf *corepb.GenesisConsensusDpos) {
+	if conf.BlockIntervalInMs == 0 {
+		conf.BlockIntervalInMs = DefaultBlockIntervalInMs
+	}
+	if conf.DynastyIntervalInMs == 0 {
+		conf.DynastyIntervalInMs = DefaultDynastyIntervalInMs
+	}
+	if conf.DynastySize == 0 {
+		conf.DynastySize = DefaultDynastySize
+	}
+	if conf.AcceptedNetworkDelayInMs == 0 {
+		conf.AcceptedNetworkDelayInMs = DefaultAcceptedNetworkDelayInMs
+	}
+	if conf.MinMintDurationInMs == 0 {
+		conf.MinMintDurationInMs = DefaultMinMintDurationInMs
+	}
+	if conf.MaxMintDurationInMs == 0 {
+		conf.MaxMintDurationInMs = DefaultMaxMintDurationInMs
+	}
+}
+
 //CheckGenesisConfByDB check mem and genesis.conf if equal return nil
 func CheckGenesisConfByDB(pGenesisDB *corepb.Genesis, pGenesis *corepb.Genesis) error {
 	//private function [Empty parameters are checked by the caller]
@@ -214,6 +279,17 @@ func CheckGenesisConfByDB(pGenesisDB *corepb.Genesis, pGenesis *corepb.Genesis)
 			return ErrGenesisNotEqualTokenLenInDB
 		}
 
+		// check dpos parameters equal
+		dposConf, dposConfDB := pGenesis.Consensus.Dpos, pGenesisDB.Consensus.Dpos
+		if dposConf.BlockIntervalInMs != dposConfDB.BlockIntervalInMs ||
+			dposConf.DynastyIntervalInMs != dposConfDB.DynastyIntervalInMs ||
+			dposConf.DynastySize != dposConfDB.DynastySize ||
+			dposConf.AcceptedNetworkDelayInMs != dposConfDB.AcceptedNetworkDelayInMs ||
+			dposConf.MinMintDurationInMs != dposConfDB.MinMintDurationInMs ||
+			dposConf.MaxMintDurationInMs != dposConfDB.MaxMintDurationInMs {
+			return ErrGenesisNotEqualDposConfInDB
+		}
+
 		// check dpos equal
 		for _, confDposAddr := range pGenesis.Consensus.Dpos.Dynasty {
 			contains := false
@@ -50,3 +50,47 @@ func TestInvalidAddressInTokenDistribution(t *testing.T) {
 	_, err := NewGenesisBlock(mockConf, chain)
 	assert.Equal(t, err, ErrInvalidAddressFormat)
 }
+
+func TestCheckGenesisDposConf(t *testing.T) {
+	conf := MockGenesisConf()
+	assert.Nil(t, CheckGenesisDposConf(conf))
+	assert.Equal(t, conf.Consensus.Dpos.BlockIntervalInMs, DefaultBlockIntervalInMs)
+	assert.Equal(t, conf.Consensus.Dpos.DynastySize, DefaultDynastySize)
+
+	conf = MockGenesisConf()
+	conf.Consensus.Dpos.BlockIntervalInMs = 1000
+	conf.Consensus.Dpos.DynastyIntervalInMs = 3000
+	conf.Consensus.Dpos.DynastySize = 3
+	conf.Consensus.Dpos.MinMintDurationInMs = 200
+	conf.Consensus.Dpos.MaxMintDurationInMs = 500
+	assert.Nil(t, CheckGenesisDposConf(conf))
+
+	conf = MockGenesisConf()
+	conf.Consensus.Dpos.BlockIntervalInMs = 1500
+	assert.Equal(t, CheckGenesisDposConf(conf), ErrInvalidGenesisDposConf)
+
+	conf = MockGenesisConf()
+	conf.Consensus.Dpos.DynastyIntervalInMs = 20000
+	assert.Equal(t, CheckGenesisDposConf(conf), ErrInvalidGenesisDposConf)
+
+	conf = MockGenesisConf()
+	conf.Consensus.Dpos.DynastySize = -1
+	assert.Equal(t, CheckGenesisDposConf(conf), ErrInvalidGenesisDposConf)
+
+	conf = MockGenesisConf()
+	conf.Consensus.Dpos.MaxMintDurationInMs = 1000
+	assert.Equal(t, CheckGenesisDposConf(conf), ErrInvalidGenesisDposConf)
+}
+
+func TestCheckGenesisDposConfByDB(t *testing.T) {
+	chain := testNeb(t).chain
+	genesisDB, err := DumpGenesis(chain)
+	assert.Nil(t, err)
+
+	conf := MockGenesisConf()
+	assert.Nil(t, CheckGenesisDposConf(conf))
+	assert.Nil(t, CheckGenesisConfByDB(genesisDB, conf))
+
+	conf.Consensus.Dpos.BlockIntervalInMs = 5000
+	assert.Equal(t, CheckGenesisConfByDB(genesisDB, conf), ErrGenesisNotEqualDposConfInDB)
+}
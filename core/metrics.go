@@ -57,6 +57,8 @@ var (
 	metricsTxPoolBelowGasPrice             = metrics.NewCounter("neb.txpool.below_gas_price")
 	metricsTxPoolOutOfGasLimit             = metrics.NewCounter("neb.txpool.out_of_gas_limit")
 	metricsTxPoolGasLimitLessOrEqualToZero = metrics.NewCounter("neb.txpool.gas_limit_less_equal_zero")
+	metricsTxPoolSenderOutOfLimit          = metrics.NewCounter("neb.txpool.sender_out_of_limit")
+	metricsTxPoolExpired                   = metrics.NewCounter("neb.txpool.expired")
 
 	// transaction metrics
 	metricsTxSubmit     = metrics.NewMeter("neb.transaction.submit")
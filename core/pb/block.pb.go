@@ -16,6 +16,8 @@ It has these top-level messages:
 	NetBlocks
 	NetBlock
 	DownloadBlock
+	EvidenceHeader
+	DoubleMintEvidence
 */
 package corepb
 
@@ -436,6 +438,62 @@ func (m *DownloadBlock) GetSign() []byte {
 	return nil
 }
 
+type EvidenceHeader struct {
+	Header     *BlockHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	Dependency *dagpb.Dag   `protobuf:"bytes,2,opt,name=dependency" json:"dependency,omitempty"`
+	TxHashes   [][]byte     `protobuf:"bytes,3,rep,name=tx_hashes,json=txHashes" json:"tx_hashes,omitempty"`
+}
+
+func (m *EvidenceHeader) Reset()                    { *m = EvidenceHeader{} }
+func (m *EvidenceHeader) String() string            { return proto.CompactTextString(m) }
+func (*EvidenceHeader) ProtoMessage()               {}
+func (*EvidenceHeader) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{8} }
+
+func (m *EvidenceHeader) GetHeader() *BlockHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *EvidenceHeader) GetDependency() *dagpb.Dag {
+	if m != nil {
+		return m.Dependency
+	}
+	return nil
+}
+
+func (m *EvidenceHeader) GetTxHashes() [][]byte {
+	if m != nil {
+		return m.TxHashes
+	}
+	return nil
+}
+
+type DoubleMintEvidence struct {
+	First  *EvidenceHeader `protobuf:"bytes,1,opt,name=first" json:"first,omitempty"`
+	Second *EvidenceHeader `protobuf:"bytes,2,opt,name=second" json:"second,omitempty"`
+}
+
+func (m *DoubleMintEvidence) Reset()                    { *m = DoubleMintEvidence{} }
+func (m *DoubleMintEvidence) String() string            { return proto.CompactTextString(m) }
+func (*DoubleMintEvidence) ProtoMessage()               {}
+func (*DoubleMintEvidence) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{9} }
+
+func (m *DoubleMintEvidence) GetFirst() *EvidenceHeader {
+	if m != nil {
+		return m.First
+	}
+	return nil
+}
+
+func (m *DoubleMintEvidence) GetSecond() *EvidenceHeader {
+	if m != nil {
+		return m.Second
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Account)(nil), "corepb.Account")
 	proto.RegisterType((*Data)(nil), "corepb.Data")
@@ -445,54 +503,61 @@ func init() {
 	proto.RegisterType((*NetBlocks)(nil), "corepb.NetBlocks")
 	proto.RegisterType((*NetBlock)(nil), "corepb.NetBlock")
 	proto.RegisterType((*DownloadBlock)(nil), "corepb.DownloadBlock")
+	proto.RegisterType((*EvidenceHeader)(nil), "corepb.EvidenceHeader")
+	proto.RegisterType((*DoubleMintEvidence)(nil), "corepb.DoubleMintEvidence")
 }
 
 func init() { proto.RegisterFile("block.proto", fileDescriptorBlock) }
 
 var fileDescriptorBlock = []byte{
-	// 698 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x6d, 0x8b, 0xd3, 0x40,
-	0x10, 0x26, 0x6d, 0xfa, 0x36, 0x69, 0x8f, 0x63, 0x3d, 0x24, 0x56, 0xe5, 0x4a, 0x44, 0x28, 0x8a,
-	0x2d, 0x9c, 0xc2, 0xf9, 0xf5, 0xf4, 0x3e, 0x9c, 0x22, 0x72, 0x04, 0xbf, 0x08, 0x42, 0x99, 0x6c,
-	0xd6, 0x24, 0x98, 0xee, 0x86, 0xec, 0xf6, 0xbc, 0xfb, 0x19, 0xfe, 0x0f, 0xbf, 0xf8, 0x93, 0xfc,
-	0x27, 0xb2, 0xb3, 0xe9, 0xdb, 0x79, 0x20, 0x7e, 0xea, 0x3e, 0xf3, 0x64, 0xa6, 0xf3, 0x3c, 0x33,
-	0xbb, 0x10, 0x24, 0xa5, 0xe2, 0xdf, 0x66, 0x55, 0xad, 0x8c, 0x62, 0x5d, 0xae, 0x6a, 0x51, 0x25,
-	0xe3, 0xd3, 0xac, 0x30, 0xf9, 0x2a, 0x99, 0x71, 0xb5, 0x9c, 0x4b, 0x91, 0xac, 0x4a, 0xd4, 0x85,
-	0x9a, 0x67, 0xea, 0x45, 0x03, 0xe6, 0x5c, 0x2d, 0x97, 0x4a, 0xce, 0x53, 0xcc, 0xe6, 0x55, 0x62,
-	0x7f, 0x5c, 0x81, 0xf1, 0xeb, 0x7f, 0x27, 0x4a, 0x2d, 0xa4, 0x5e, 0x69, 0x9b, 0xa7, 0x0d, 0x1a,
-	0xe1, 0x32, 0xa3, 0x1f, 0x1e, 0xf4, 0xce, 0x38, 0x57, 0x2b, 0x69, 0x58, 0x08, 0x3d, 0x4c, 0xd3,
-	0x5a, 0x68, 0x1d, 0x7a, 0x13, 0x6f, 0x3a, 0x8c, 0xd7, 0xd0, 0x32, 0x09, 0x96, 0x28, 0xb9, 0x08,
-	0x5b, 0x8e, 0x69, 0x20, 0x3b, 0x82, 0x8e, 0x54, 0x36, 0xde, 0x9e, 0x78, 0x53, 0x3f, 0x76, 0x80,
-	0x3d, 0x84, 0xc1, 0x15, 0xd6, 0x7a, 0x91, 0xa3, 0xce, 0x43, 0x9f, 0x32, 0xfa, 0x36, 0x70, 0x81,
-	0x3a, 0x67, 0xc7, 0x10, 0x24, 0x45, 0x6d, 0xf2, 0x45, 0x55, 0x22, 0x17, 0x61, 0x87, 0x68, 0xa0,
-	0xd0, 0xa5, 0x8d, 0x44, 0xaf, 0xc0, 0x3f, 0x47, 0x83, 0x8c, 0x81, 0x6f, 0x6e, 0x2a, 0x41, 0xcd,
-	0x0c, 0x62, 0x3a, 0xdb, 0x4e, 0x2a, 0xbc, 0x29, 0x15, 0xa6, 0xeb, 0x4e, 0x1a, 0x18, 0xfd, 0x6c,
-	0x41, 0xf0, 0xa9, 0x46, 0xa9, 0x91, 0x9b, 0x42, 0x49, 0x9b, 0x4d, 0x7f, 0xef, 0xa4, 0xd0, 0xd9,
-	0xc6, 0xbe, 0xd6, 0x6a, 0xd9, 0xa4, 0xd2, 0x99, 0x1d, 0x40, 0xcb, 0x28, 0x6a, 0x7f, 0x18, 0xb7,
-	0x8c, 0xb2, 0x8a, 0xae, 0xb0, 0x5c, 0x89, 0xa6, 0x6f, 0x07, 0xb6, 0x3a, 0x3b, 0xbb, 0x3a, 0x1f,
-	0xc1, 0xc0, 0x14, 0x4b, 0xa1, 0x0d, 0x2e, 0xab, 0xb0, 0x3b, 0xf1, 0xa6, 0xed, 0x78, 0x1b, 0x60,
-	0x13, 0xf0, 0x53, 0x34, 0x18, 0xf6, 0x26, 0xde, 0x34, 0x38, 0x19, 0xce, 0xdc, 0x94, 0x67, 0x56,
-	0x5b, 0x4c, 0x0c, 0x7b, 0x00, 0x7d, 0x9e, 0x63, 0x21, 0x17, 0x45, 0x1a, 0xf6, 0x27, 0xde, 0x74,
-	0x14, 0xf7, 0x08, 0xbf, 0x4b, 0xad, 0x85, 0x19, 0xea, 0x45, 0x55, 0x17, 0x5c, 0x84, 0x03, 0x67,
-	0x61, 0x86, 0xfa, 0xd2, 0xe2, 0x35, 0x59, 0x16, 0xcb, 0xc2, 0x84, 0xb0, 0x21, 0x3f, 0x58, 0xcc,
-	0x0e, 0xa1, 0x8d, 0x65, 0x16, 0x06, 0x54, 0xcf, 0x1e, 0xad, 0x6c, 0x5d, 0x64, 0x32, 0x1c, 0x3a,
-	0xd9, 0xf6, 0x1c, 0xfd, 0x6e, 0x41, 0xf0, 0xc6, 0xee, 0xe0, 0x85, 0xc0, 0x54, 0xd4, 0x77, 0xda,
-	0x75, 0x0c, 0x41, 0x85, 0xb5, 0x90, 0xc6, 0x0d, 0xd2, 0xb9, 0x06, 0x2e, 0x44, 0xa3, 0x1c, 0x43,
-	0x9f, 0xab, 0x42, 0x26, 0xa8, 0xd7, 0x76, 0x6d, 0xf0, 0xbe, 0x37, 0x9d, 0xdb, 0xde, 0xec, 0x2a,
-	0xef, 0xee, 0x2b, 0x6f, 0xfa, 0xef, 0xfd, 0xdd, 0x7f, 0x7f, 0xdb, 0x3f, 0x7b, 0x0c, 0x40, 0x7b,
-	0xbc, 0xa8, 0x95, 0x32, 0x8d, 0x41, 0x03, 0x8a, 0xc4, 0x4a, 0x19, 0x5b, 0xdf, 0x5c, 0x6b, 0x47,
-	0x3a, 0x83, 0x7a, 0xe6, 0x5a, 0x13, 0x75, 0x0c, 0x81, 0xb8, 0x12, 0xd2, 0x34, 0x6c, 0xe0, 0x54,
-	0xb9, 0x10, 0x7d, 0x70, 0x06, 0x07, 0x9b, 0xfb, 0xe2, 0xbe, 0x19, 0xd2, 0x04, 0xc7, 0xb3, 0x4d,
-	0xb8, 0x4a, 0x66, 0x6f, 0xd7, 0x67, 0x9b, 0x13, 0x8f, 0xf8, 0x2e, 0x7c, 0xef, 0xf7, 0xdb, 0x87,
-	0x7e, 0xf4, 0xcb, 0x83, 0x0e, 0x79, 0xcc, 0x9e, 0x43, 0x37, 0x27, 0x9f, 0xc9, 0xdf, 0xe0, 0xe4,
-	0xde, 0x7a, 0x19, 0x76, 0x46, 0x10, 0x37, 0x9f, 0xb0, 0x53, 0x18, 0x9a, 0xed, 0x22, 0xeb, 0xb0,
-	0x35, 0x69, 0xef, 0xa6, 0xec, 0x2c, 0x79, 0xbc, 0xf7, 0x21, 0x7b, 0x06, 0x90, 0x8a, 0x4a, 0xc8,
-	0x54, 0x48, 0x7e, 0x43, 0x2b, 0x1d, 0x9c, 0xc0, 0x2c, 0xc5, 0x8c, 0xb6, 0x2e, 0x8b, 0x77, 0x58,
-	0x76, 0xdf, 0x76, 0x54, 0x64, 0xb9, 0xa1, 0xc1, 0xf9, 0x71, 0x83, 0xa2, 0x2f, 0x30, 0xf8, 0x28,
-	0x0c, 0xb5, 0xa5, 0x37, 0xf7, 0xa5, 0xb9, 0x81, 0x74, 0x5f, 0x8e, 0xa0, 0x93, 0xa0, 0xe1, 0x6e,
-	0x1d, 0xfc, 0xd8, 0x01, 0xf6, 0x14, 0xba, 0xf4, 0xa2, 0xe9, 0xb0, 0x4d, 0xdd, 0x8e, 0xf6, 0x04,
-	0xc6, 0x0d, 0x19, 0x7d, 0x86, 0xfe, 0xba, 0xfa, 0x7f, 0x14, 0x7f, 0x02, 0x1d, 0xca, 0x6f, 0x24,
-	0xdd, 0xaa, 0xed, 0xb8, 0xe8, 0x14, 0x46, 0xe7, 0xea, 0xbb, 0xb4, 0x6f, 0xc1, 0xa6, 0xfe, 0x5d,
-	0x0f, 0x00, 0x6d, 0x52, 0x6b, 0xbb, 0x49, 0x49, 0x97, 0x5e, 0xc2, 0x97, 0x7f, 0x02, 0x00, 0x00,
-	0xff, 0xff, 0xa7, 0xc4, 0x6c, 0xca, 0x93, 0x05, 0x00, 0x00,
+	// 776 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xdd, 0x8a, 0xe4, 0x44,
+	0x14, 0x26, 0xe9, 0xf4, 0xdf, 0x49, 0xcf, 0xb0, 0x94, 0xb2, 0xc4, 0x59, 0x65, 0x9a, 0x88, 0xd0,
+	0xf8, 0x93, 0x86, 0x51, 0x18, 0x6f, 0x57, 0x47, 0x18, 0x45, 0x65, 0x29, 0xbc, 0x11, 0x84, 0xa6,
+	0x52, 0xa9, 0x4d, 0x0a, 0xd3, 0x55, 0x21, 0x55, 0x3d, 0xce, 0xbc, 0x80, 0xf7, 0xbe, 0x87, 0x37,
+	0x3e, 0x92, 0x6f, 0x22, 0x75, 0xaa, 0xd2, 0x93, 0x5e, 0x97, 0x5d, 0xf6, 0x6a, 0xea, 0x3b, 0x7f,
+	0xf3, 0x9d, 0xef, 0x9c, 0x93, 0x86, 0xb4, 0x6c, 0x35, 0xff, 0xbd, 0xe8, 0x7a, 0x6d, 0x35, 0x99,
+	0x71, 0xdd, 0x8b, 0xae, 0xbc, 0xb8, 0xae, 0xa5, 0x6d, 0x0e, 0x65, 0xc1, 0xf5, 0x7e, 0xab, 0x44,
+	0x79, 0x68, 0x99, 0x91, 0x7a, 0x5b, 0xeb, 0x2f, 0x02, 0xd8, 0x72, 0xbd, 0xdf, 0x6b, 0xb5, 0xad,
+	0x58, 0xbd, 0xed, 0x4a, 0xf7, 0xc7, 0x17, 0xb8, 0xf8, 0xfa, 0xed, 0x89, 0xca, 0x08, 0x65, 0x0e,
+	0xc6, 0xe5, 0x19, 0xcb, 0xac, 0xf0, 0x99, 0xf9, 0x5f, 0x11, 0xcc, 0x9f, 0x73, 0xae, 0x0f, 0xca,
+	0x92, 0x0c, 0xe6, 0xac, 0xaa, 0x7a, 0x61, 0x4c, 0x16, 0xad, 0xa3, 0xcd, 0x8a, 0x0e, 0xd0, 0x79,
+	0x4a, 0xd6, 0x32, 0xc5, 0x45, 0x16, 0x7b, 0x4f, 0x80, 0xe4, 0x7d, 0x98, 0x2a, 0xed, 0xec, 0x93,
+	0x75, 0xb4, 0x49, 0xa8, 0x07, 0xe4, 0x19, 0x2c, 0xef, 0x58, 0x6f, 0x76, 0x0d, 0x33, 0x4d, 0x96,
+	0x60, 0xc6, 0xc2, 0x19, 0x6e, 0x99, 0x69, 0xc8, 0x25, 0xa4, 0xa5, 0xec, 0x6d, 0xb3, 0xeb, 0x5a,
+	0xc6, 0x45, 0x36, 0x45, 0x37, 0xa0, 0xe9, 0x85, 0xb3, 0xe4, 0x5f, 0x41, 0x72, 0xc3, 0x2c, 0x23,
+	0x04, 0x12, 0xfb, 0xd0, 0x09, 0x24, 0xb3, 0xa4, 0xf8, 0x76, 0x4c, 0x3a, 0xf6, 0xd0, 0x6a, 0x56,
+	0x0d, 0x4c, 0x02, 0xcc, 0xff, 0x8e, 0x21, 0xfd, 0xa5, 0x67, 0xca, 0x30, 0x6e, 0xa5, 0x56, 0x2e,
+	0x1b, 0xff, 0xbd, 0x6f, 0x05, 0xdf, 0xce, 0xf6, 0xb2, 0xd7, 0xfb, 0x90, 0x8a, 0x6f, 0x72, 0x0e,
+	0xb1, 0xd5, 0x48, 0x7f, 0x45, 0x63, 0xab, 0x5d, 0x47, 0x77, 0xac, 0x3d, 0x88, 0xc0, 0xdb, 0x83,
+	0xc7, 0x3e, 0xa7, 0xe3, 0x3e, 0x3f, 0x84, 0xa5, 0x95, 0x7b, 0x61, 0x2c, 0xdb, 0x77, 0xd9, 0x6c,
+	0x1d, 0x6d, 0x26, 0xf4, 0xd1, 0x40, 0xd6, 0x90, 0x54, 0xcc, 0xb2, 0x6c, 0xbe, 0x8e, 0x36, 0xe9,
+	0xd5, 0xaa, 0xf0, 0x53, 0x2e, 0x5c, 0x6f, 0x14, 0x3d, 0xe4, 0x03, 0x58, 0xf0, 0x86, 0x49, 0xb5,
+	0x93, 0x55, 0xb6, 0x58, 0x47, 0x9b, 0x33, 0x3a, 0x47, 0xfc, 0x7d, 0xe5, 0x24, 0xac, 0x99, 0xd9,
+	0x75, 0xbd, 0xe4, 0x22, 0x5b, 0x7a, 0x09, 0x6b, 0x66, 0x5e, 0x38, 0x3c, 0x38, 0x5b, 0xb9, 0x97,
+	0x36, 0x83, 0xa3, 0xf3, 0x47, 0x87, 0xc9, 0x13, 0x98, 0xb0, 0xb6, 0xce, 0x52, 0xac, 0xe7, 0x9e,
+	0xae, 0x6d, 0x23, 0x6b, 0x95, 0xad, 0x7c, 0xdb, 0xee, 0x9d, 0xff, 0x1b, 0x43, 0xfa, 0x8d, 0xdb,
+	0xc1, 0x5b, 0xc1, 0x2a, 0xd1, 0xbf, 0x56, 0xae, 0x4b, 0x48, 0x3b, 0xd6, 0x0b, 0x65, 0xfd, 0x20,
+	0xbd, 0x6a, 0xe0, 0x4d, 0x38, 0xca, 0x0b, 0x58, 0x70, 0x2d, 0x55, 0xc9, 0xcc, 0x20, 0xd7, 0x11,
+	0x9f, 0x6a, 0x33, 0x7d, 0x55, 0x9b, 0x71, 0xe7, 0xb3, 0xd3, 0xce, 0x03, 0xff, 0xf9, 0xff, 0xf9,
+	0x2f, 0x1e, 0xf9, 0x93, 0x8f, 0x00, 0x70, 0x8f, 0x77, 0xbd, 0xd6, 0x36, 0x08, 0xb4, 0x44, 0x0b,
+	0xd5, 0xda, 0xba, 0xfa, 0xf6, 0xde, 0x78, 0xa7, 0x17, 0x68, 0x6e, 0xef, 0x0d, 0xba, 0x2e, 0x21,
+	0x15, 0x77, 0x42, 0xd9, 0xe0, 0x4d, 0x7d, 0x57, 0xde, 0x84, 0x01, 0xcf, 0xe1, 0xfc, 0x78, 0x2f,
+	0x3e, 0x66, 0x85, 0x13, 0xbc, 0x28, 0x8e, 0xe6, 0xae, 0x2c, 0xbe, 0x1d, 0xde, 0x2e, 0x87, 0x9e,
+	0xf1, 0x31, 0xfc, 0x21, 0x59, 0x4c, 0x9e, 0x24, 0xf9, 0x3f, 0x11, 0x4c, 0x51, 0x63, 0xf2, 0x19,
+	0xcc, 0x1a, 0xd4, 0x19, 0xf5, 0x4d, 0xaf, 0xde, 0x1b, 0x96, 0x61, 0x34, 0x02, 0x1a, 0x42, 0xc8,
+	0x35, 0xac, 0xec, 0xe3, 0x22, 0x9b, 0x2c, 0x5e, 0x4f, 0xc6, 0x29, 0xa3, 0x25, 0xa7, 0x27, 0x81,
+	0xe4, 0x53, 0x80, 0x4a, 0x74, 0x42, 0x55, 0x42, 0xf1, 0x07, 0x5c, 0xe9, 0xf4, 0x0a, 0x8a, 0x8a,
+	0xd5, 0xb8, 0x75, 0x35, 0x1d, 0x79, 0xc9, 0x53, 0xc7, 0x48, 0xd6, 0x8d, 0xc5, 0xc1, 0x25, 0x34,
+	0xa0, 0xfc, 0x37, 0x58, 0xfe, 0x2c, 0x2c, 0xd2, 0x32, 0xc7, 0x7b, 0x09, 0x17, 0xe8, 0xde, 0xee,
+	0x12, 0x4a, 0x66, 0xb9, 0x5f, 0x87, 0x84, 0x7a, 0x40, 0x3e, 0x81, 0x19, 0x7e, 0xd1, 0x4c, 0x36,
+	0x41, 0xb6, 0x67, 0x27, 0x0d, 0xd2, 0xe0, 0xcc, 0x7f, 0x85, 0xc5, 0x50, 0xfd, 0x1d, 0x8a, 0x7f,
+	0x0c, 0x53, 0xcc, 0x0f, 0x2d, 0xbd, 0x52, 0xdb, 0xfb, 0xf2, 0x6b, 0x38, 0xbb, 0xd1, 0x7f, 0x28,
+	0xf7, 0x2d, 0x38, 0xd6, 0x7f, 0xdd, 0x07, 0x00, 0x37, 0x29, 0x1e, 0x5d, 0xc2, 0x9f, 0x11, 0x9c,
+	0x7f, 0x77, 0x27, 0x9d, 0x2c, 0x22, 0x1c, 0xc3, 0x3b, 0x8d, 0xeb, 0x54, 0xf5, 0xf8, 0x8d, 0xaa,
+	0x3f, 0x83, 0xa5, 0xbd, 0xc7, 0x6b, 0x12, 0x5e, 0xa9, 0x15, 0x5d, 0xd8, 0xfb, 0x5b, 0xc4, 0x79,
+	0x0f, 0xe4, 0x46, 0x1f, 0xca, 0x56, 0xfc, 0x24, 0x95, 0x1d, 0x18, 0x91, 0xcf, 0x61, 0xfa, 0x52,
+	0xf6, 0xc6, 0x06, 0x2a, 0x4f, 0x07, 0x2a, 0xa7, 0x94, 0xa9, 0x0f, 0x22, 0x05, 0xcc, 0x8c, 0xe0,
+	0x5a, 0x55, 0x59, 0xfc, 0xc6, 0xf0, 0x10, 0x55, 0xce, 0xf0, 0x67, 0xe0, 0xcb, 0xff, 0x06, 0x00,
+	0x9d, 0xb1, 0x0c, 0x8d, 0x90, 0x06, 0x00, 0x00,
 }
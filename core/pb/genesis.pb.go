@@ -104,6 +104,18 @@ func (m *GenesisConsensus) GetDpos() *GenesisConsensusDpos {
 type GenesisConsensusDpos struct {
 	// dpos genesis dynasty address
 	Dynasty []string `protobuf:"bytes,1,rep,name=dynasty" json:"dynasty,omitempty"`
+	// interval between two blocks, default is 15000.
+	BlockIntervalInMs int64 `protobuf:"varint,2,opt,name=block_interval_in_ms,json=blockIntervalInMs,proto3" json:"block_interval_in_ms,omitempty"`
+	// interval between two dynasties, default is 3150000.
+	DynastyIntervalInMs int64 `protobuf:"varint,3,opt,name=dynasty_interval_in_ms,json=dynastyIntervalInMs,proto3" json:"dynasty_interval_in_ms,omitempty"`
+	// number of miners in a dynasty, default is 6.
+	DynastySize int32 `protobuf:"varint,4,opt,name=dynasty_size,json=dynastySize,proto3" json:"dynasty_size,omitempty"`
+	// max network delay accepted when receiving blocks, default is 3750.
+	AcceptedNetworkDelayInMs int64 `protobuf:"varint,5,opt,name=accepted_network_delay_in_ms,json=acceptedNetworkDelayInMs,proto3" json:"accepted_network_delay_in_ms,omitempty"`
+	// min time left in the slot to start minting, default is 2250.
+	MinMintDurationInMs int64 `protobuf:"varint,6,opt,name=min_mint_duration_in_ms,json=minMintDurationInMs,proto3" json:"min_mint_duration_in_ms,omitempty"`
+	// max time used to pack transactions in a block, default is 5250.
+	MaxMintDurationInMs int64 `protobuf:"varint,7,opt,name=max_mint_duration_in_ms,json=maxMintDurationInMs,proto3" json:"max_mint_duration_in_ms,omitempty"`
 }
 
 func (m *GenesisConsensusDpos) Reset()                    { *m = GenesisConsensusDpos{} }
@@ -118,6 +130,48 @@ func (m *GenesisConsensusDpos) GetDynasty() []string {
 	return nil
 }
 
+func (m *GenesisConsensusDpos) GetBlockIntervalInMs() int64 {
+	if m != nil {
+		return m.BlockIntervalInMs
+	}
+	return 0
+}
+
+func (m *GenesisConsensusDpos) GetDynastyIntervalInMs() int64 {
+	if m != nil {
+		return m.DynastyIntervalInMs
+	}
+	return 0
+}
+
+func (m *GenesisConsensusDpos) GetDynastySize() int32 {
+	if m != nil {
+		return m.DynastySize
+	}
+	return 0
+}
+
+func (m *GenesisConsensusDpos) GetAcceptedNetworkDelayInMs() int64 {
+	if m != nil {
+		return m.AcceptedNetworkDelayInMs
+	}
+	return 0
+}
+
+func (m *GenesisConsensusDpos) GetMinMintDurationInMs() int64 {
+	if m != nil {
+		return m.MinMintDurationInMs
+	}
+	return 0
+}
+
+func (m *GenesisConsensusDpos) GetMaxMintDurationInMs() int64 {
+	if m != nil {
+		return m.MaxMintDurationInMs
+	}
+	return 0
+}
+
 type GenesisTokenDistribution struct {
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	Value   string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
@@ -153,22 +207,30 @@ func init() {
 func init() { proto.RegisterFile("genesis.proto", fileDescriptorGenesis) }
 
 var fileDescriptorGenesis = []byte{
-	// 264 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x51, 0x4b, 0xc3, 0x30,
-	0x14, 0x85, 0xa9, 0x9d, 0xab, 0xbd, 0x65, 0xa0, 0x71, 0x0f, 0x11, 0x7c, 0x28, 0x79, 0xb1, 0x4f,
-	0x65, 0x4c, 0xf0, 0x0f, 0x58, 0x10, 0x05, 0x11, 0x82, 0xef, 0x23, 0x6d, 0x2e, 0x1a, 0xd4, 0xa4,
-	0xf4, 0xa6, 0xc2, 0x7e, 0x9b, 0x7f, 0x4e, 0x96, 0xae, 0x38, 0x8a, 0x7b, 0x3c, 0x39, 0x5f, 0x2e,
-	0xe7, 0xdc, 0x0b, 0x8b, 0x37, 0xb4, 0x48, 0x86, 0xca, 0xb6, 0x73, 0xde, 0xb1, 0x79, 0xe3, 0x3a,
-	0x6c, 0x6b, 0xf1, 0x13, 0x41, 0xf2, 0x30, 0x38, 0xec, 0x06, 0x66, 0x5f, 0xe8, 0x15, 0x8f, 0xf2,
-	0xa8, 0xc8, 0xd6, 0x97, 0xe5, 0x80, 0x94, 0x7b, 0xfb, 0x19, 0xbd, 0x92, 0x01, 0x60, 0x77, 0x90,
-	0x36, 0xce, 0x12, 0x5a, 0xea, 0x89, 0x9f, 0x04, 0x9a, 0x4f, 0xe8, 0xfb, 0xd1, 0x97, 0x7f, 0x28,
-	0x7b, 0x01, 0xe6, 0xdd, 0x07, 0xda, 0x8d, 0x36, 0xe4, 0x3b, 0x53, 0xf7, 0xde, 0x38, 0xcb, 0xe3,
-	0x3c, 0x2e, 0xb2, 0x75, 0x3e, 0x19, 0xf0, 0xba, 0x03, 0xab, 0x03, 0x4e, 0x5e, 0xf8, 0xe9, 0x93,
-	0x28, 0x20, 0x3b, 0x48, 0xc7, 0xae, 0xe0, 0xac, 0x79, 0x57, 0xc6, 0x6e, 0x8c, 0x0e, 0x25, 0x16,
-	0x32, 0x09, 0xfa, 0x51, 0x8b, 0x0a, 0xce, 0xa7, 0xc9, 0xd8, 0x0a, 0x66, 0xba, 0x75, 0xb4, 0xef,
-	0x7b, 0x7d, 0xac, 0x41, 0xd5, 0x3a, 0x92, 0x81, 0x14, 0x2b, 0x58, 0xfe, 0xe7, 0x32, 0x0e, 0x89,
-	0xde, 0x5a, 0x45, 0x7e, 0xcb, 0xa3, 0x3c, 0x2e, 0x52, 0x39, 0x4a, 0xf1, 0x04, 0xfc, 0x58, 0xa1,
-	0xdd, 0x2f, 0xa5, 0x75, 0x87, 0x34, 0x44, 0x48, 0xe5, 0x28, 0xd9, 0x12, 0x4e, 0xbf, 0xd5, 0x67,
-	0x8f, 0x61, 0xb9, 0xa9, 0x1c, 0x44, 0x3d, 0x0f, 0xa7, 0xbb, 0xfd, 0x0d, 0x00, 0x00, 0xff, 0xff,
-	0xbe, 0xf4, 0x0e, 0x3f, 0xcb, 0x01, 0x00, 0x00,
+	// 400 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xcf, 0x6e, 0xd4, 0x30,
+	0x10, 0x87, 0x95, 0x66, 0xff, 0xb0, 0xb3, 0x54, 0xa2, 0xee, 0x0a, 0x8c, 0xd4, 0x43, 0xc8, 0x85,
+	0x9c, 0x16, 0xd4, 0x22, 0x8e, 0x5c, 0x88, 0x84, 0x16, 0x69, 0x41, 0x32, 0xdc, 0x2d, 0x27, 0x1e,
+	0x81, 0xb5, 0x89, 0x1d, 0xc5, 0x4e, 0xe9, 0xf6, 0xd5, 0x78, 0x1e, 0xde, 0x03, 0xc5, 0x71, 0xa0,
+	0x8d, 0xda, 0xe3, 0xe4, 0xf7, 0x7d, 0xce, 0xcc, 0xd8, 0x70, 0xfa, 0x03, 0x35, 0x5a, 0x65, 0xb7,
+	0x4d, 0x6b, 0x9c, 0x21, 0x8b, 0xd2, 0xb4, 0xd8, 0x14, 0xe9, 0xef, 0x08, 0x96, 0x9f, 0x86, 0x84,
+	0xbc, 0x86, 0x59, 0x8d, 0x4e, 0xd0, 0x28, 0x89, 0xb2, 0xf5, 0xe5, 0xf9, 0x76, 0x40, 0xb6, 0x21,
+	0xde, 0xa3, 0x13, 0xcc, 0x03, 0xe4, 0x3d, 0xac, 0x4a, 0xa3, 0x2d, 0x6a, 0xdb, 0x59, 0x7a, 0xe2,
+	0x69, 0x3a, 0xa1, 0x3f, 0x8e, 0x39, 0xfb, 0x8f, 0x92, 0xaf, 0x40, 0x9c, 0x39, 0xa0, 0xe6, 0x52,
+	0x59, 0xd7, 0xaa, 0xa2, 0x73, 0xca, 0x68, 0x1a, 0x27, 0x71, 0xb6, 0xbe, 0x4c, 0x26, 0x07, 0x7c,
+	0xef, 0xc1, 0xfc, 0x0e, 0xc7, 0xce, 0xdc, 0xf4, 0x53, 0x9a, 0xc1, 0xfa, 0x4e, 0x77, 0xe4, 0x25,
+	0x3c, 0x29, 0x7f, 0x0a, 0xa5, 0xb9, 0x92, 0x7e, 0x88, 0x53, 0xb6, 0xf4, 0xf5, 0x4e, 0xa6, 0x39,
+	0x3c, 0x9b, 0x76, 0x46, 0xde, 0xc2, 0x4c, 0x36, 0xc6, 0x86, 0x79, 0x2f, 0x1e, 0x9b, 0x20, 0x6f,
+	0x8c, 0x65, 0x9e, 0x4c, 0xff, 0x9c, 0xc0, 0xe6, 0xa1, 0x98, 0x50, 0x58, 0xca, 0xa3, 0x16, 0xd6,
+	0x1d, 0x69, 0x94, 0xc4, 0xd9, 0x8a, 0x8d, 0x25, 0x79, 0x03, 0x9b, 0xa2, 0x32, 0xe5, 0x81, 0x2b,
+	0xed, 0xb0, 0xbd, 0x16, 0x15, 0x57, 0x9a, 0xd7, 0xc3, 0xda, 0x62, 0x76, 0xe6, 0xb3, 0x5d, 0x88,
+	0x76, 0x7a, 0x6f, 0xc9, 0x15, 0x3c, 0x0f, 0xee, 0x54, 0x89, 0xbd, 0x72, 0x1e, 0xd2, 0x7b, 0xd2,
+	0x2b, 0x78, 0x3a, 0x4a, 0x56, 0xdd, 0x22, 0x9d, 0x25, 0x51, 0x36, 0x67, 0xeb, 0xf0, 0xed, 0x9b,
+	0xba, 0x45, 0xf2, 0x01, 0x2e, 0x44, 0x59, 0x62, 0xe3, 0x50, 0x72, 0x8d, 0xee, 0x97, 0x69, 0x0f,
+	0x5c, 0x62, 0x25, 0x8e, 0xe1, 0xf4, 0xb9, 0x3f, 0x9d, 0x8e, 0xcc, 0x97, 0x01, 0xc9, 0x7b, 0xc2,
+	0xff, 0xe2, 0x1d, 0xbc, 0xa8, 0x7b, 0x52, 0x69, 0xc7, 0x65, 0xd7, 0x8a, 0xfe, 0x02, 0x82, 0xba,
+	0x18, 0x1a, 0xab, 0x95, 0xde, 0x2b, 0xed, 0xf2, 0x10, 0xfe, 0xb3, 0xc4, 0xcd, 0x83, 0xd6, 0x32,
+	0x58, 0xe2, 0x66, 0x6a, 0xa5, 0x9f, 0x81, 0x3e, 0xf6, 0x0c, 0xfa, 0x55, 0x0b, 0x29, 0x5b, 0xb4,
+	0xc3, 0xc5, 0xad, 0xd8, 0x58, 0x92, 0x0d, 0xcc, 0xaf, 0x45, 0xd5, 0xa1, 0xdf, 0xed, 0x8a, 0x0d,
+	0x45, 0xb1, 0xf0, 0x0f, 0xfe, 0xea, 0xef, 0x00, 0xc2, 0xb8, 0xe5, 0x08, 0x01, 0x03, 0x00, 0x00,
 }
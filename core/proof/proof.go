@@ -0,0 +1,101 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+// Package proof verifies the merkle proofs returned by GetProof rpc, light clients can import it.
+package proof
+
+import (
+	"regexp"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/common/trie/pb"
+	"github.com/nebulasio/go-nebulas/core/pb"
+)
+
+var (
+	// storageKeyPattern is the pattern of map item keys in contract storage, same as nf/nvm.
+	storageKeyPattern = regexp.MustCompile("^@([a-zA-Z_$][a-zA-Z0-9_]+?)\\[(.*?)\\]$")
+
+	// defaultDomainKey is the domain of object item keys in contract storage.
+	defaultDomainKey = "_"
+)
+
+// StorageKey return the key in the variables trie of a contract storage key,
+// such as "totalSupply" or "@balances[n1...]".
+func StorageKey(key string) []byte {
+	matches := storageKeyPattern.FindAllStringSubmatch(key, -1)
+	if matches == nil {
+		return trie.HashDomains(defaultDomainKey, key)
+	}
+	return trie.HashDomains(matches[0][1], matches[0][2])
+}
+
+// EncodeProof encode the nodes in proof into bytes.
+func EncodeProof(proof trie.MerkleProof) ([][]byte, error) {
+	nodes := make([][]byte, len(proof))
+	for i, val := range proof {
+		data, err := proto.Marshal(&triepb.Node{Val: val})
+		if err != nil {
+			return nil, err
+		}
+		nodes[i] = data
+	}
+	return nodes, nil
+}
+
+// DecodeProof decode the encoded nodes into proof.
+func DecodeProof(nodes [][]byte) (trie.MerkleProof, error) {
+	proof := make(trie.MerkleProof, len(nodes))
+	for i, data := range nodes {
+		pb := new(triepb.Node)
+		if err := proto.Unmarshal(data, pb); err != nil {
+			return nil, err
+		}
+		proof[i] = pb.Val
+	}
+	return proof, nil
+}
+
+// VerifyAccount verify the encoded proof of address in the accounts trie of state root,
+// return the proved account.
+func VerifyAccount(stateRoot []byte, address []byte, nodes [][]byte) (*corepb.Account, error) {
+	proof, err := DecodeProof(nodes)
+	if err != nil {
+		return nil, err
+	}
+	value, err := trie.VerifyProof(stateRoot, address, proof)
+	if err != nil {
+		return nil, err
+	}
+	account := new(corepb.Account)
+	if err := proto.Unmarshal(value, account); err != nil {
+		return nil, err
+	}
+	return account, nil
+}
+
+// VerifyStorage verify the encoded proof of the storage key in the variables trie
+// of a proved contract account, return the proved value.
+func VerifyStorage(account *corepb.Account, key string, nodes [][]byte) ([]byte, error) {
+	proof, err := DecodeProof(nodes)
+	if err != nil {
+		return nil, err
+	}
+	return trie.VerifyProof(account.VarsHash, StorageKey(key), proof)
+}
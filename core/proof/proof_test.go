@@ -0,0 +1,85 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package proof
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerifyAccountAndStorage(t *testing.T) {
+	stor, _ := storage.NewMemoryStorage()
+	varsTrie, _ := trie.NewTrie(nil, stor, false)
+	_, err := varsTrie.Put(StorageKey("totalSupply"), []byte("1000"))
+	assert.Nil(t, err)
+	_, err = varsTrie.Put(StorageKey("@balances[n1a]"), []byte("10"))
+	assert.Nil(t, err)
+	assert.Equal(t, trie.HashDomains("balances", "n1a"), StorageKey("@balances[n1a]"))
+	assert.Equal(t, trie.HashDomains("_", "totalSupply"), StorageKey("totalSupply"))
+
+	accTrie, _ := trie.NewTrie(nil, stor, false)
+	addrs := [][]byte{[]byte("address1"), []byte("address2"), []byte("address3")}
+	for i, addr := range addrs {
+		acc := &corepb.Account{Address: addr, Nonce: uint64(i)}
+		if i == 0 {
+			acc.VarsHash = varsTrie.RootHash()
+		}
+		data, _ := proto.Marshal(acc)
+		_, err := accTrie.Put(addr, data)
+		assert.Nil(t, err)
+	}
+
+	accProof, err := accTrie.Prove(addrs[0])
+	assert.Nil(t, err)
+	nodes, err := EncodeProof(accProof)
+	assert.Nil(t, err)
+	acc, err := VerifyAccount(accTrie.RootHash(), addrs[0], nodes)
+	assert.Nil(t, err)
+	assert.Equal(t, addrs[0], acc.Address)
+	assert.Equal(t, varsTrie.RootHash(), acc.VarsHash)
+
+	_, err = VerifyAccount(accTrie.RootHash(), addrs[1], nodes)
+	assert.Equal(t, trie.ErrInvalidProof, err)
+	_, err = VerifyAccount(varsTrie.RootHash(), addrs[0], nodes)
+	assert.Equal(t, trie.ErrInvalidProof, err)
+
+	// a tampered account doesn't match the hash in its parent node.
+	tampered, _ := DecodeProof(nodes)
+	last := tampered[len(tampered)-1]
+	fake, _ := proto.Marshal(&corepb.Account{Address: addrs[0], Nonce: 100})
+	last[2] = fake
+	tamperedNodes, _ := EncodeProof(tampered)
+	_, err = VerifyAccount(accTrie.RootHash(), addrs[0], tamperedNodes)
+	assert.Equal(t, trie.ErrInvalidProof, err)
+
+	varsProof, err := varsTrie.Prove(StorageKey("@balances[n1a]"))
+	assert.Nil(t, err)
+	nodes, err = EncodeProof(varsProof)
+	assert.Nil(t, err)
+	value, err := VerifyStorage(acc, "@balances[n1a]", nodes)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("10"), value)
+	_, err = VerifyStorage(acc, "totalSupply", nodes)
+	assert.Equal(t, trie.ErrInvalidProof, err)
+}
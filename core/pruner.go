@@ -0,0 +1,266 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// PruneInterval is the interval of online pruning.
+var PruneInterval = 10 * time.Minute
+
+// Pruner deletes the trie nodes unreachable from the states kept in chain.
+// The states of genesis, the latest irreversible block with the keep blocks before it,
+// and all blocks after it are kept, the others are pruned.
+type Pruner struct {
+	chain *BlockChain
+	keep  uint64
+
+	mu     sync.Mutex
+	quitCh chan int
+}
+
+// NewPruner create a new Pruner
+func NewPruner(chain *BlockChain, keep uint64) *Pruner {
+	return &Pruner{
+		chain:  chain,
+		keep:   keep,
+		quitCh: make(chan int, 1),
+	}
+}
+
+// Start start loop.
+func (p *Pruner) Start() {
+	logging.CLog().WithFields(logrus.Fields{
+		"keep": p.keep,
+	}).Info("Starting Pruner...")
+
+	go p.loop()
+}
+
+// Stop stop loop.
+func (p *Pruner) Stop() {
+	logging.CLog().Info("Stopping Pruner...")
+	p.quitCh <- 0
+}
+
+func (p *Pruner) loop() {
+	logging.CLog().Info("Started Pruner.")
+	timerChan := time.NewTicker(PruneInterval).C
+	for {
+		select {
+		case <-p.quitCh:
+			logging.CLog().Info("Stopped Pruner.")
+			return
+		case <-timerChan:
+			if _, err := p.Prune(); err != nil {
+				logging.VLog().WithFields(logrus.Fields{
+					"err": err,
+				}).Error("Failed to prune states.")
+			}
+		}
+	}
+}
+
+// Prune delete the unreachable trie nodes in storage, return the count of deleted nodes.
+func (p *Pruner) Prune() (int, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	bc := p.chain
+	iterable, ok := bc.storage.(storage.Iterable)
+	if !ok {
+		return 0, ErrStorageNotIterable
+	}
+
+	lib := bc.LIB()
+	if lib.Height() <= p.keep+1 {
+		return 0, nil
+	}
+	height := p.evidenceHeight(lib, lib.Height()-p.keep-1)
+	if height <= bc.PrunedHeight() {
+		return 0, nil
+	}
+
+	marked := make(map[string]bool)
+	var garbage [][]byte
+
+	// mark and sweep on a snapshot first, so that the chain is not blocked
+	// by walking all the states. the blocks must be collected before the
+	// snapshot is taken, so that their states are in the snapshot.
+	snapshotter, snapshotable := bc.storage.(storage.Snapshotter)
+	if snapshotable {
+		blocks := p.keptBlocks(height)
+		snapshot, err := snapshotter.Snapshot()
+		if err != nil {
+			return 0, err
+		}
+		if err := markBlocks(snapshot, blocks, marked); err != nil {
+			snapshot.Release()
+			return 0, err
+		}
+		garbage, err = sweep(snapshot, marked)
+		snapshot.Release()
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	// states are only committed to storage when blocks are pushed into block pool,
+	// hold its lock to mark the states committed meanwhile and delete the garbage.
+	bc.bkPool.mu.Lock()
+	defer bc.bkPool.mu.Unlock()
+
+	if err := markBlocks(bc.storage, p.keptBlocks(height), marked); err != nil {
+		return 0, err
+	}
+	if !snapshotable {
+		var err error
+		if garbage, err = sweep(iterable, marked); err != nil {
+			return 0, err
+		}
+	}
+
+	count := 0
+	bc.storage.EnableBatch()
+	defer bc.storage.DisableBatch()
+	for _, key := range garbage {
+		if marked[string(key)] {
+			continue
+		}
+		if err := bc.storage.Del(key); err != nil {
+			return 0, err
+		}
+		count++
+	}
+	if err := bc.storage.Flush(); err != nil {
+		return 0, err
+	}
+	if err := bc.StorePrunedHeightToStorage(height); err != nil {
+		return 0, err
+	}
+	bc.prunedHeight = height
+
+	// cached blocks below the pruned height refer to deleted states.
+	for _, k := range bc.cachedBlocks.Keys() {
+		if v, _ := bc.cachedBlocks.Get(k); v != nil && v.(*Block).Height() <= height {
+			bc.cachedBlocks.Remove(k)
+		}
+	}
+
+	logging.CLog().WithFields(logrus.Fields{
+		"prunedHeight": height,
+		"lib":          lib,
+		"deleted":      count,
+	}).Info("Pruned states.")
+	return count, nil
+}
+
+// evidenceHeight lower the pruned height to keep the dynasties that the double mint
+// evidences after LIB may refer to.
+func (p *Pruner) evidenceHeight(lib *Block, height uint64) uint64 {
+	since, err := lib.WorldState().EvidenceSince(lib.Timestamp())
+	if err != nil {
+		// no evidences without candidates in consensus.
+		return height
+	}
+	for ; height > 0; height-- {
+		block := p.chain.GetBlockOnCanonicalChainByHeight(height)
+		if block == nil || block.Timestamp() < since {
+			break
+		}
+	}
+	return height
+}
+
+// keptBlocks return the blocks whose states are kept after pruning to the height.
+func (p *Pruner) keptBlocks(height uint64) []*Block {
+	bc := p.chain
+	blocks := []*Block{bc.GenesisBlock()}
+
+	lib := bc.LIB()
+	for h := height + 1; h <= lib.Height(); h++ {
+		if block := bc.GetBlockOnCanonicalChainByHeight(h); block != nil {
+			blocks = append(blocks, block)
+		}
+	}
+
+	// blocks after LIB, including the forks.
+	tails := append(bc.DetachedTailBlocks(), bc.TailBlock())
+	visited := make(map[byteutils.HexHash]bool)
+	for _, tail := range tails {
+		for block := tail; block != nil && block.Height() > lib.Height(); block = bc.GetBlock(block.ParentHash()) {
+			if visited[block.Hash().Hex()] {
+				break
+			}
+			visited[block.Hash().Hex()] = true
+			blocks = append(blocks, block)
+		}
+	}
+	return blocks
+}
+
+func markBlocks(stor storage.Reader, blocks []*Block, marked map[string]bool) error {
+	visitAccount := func(value []byte) error {
+		pbAcc := new(corepb.Account)
+		if err := proto.Unmarshal(value, pbAcc); err != nil {
+			return err
+		}
+		return trie.MarkNodes(stor, pbAcc.VarsHash, marked, nil)
+	}
+
+	for _, block := range blocks {
+		if err := trie.MarkNodes(stor, block.StateRoot(), marked, visitAccount); err != nil {
+			return err
+		}
+		roots := [][]byte{block.TxsRoot(), block.EventsRoot()}
+		if root := block.ConsensusRoot(); root != nil {
+			roots = append(roots, root.DynastyRoot, root.CandidatesRoot, root.DelegateRoot, root.VoteRoot, root.SlashedRoot)
+		}
+		for _, root := range roots {
+			if err := trie.MarkNodes(stor, root, marked, nil); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// sweep return the keys of unmarked trie nodes.
+func sweep(stor storage.Iterable, marked map[string]bool) ([][]byte, error) {
+	garbage := [][]byte{}
+	iter := stor.RangeIterator(nil, nil)
+	defer iter.Release()
+	for iter.Next() {
+		if marked[string(iter.Key())] || !trie.IsNode(iter.Key(), iter.Value()) {
+			continue
+		}
+		garbage = append(garbage, append([]byte{}, iter.Key()...))
+	}
+	return garbage, iter.Error()
+}
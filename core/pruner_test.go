@@ -0,0 +1,82 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPruner_Prune(t *testing.T) {
+	neb := testNeb(t)
+	bc := neb.chain
+
+	coinbase, _ := AddressParse("n1JNHZJEUvfBYfjDRD14Q73FX62nJAzXkMR")
+	blocks := []*Block{bc.GenesisBlock()}
+	for i := 1; i <= 6; i++ {
+		block, err := bc.NewBlock(coinbase)
+		assert.Nil(t, err)
+		block.header.timestamp = BlockInterval * int64(i)
+		assert.Nil(t, block.Seal())
+		signBlock(block)
+		assert.Nil(t, bc.BlockPool().Push(block))
+		assert.Equal(t, block.Hash(), bc.TailBlock().Hash())
+		blocks = append(blocks, block)
+	}
+
+	// nothing to prune before the keep blocks of LIB.
+	pruner := NewPruner(bc, 2)
+	bc.SetLIB(blocks[2])
+	count, err := pruner.Prune()
+	assert.Nil(t, err)
+	assert.Equal(t, 0, count)
+
+	// the states below LIB-keep are pruned.
+	lib := blocks[5]
+	bc.SetLIB(lib)
+	count, err = pruner.Prune()
+	assert.Nil(t, err)
+	assert.True(t, count > 0)
+	assert.Equal(t, lib.Height()-3, bc.PrunedHeight())
+
+	for _, block := range blocks {
+		loaded, err := LoadBlockFromStorage(block.Hash(), bc)
+		assert.Nil(t, err)
+		if block.Height() > bc.PrunedHeight() || block == bc.GenesisBlock() {
+			assert.False(t, loaded.StatePruned())
+			_, err = trie.NewTrie(block.StateRoot(), bc.storage, false)
+			assert.Nil(t, err)
+			_, err = loaded.WorldState().GetOrCreateUserAccount(coinbase.Bytes())
+			assert.Nil(t, err)
+		} else {
+			assert.True(t, loaded.StatePruned())
+			_, err = trie.NewTrie(block.StateRoot(), bc.storage, false)
+			assert.Equal(t, storage.ErrKeyNotFound, err)
+			assert.True(t, bc.GetBlock(block.Hash()).StatePruned())
+		}
+	}
+
+	// pruning again to the same height deletes nothing.
+	count, err = pruner.Prune()
+	assert.Nil(t, err)
+	assert.Equal(t, 0, count)
+}
@@ -132,6 +132,22 @@ func (acc *account) Clone() (Account, error) {
 	}, nil
 }
 
+// bindTo return a copy of acc whose variables are read and written through storage.
+func (acc *account) bindTo(storage storage.Storage) (*account, error) {
+	variables, err := trie.NewTrie(acc.variables.RootHash(), storage, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &account{
+		address:    acc.address,
+		balance:    acc.balance,
+		nonce:      acc.nonce,
+		variables:  variables,
+		birthPlace: acc.birthPlace,
+	}, nil
+}
+
 // IncrNonce by 1
 func (acc *account) IncrNonce() {
 	acc.nonce++
@@ -362,6 +378,43 @@ func (as *accountState) Replay(done AccountState) error {
 	return nil
 }
 
+// nested return an account state over storage seeing the unflushed changes of as.
+func (as *accountState) nested(storage storage.Storage) (*accountState, error) {
+	stateTrie, err := trie.NewTrie(as.stateTrie.RootHash(), storage, false)
+	if err != nil {
+		return nil, err
+	}
+
+	dirtyAccount := make(map[byteutils.HexHash]Account)
+	for addr, acc := range as.dirtyAccount {
+		if dirtyAccount[addr], err = acc.(*account).bindTo(storage); err != nil {
+			return nil, err
+		}
+	}
+
+	return &accountState{
+		stateTrie:    stateTrie,
+		dirtyAccount: dirtyAccount,
+		storage:      storage,
+	}, nil
+}
+
+// merge the changes of a nested account state, accounts already loaded in as are updated in place.
+func (as *accountState) merge(done *accountState) error {
+	for addr, acc := range done.dirtyAccount {
+		merged, err := acc.(*account).bindTo(as.storage)
+		if err != nil {
+			return err
+		}
+		if existed, ok := as.dirtyAccount[addr]; ok {
+			*existed.(*account) = *merged
+		} else {
+			as.dirtyAccount[addr] = merged
+		}
+	}
+	return nil
+}
+
 // Clone an accountState
 func (as *accountState) Clone() (AccountState, error) {
 	stateTrie, err := as.stateTrie.Clone()
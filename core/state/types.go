@@ -39,6 +39,7 @@ var (
 	ErrCannotUpdateTxStateBeforePrepare    = errors.New("cannot update a tx state before prepare")
 	ErrCannotResetTxStateBeforePrepare     = errors.New("cannot reset a tx state before prepare")
 	ErrContractCheckFailed                 = errors.New("contract check failed")
+	ErrCandidateNotSupported               = errors.New("candidate and delegate are not supported by current consensus")
 )
 
 // Iterator Variables in Account Storage
@@ -113,6 +114,20 @@ type ConsensusState interface {
 	DynastyRoot() byteutils.Hash
 }
 
+// CandidateState interface of consensus state supporting candidates and delegates on chain
+type CandidateState interface {
+	LoginCandidate(byteutils.Hash) error
+	LogoutCandidate(byteutils.Hash) error
+	Delegate(byteutils.Hash, byteutils.Hash) error
+	UnDelegate(byteutils.Hash, byteutils.Hash) error
+	Slash(byteutils.Hash) error
+
+	// VerifyProposer check the proposer is the one scheduled by the consensus root.
+	VerifyProposer(*consensuspb.ConsensusRoot, byteutils.Hash) error
+	// EvidenceSince return the earliest slot whose double mint evidence is accepted at the time.
+	EvidenceSince(int64) int64
+}
+
 // WorldState interface of world state
 type WorldState interface {
 	Begin() error
@@ -153,6 +168,14 @@ type WorldState interface {
 	Dynasty() ([]byteutils.Hash, error)
 	DynastyRoot() byteutils.Hash
 
+	LoginCandidate(candidate byteutils.Hash) error
+	LogoutCandidate(candidate byteutils.Hash) error
+	Delegate(delegator byteutils.Hash, delegatee byteutils.Hash) error
+	UnDelegate(delegator byteutils.Hash, delegatee byteutils.Hash) error
+	Slash(offender byteutils.Hash) error
+	VerifyProposer(root *consensuspb.ConsensusRoot, proposer byteutils.Hash) error
+	EvidenceSince(now int64) (int64, error)
+
 	RecordGas(from string, gas *util.Uint128) error
 	GetGas() map[string]*util.Uint128
 }
@@ -164,8 +187,10 @@ type TxWorldState interface {
 	EventsRoot() byteutils.Hash
 	ConsensusRoot() *consensuspb.ConsensusRoot
 
+	Prepare(interface{}) (TxWorldState, error)
 	CheckAndUpdate() ([]interface{}, error)
 	Reset() error
+	Flush() error
 	Close() error
 
 	Accounts() ([]Account, error)
@@ -182,5 +207,13 @@ type TxWorldState interface {
 	Dynasty() ([]byteutils.Hash, error)
 	DynastyRoot() byteutils.Hash
 
+	LoginCandidate(candidate byteutils.Hash) error
+	LogoutCandidate(candidate byteutils.Hash) error
+	Delegate(delegator byteutils.Hash, delegatee byteutils.Hash) error
+	UnDelegate(delegator byteutils.Hash, delegatee byteutils.Hash) error
+	Slash(offender byteutils.Hash) error
+	VerifyProposer(root *consensuspb.ConsensusRoot, proposer byteutils.Hash) error
+	EvidenceSince(now int64) (int64, error)
+
 	RecordGas(from string, gas *util.Uint128) error
 }
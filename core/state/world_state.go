@@ -329,6 +329,89 @@ func (s *states) Prepare(txid interface{}) (*states, error) {
 	}, nil
 }
 
+// prepareNested prepare states nested in a prepared one, the changes of s are visible without flushing.
+func (s *states) prepareNested(txid interface{}) (*states, error) {
+	changelog, err := s.changelog.Prepare(txid)
+	if err != nil {
+		return nil, err
+	}
+	stateDB, err := s.stateDB.Prepare(txid)
+	if err != nil {
+		return nil, err
+	}
+
+	accState, err := s.accState.(*accountState).nested(stateDB)
+	if err != nil {
+		return nil, err
+	}
+	txsState, err := trie.NewTrie(s.TxsRoot(), stateDB, true)
+	if err != nil {
+		return nil, err
+	}
+	eventsState, err := trie.NewTrie(s.EventsRoot(), stateDB, true)
+	if err != nil {
+		return nil, err
+	}
+	consensusState, err := s.consensus.NewState(s.ConsensusRoot(), stateDB, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return &states{
+		accState:       accState,
+		txsState:       txsState,
+		eventsState:    eventsState,
+		consensusState: consensusState,
+
+		consensus: s.consensus,
+		changelog: changelog,
+		stateDB:   stateDB,
+		innerDB:   s.innerDB,
+		txid:      txid,
+
+		gasConsumed: make(map[string]*util.Uint128),
+		events:      make(map[string][]*Event),
+	}, nil
+}
+
+// mergeNestedTo merge the changes of nested states into its parent.
+// events are kept in memory, they are replayed with the outermost transaction.
+func (s *states) mergeNestedTo(parent *states) ([]interface{}, error) {
+	dependency, err := s.changelog.CheckAndUpdate()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.stateDB.CheckAndUpdate(); err != nil {
+		return nil, err
+	}
+
+	if err := parent.accState.(*accountState).merge(s.accState.(*accountState)); err != nil {
+		return nil, err
+	}
+	if _, err := parent.txsState.Replay(s.txsState); err != nil {
+		return nil, err
+	}
+	if err := parent.consensusState.Replay(s.consensusState); err != nil {
+		return nil, err
+	}
+	for tx, events := range s.events {
+		parent.events[tx] = append(parent.events[tx], events...)
+	}
+	s.events = make(map[string][]*Event)
+	for from, gas := range s.gasConsumed {
+		consumed, ok := parent.gasConsumed[from]
+		if !ok {
+			consumed = util.NewUint128()
+		}
+		consumed, err := consumed.Add(gas)
+		if err != nil {
+			return nil, err
+		}
+		parent.gasConsumed[from] = consumed
+	}
+	return dependency, nil
+}
+
 func (s *states) CheckAndUpdateTo(parent *states) ([]interface{}, error) {
 	// logging.CLog().Info("WS CheckAndUpdateTo MVCCDB: ", s.txid)
 	dependency, err := s.changelog.CheckAndUpdate()
@@ -510,6 +593,76 @@ func (s *states) DynastyRoot() byteutils.Hash {
 	return s.consensusState.DynastyRoot()
 }
 
+func (s *states) candidateState(addrs ...byteutils.Hash) (CandidateState, error) {
+	candidateState, ok := s.consensusState.(CandidateState)
+	if !ok {
+		return nil, ErrCandidateNotSupported
+	}
+	// record the touched addresses to find conflicts between txs
+	for _, addr := range addrs {
+		if err := s.changelog.Put(addr, addr); err != nil {
+			return nil, err
+		}
+	}
+	return candidateState, nil
+}
+
+func (s *states) LoginCandidate(candidate byteutils.Hash) error {
+	candidateState, err := s.candidateState(candidate)
+	if err != nil {
+		return err
+	}
+	return candidateState.LoginCandidate(candidate)
+}
+
+func (s *states) LogoutCandidate(candidate byteutils.Hash) error {
+	candidateState, err := s.candidateState(candidate)
+	if err != nil {
+		return err
+	}
+	return candidateState.LogoutCandidate(candidate)
+}
+
+func (s *states) Delegate(delegator byteutils.Hash, delegatee byteutils.Hash) error {
+	candidateState, err := s.candidateState(delegator, delegatee)
+	if err != nil {
+		return err
+	}
+	return candidateState.Delegate(delegator, delegatee)
+}
+
+func (s *states) UnDelegate(delegator byteutils.Hash, delegatee byteutils.Hash) error {
+	candidateState, err := s.candidateState(delegator, delegatee)
+	if err != nil {
+		return err
+	}
+	return candidateState.UnDelegate(delegator, delegatee)
+}
+
+func (s *states) Slash(offender byteutils.Hash) error {
+	candidateState, err := s.candidateState(offender)
+	if err != nil {
+		return err
+	}
+	return candidateState.Slash(offender)
+}
+
+func (s *states) VerifyProposer(root *consensuspb.ConsensusRoot, proposer byteutils.Hash) error {
+	candidateState, err := s.candidateState()
+	if err != nil {
+		return err
+	}
+	return candidateState.VerifyProposer(root, proposer)
+}
+
+func (s *states) EvidenceSince(now int64) (int64, error) {
+	candidateState, err := s.candidateState()
+	if err != nil {
+		return 0, err
+	}
+	return candidateState.EvidenceSince(now), nil
+}
+
 func (s *states) Accounts() ([]Account, error) { // TODO delete
 	return s.accState.Accounts()
 }
@@ -644,7 +797,7 @@ func (ws *worldState) Prepare(txid interface{}) (TxWorldState, error) {
 	txState := &txWorldState{
 		states: s,
 		txid:   txid,
-		parent: ws,
+		parent: ws.states,
 	}
 	return txState, nil
 }
@@ -660,11 +813,37 @@ func (ws *worldState) SetConsensusState(consensusState ConsensusState) {
 type txWorldState struct {
 	*states
 	txid   interface{}
-	parent *worldState
+	parent *states
+	nested bool
+}
+
+// Prepare a nested TxWorldState, used by contract-to-contract calls.
+// Its changes are merged into tws by CheckAndUpdate, or dropped by Reset and Close.
+func (tws *txWorldState) Prepare(txid interface{}) (TxWorldState, error) {
+	s, err := tws.states.prepareNested(txid)
+	if err != nil {
+		logging.VLog().Info("PPE 2")
+		return nil, err
+	}
+	txState := &txWorldState{
+		states: s,
+		txid:   txid,
+		parent: tws.states,
+		nested: true,
+	}
+	return txState, nil
 }
 
 func (tws *txWorldState) CheckAndUpdate() ([]interface{}, error) {
-	dependencies, err := tws.states.CheckAndUpdateTo(tws.parent.states)
+	var (
+		dependencies []interface{}
+		err          error
+	)
+	if tws.nested {
+		dependencies, err = tws.states.mergeNestedTo(tws.parent)
+	} else {
+		dependencies, err = tws.states.CheckAndUpdateTo(tws.parent)
+	}
 	if err != nil {
 		logging.VLog().Info("CUE 1")
 		return nil, err
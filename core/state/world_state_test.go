@@ -0,0 +1,127 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package state
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/consensus/pb"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockConsensusState struct{}
+
+func (cs *mockConsensusState) RootHash() *consensuspb.ConsensusRoot {
+	return &consensuspb.ConsensusRoot{}
+}
+func (cs *mockConsensusState) String() string                     { return "" }
+func (cs *mockConsensusState) Clone() (ConsensusState, error)     { return cs, nil }
+func (cs *mockConsensusState) Replay(ConsensusState) error        { return nil }
+func (cs *mockConsensusState) Proposer() byteutils.Hash           { return nil }
+func (cs *mockConsensusState) TimeStamp() int64                   { return 0 }
+func (cs *mockConsensusState) Dynasty() ([]byteutils.Hash, error) { return nil, nil }
+func (cs *mockConsensusState) DynastyRoot() byteutils.Hash        { return nil }
+func (cs *mockConsensusState) NextConsensusState(int64, WorldState) (ConsensusState, error) {
+	return cs, nil
+}
+
+type mockConsensus struct{}
+
+func (c *mockConsensus) NewState(*consensuspb.ConsensusRoot, storage.Storage, bool) (ConsensusState, error) {
+	return &mockConsensusState{}, nil
+}
+
+func TestTxWorldState_Prepare(t *testing.T) {
+	stor, err := storage.NewMemoryStorage()
+	assert.Nil(t, err)
+	ws, err := NewWorldState(&mockConsensus{}, stor)
+	assert.Nil(t, err)
+	assert.Nil(t, ws.Begin())
+
+	txHash := []byte("tx")
+	txid := byteutils.Hex(txHash)
+	tws, err := ws.Prepare(txid)
+	assert.Nil(t, err)
+	caller, err := tws.GetOrCreateUserAccount([]byte("caller"))
+	assert.Nil(t, err)
+	assert.Nil(t, caller.AddBalance(util.NewUint128FromUint(10)))
+	tws.RecordEvent(txHash, &Event{Topic: "caller", Data: "1"})
+
+	// a failed nested call is dropped.
+	nested, err := tws.Prepare(txid + "-call-1")
+	assert.Nil(t, err)
+	callee, err := nested.GetOrCreateUserAccount([]byte("callee"))
+	assert.Nil(t, err)
+	assert.Nil(t, callee.AddBalance(util.NewUint128FromUint(5)))
+	nested.RecordEvent(txHash, &Event{Topic: "callee", Data: "1"})
+	assert.Nil(t, nested.Reset())
+	assert.Nil(t, nested.Close())
+
+	callee, err = tws.GetOrCreateUserAccount([]byte("callee"))
+	assert.Nil(t, err)
+	assert.Equal(t, util.NewUint128(), callee.Balance())
+
+	// a successful nested call is merged, its events follow the caller's.
+	nested, err = tws.Prepare(txid + "-call-2")
+	assert.Nil(t, err)
+	nestedCaller, err := nested.GetOrCreateUserAccount([]byte("caller"))
+	assert.Nil(t, err)
+	assert.Equal(t, util.NewUint128FromUint(10), nestedCaller.Balance())
+	assert.Nil(t, nestedCaller.SubBalance(util.NewUint128FromUint(3)))
+	assert.Nil(t, nestedCaller.Put([]byte("key"), []byte("value")))
+	callee, err = nested.GetOrCreateUserAccount([]byte("callee"))
+	assert.Nil(t, err)
+	assert.Nil(t, callee.AddBalance(util.NewUint128FromUint(3)))
+	nested.RecordEvent(txHash, &Event{Topic: "callee", Data: "2"})
+	_, err = nested.CheckAndUpdate()
+	assert.Nil(t, err)
+	assert.Nil(t, nested.Close())
+
+	// accounts loaded by the caller are updated in place and still writable.
+	assert.Equal(t, util.NewUint128FromUint(7), caller.Balance())
+	value, err := caller.Get([]byte("key"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value"), value)
+	assert.Nil(t, caller.Put([]byte("key"), []byte("value2")))
+
+	tws.RecordEvent(txHash, &Event{Topic: "caller", Data: "2"})
+	_, err = tws.CheckAndUpdate()
+	assert.Nil(t, err)
+
+	caller, err = ws.GetOrCreateUserAccount([]byte("caller"))
+	assert.Nil(t, err)
+	assert.Equal(t, util.NewUint128FromUint(7), caller.Balance())
+	value, err = caller.Get([]byte("key"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value2"), value)
+	callee, err = ws.GetOrCreateUserAccount([]byte("callee"))
+	assert.Nil(t, err)
+	assert.Equal(t, util.NewUint128FromUint(3), callee.Balance())
+
+	events, err := ws.FetchEvents(txHash)
+	assert.Nil(t, err)
+	topics := []string{}
+	for _, event := range events {
+		topics = append(topics, event.Topic+event.Data)
+	}
+	assert.Equal(t, []string{"caller1", "callee2", "caller2"}, topics)
+}
@@ -75,6 +75,30 @@ type TransactionEvent struct {
 	Error   string `json:"error"`
 }
 
+// EventsFetcher fetches the events of a transaction.
+type EventsFetcher interface {
+	FetchEvents(byteutils.Hash) ([]*state.Event, error)
+}
+
+// TransactionSucceeded returns whether the execution result event of the tx is success.
+func TransactionSucceeded(ws EventsFetcher, txHash byteutils.Hash) (bool, error) {
+	events, err := ws.FetchEvents(txHash)
+	if err != nil {
+		return false, err
+	}
+	for _, event := range events {
+		if event.Topic != TopicTransactionExecutionResult {
+			continue
+		}
+		txEvent := TransactionEvent{}
+		if err := json.Unmarshal([]byte(event.Data), &txEvent); err != nil {
+			return false, err
+		}
+		return txEvent.Status == TxExecutionSuccess, nil
+	}
+	return false, nil
+}
+
 // Transaction type is used to handle all transaction data.
 type Transaction struct {
 	hash      byteutils.Hash
@@ -339,6 +363,16 @@ func (tx *Transaction) LoadPayload() (TxPayload, error) {
 		payload, err = LoadDeployPayload(tx.data.Payload)
 	case TxPayloadCallType:
 		payload, err = LoadCallPayload(tx.data.Payload)
+	case TxPayloadCandidateType:
+		payload, err = LoadCandidatePayload(tx.data.Payload)
+	case TxPayloadDelegateType:
+		payload, err = LoadDelegatePayload(tx.data.Payload)
+	case TxPayloadDipType:
+		payload, err = LoadDipPayload(tx.data.Payload)
+	case TxPayloadEvidenceType:
+		payload, err = LoadEvidencePayload(tx.data.Payload)
+	case TxPayloadUpgradeType:
+		payload, err = LoadUpgradePayload(tx.data.Payload)
 	default:
 		err = ErrInvalidTxPayloadType
 	}
@@ -496,7 +530,10 @@ func VerifyExecution(tx *Transaction, block *Block, ws WorldState) (bool, error)
 	}
 
 	// step7. execute contract.
-	gasExecution, _, exeErr := payload.Execute(contractLimitedGas, tx, block, ws)
+	gasExecution, result, exeErr := payload.Execute(contractLimitedGas, tx, block, ws)
+	if block.trace != nil {
+		block.trace.Result, block.trace.Err, block.trace.ExecutionGas = result, exeErr, gasExecution.Uint64()
+	}
 
 	// step8. calculate final gas.
 	allGas, gasErr := gasUsed.Add(gasExecution)
@@ -507,6 +544,15 @@ func VerifyExecution(tx *Transaction, block *Block, ws WorldState) (bool, error)
 		return submitTx(tx, block, ws, tx.gasLimit, ErrOutOfGasLimit, "Failed to check gasLimit >= allGas")
 	}
 
+	// the refund is deducted after checking the gas limit, which must cover all the executed gas.
+	if refunder, ok := payload.(gasRefunder); ok && exeErr == nil {
+		chargedGas, err := allGas.Sub(util.NewUint128FromUint(refunder.gasRefund()))
+		if err != nil {
+			return submitTx(tx, block, ws, allGas, ErrGasCntOverflow, "Failed to deduct the refund of execution gas")
+		}
+		allGas = chargedGas
+	}
+
 	// step9. over
 	return submitTx(tx, block, ws, allGas, exeErr, "Failed to execute payload")
 }
@@ -552,7 +598,7 @@ func (tx *Transaction) simulateExecution(block *Block) (*SimulateResult, error)
 	)
 
 	// try run smart contract if payload is.
-	if tx.data.Type == TxPayloadCallType || tx.data.Type == TxPayloadDeployType {
+	if tx.data.Type == TxPayloadCallType || tx.data.Type == TxPayloadDeployType || tx.data.Type == TxPayloadUpgradeType {
 
 		// transfer value to smart contract.
 		toAcc, err := ws.GetOrCreateUserAccount(tx.to.address)
@@ -29,6 +29,8 @@ import (
 type CallPayload struct {
 	Function string
 	Args     string
+
+	refund uint64
 }
 
 // LoadCallPayload from bytes
@@ -85,16 +87,13 @@ func (payload *CallPayload) Execute(limitedGas *util.Uint128, tx *Transaction, b
 		return util.NewUint128(), "", err
 	}
 
-	birthTx, err := GetTransaction(contract.BirthPlace(), ws)
-	if err != nil {
+	// reject calls mismatching the abi before running the engine.
+	if err := CheckContractCall(contract, payload.Function, payload.Args, tx.value); err != nil {
 		return util.NewUint128(), "", err
 	}
-	/* // useless owner.
-	owner, err := ws.GetOrCreateUserAccount(birthTx.from.Bytes())
-	if err != nil {
-		return util.NewUint128(), "", err
-	} */
-	deploy, err := LoadDeployPayload(birthTx.data.Payload) // ToConfirm: move deploy payload in ctx.
+
+	// the source of the latest version, upgrades keep the storage of the contract.
+	source, sourceType, err := ContractSource(contract, ws)
 	if err != nil {
 		return util.NewUint128(), "", err
 	}
@@ -105,12 +104,21 @@ func (payload *CallPayload) Execute(limitedGas *util.Uint128, tx *Transaction, b
 	}
 	defer engine.Dispose()
 
+	if block.trace != nil {
+		engine.SetTrace(block.trace)
+	}
+
 	if err := engine.SetExecutionLimits(limitedGas.Uint64(), DefaultLimitsOfTotalMemorySize); err != nil {
 		return util.NewUint128(), "", err
 	}
 
-	result, exeErr := engine.Call(deploy.Source, deploy.SourceType, payload.Function, payload.Args)
+	varsHash := contract.VarsHash()
+	result, exeErr := engine.Call(source, sourceType, payload.Function, payload.Args)
+	if exeErr == nil {
+		exeErr = CheckReadOnlyCall(contract, payload.Function, varsHash)
+	}
 	gasCout := engine.ExecutionInstructions()
+	payload.refund = engine.ExecutionRefund()
 	instructions, err := util.NewUint128FromInt(int64(gasCout))
 	if err != nil {
 		return util.NewUint128(), "", err
@@ -120,3 +128,7 @@ func (payload *CallPayload) Execute(limitedGas *util.Uint128, tx *Transaction, b
 	}
 	return instructions, result, exeErr
 }
+
+func (payload *CallPayload) gasRefund() uint64 {
+	return payload.refund
+}
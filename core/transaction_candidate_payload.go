@@ -0,0 +1,108 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+package core
+
+import (
+	"encoding/json"
+
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/util"
+)
+
+// Candidate Payload Actions
+const (
+	LoginAction  = "login"
+	LogoutAction = "logout"
+)
+
+// CandidateEvent is the event data of candidate transactions
+type CandidateEvent struct {
+	Hash      string `json:"hash"`
+	Action    string `json:"action"`
+	Candidate string `json:"candidate"`
+}
+
+// CandidatePayload carry candidate application
+type CandidatePayload struct {
+	Action string
+}
+
+// LoadCandidatePayload from bytes
+func LoadCandidatePayload(bytes []byte) (*CandidatePayload, error) {
+	payload := &CandidatePayload{}
+	if err := json.Unmarshal(bytes, payload); err != nil {
+		return nil, ErrInvalidArgument
+	}
+	return NewCandidatePayload(payload.Action)
+}
+
+// NewCandidatePayload with action
+func NewCandidatePayload(action string) (*CandidatePayload, error) {
+	if action != LoginAction && action != LogoutAction {
+		return nil, ErrInvalidCandidatePayloadAction
+	}
+	return &CandidatePayload{
+		Action: action,
+	}, nil
+}
+
+// ToBytes serialize payload
+func (payload *CandidatePayload) ToBytes() ([]byte, error) {
+	return json.Marshal(payload)
+}
+
+// BaseGasCount returns base gas count
+func (payload *CandidatePayload) BaseGasCount() *util.Uint128 {
+	base, _ := util.NewUint128FromInt(20)
+	return base
+}
+
+// Execute candidate payload in tx, login or logout the candidate
+func (payload *CandidatePayload) Execute(limitedGas *util.Uint128, tx *Transaction, block *Block, ws WorldState) (*util.Uint128, string, error) {
+	if block == nil || tx == nil {
+		return util.NewUint128(), "", ErrNilArgument
+	}
+
+	candidate := tx.from.Bytes()
+	switch payload.Action {
+	case LoginAction:
+		if err := ws.LoginCandidate(candidate); err != nil {
+			return util.NewUint128(), "", err
+		}
+	case LogoutAction:
+		if err := ws.LogoutCandidate(candidate); err != nil {
+			return util.NewUint128(), "", err
+		}
+	default:
+		return util.NewUint128(), "", ErrInvalidCandidatePayloadAction
+	}
+
+	data, err := json.Marshal(&CandidateEvent{
+		Hash:      tx.hash.String(),
+		Action:    payload.Action,
+		Candidate: tx.from.String(),
+	})
+	if err != nil {
+		return util.NewUint128(), "", err
+	}
+	ws.RecordEvent(tx.hash, &state.Event{
+		Topic: TopicCandidate,
+		Data:  string(data),
+	})
+	return util.NewUint128(), "", nil
+}
@@ -0,0 +1,119 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+package core
+
+import (
+	"encoding/json"
+
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/util"
+)
+
+// Delegate Payload Actions
+const (
+	DelegateAction   = "do"
+	UnDelegateAction = "undo"
+)
+
+// DelegateEvent is the event data of delegate transactions
+type DelegateEvent struct {
+	Hash      string `json:"hash"`
+	Action    string `json:"action"`
+	Delegator string `json:"delegator"`
+	Delegatee string `json:"delegatee"`
+}
+
+// DelegatePayload carry election information
+type DelegatePayload struct {
+	Action    string
+	Delegatee string
+}
+
+// LoadDelegatePayload from bytes
+func LoadDelegatePayload(bytes []byte) (*DelegatePayload, error) {
+	payload := &DelegatePayload{}
+	if err := json.Unmarshal(bytes, payload); err != nil {
+		return nil, ErrInvalidArgument
+	}
+	return NewDelegatePayload(payload.Action, payload.Delegatee)
+}
+
+// NewDelegatePayload with action & delegatee
+func NewDelegatePayload(action string, delegatee string) (*DelegatePayload, error) {
+	if action != DelegateAction && action != UnDelegateAction {
+		return nil, ErrInvalidDelegatePayloadAction
+	}
+	if _, err := AddressParse(delegatee); err != nil {
+		return nil, err
+	}
+	return &DelegatePayload{
+		Action:    action,
+		Delegatee: delegatee,
+	}, nil
+}
+
+// ToBytes serialize payload
+func (payload *DelegatePayload) ToBytes() ([]byte, error) {
+	return json.Marshal(payload)
+}
+
+// BaseGasCount returns base gas count
+func (payload *DelegatePayload) BaseGasCount() *util.Uint128 {
+	base, _ := util.NewUint128FromInt(20)
+	return base
+}
+
+// Execute delegate payload in tx, vote or cancel the vote on the delegatee
+func (payload *DelegatePayload) Execute(limitedGas *util.Uint128, tx *Transaction, block *Block, ws WorldState) (*util.Uint128, string, error) {
+	if block == nil || tx == nil {
+		return util.NewUint128(), "", ErrNilArgument
+	}
+
+	delegatee, err := AddressParse(payload.Delegatee)
+	if err != nil {
+		return util.NewUint128(), "", err
+	}
+	delegator := tx.from.Bytes()
+	switch payload.Action {
+	case DelegateAction:
+		if err := ws.Delegate(delegator, delegatee.Bytes()); err != nil {
+			return util.NewUint128(), "", err
+		}
+	case UnDelegateAction:
+		if err := ws.UnDelegate(delegator, delegatee.Bytes()); err != nil {
+			return util.NewUint128(), "", err
+		}
+	default:
+		return util.NewUint128(), "", ErrInvalidDelegatePayloadAction
+	}
+
+	data, err := json.Marshal(&DelegateEvent{
+		Hash:      tx.hash.String(),
+		Action:    payload.Action,
+		Delegator: tx.from.String(),
+		Delegatee: delegatee.String(),
+	})
+	if err != nil {
+		return util.NewUint128(), "", err
+	}
+	ws.RecordEvent(tx.hash, &state.Event{
+		Topic: TopicDelegate,
+		Data:  string(data),
+	})
+	return util.NewUint128(), "", nil
+}
@@ -30,6 +30,11 @@ type DeployPayload struct {
 	SourceType string
 	Source     string
 	Args       string
+	ABI        string `json:",omitempty"`
+	// Upgradable lets the deployer upgrade the contract.
+	Upgradable bool `json:",omitempty"`
+
+	refund uint64
 }
 
 // CheckContractArgs check contract args
@@ -49,11 +54,11 @@ func LoadDeployPayload(bytes []byte) (*DeployPayload, error) {
 	if err := json.Unmarshal(bytes, payload); err != nil {
 		return nil, ErrInvalidArgument
 	}
-	return NewDeployPayload(payload.Source, payload.SourceType, payload.Args)
+	return NewDeployPayload(payload.Source, payload.SourceType, payload.Args, payload.ABI, payload.Upgradable)
 }
 
-// NewDeployPayload with source & args
-func NewDeployPayload(source, sourceType, args string) (*DeployPayload, error) {
+// NewDeployPayload with source, args, optional abi & upgradable flag
+func NewDeployPayload(source, sourceType, args, abi string, upgradable bool) (*DeployPayload, error) {
 	if len(source) == 0 {
 		return nil, ErrInvalidDeploySource
 	}
@@ -66,10 +71,18 @@ func NewDeployPayload(source, sourceType, args string) (*DeployPayload, error) {
 		return nil, ErrInvalidArgument
 	}
 
+	if len(abi) > 0 {
+		if _, err := ParseContractABI(abi); err != nil {
+			return nil, err
+		}
+	}
+
 	return &DeployPayload{
 		Source:     source,
 		SourceType: sourceType,
 		Args:       args,
+		ABI:        abi,
+		Upgradable: upgradable,
 	}, nil
 }
 
@@ -113,12 +126,38 @@ func (payload *DeployPayload) Execute(limitedGas *util.Uint128, tx *Transaction,
 		return util.NewUint128(), "", err
 	}
 
+	// init is checked only when the abi declares it.
+	if len(payload.ABI) > 0 && block.Height() >= ContractABIHeight {
+		abi, err := ParseContractABI(payload.ABI)
+		if err != nil {
+			return util.NewUint128(), "", err
+		}
+		if abi.Function("init") != nil {
+			if err := abi.CheckCall("init", payload.Args, tx.value); err != nil {
+				return util.NewUint128(), "", err
+			}
+		}
+		if err := setContractABI(contract, payload.ABI); err != nil {
+			return util.NewUint128(), "", err
+		}
+	}
+
+	if payload.Upgradable && block.Height() >= ContractUpgradeHeight {
+		if err := setContractUpgrader(contract, tx.from); err != nil {
+			return util.NewUint128(), "", err
+		}
+	}
+
 	engine, err := block.nvm.CreateEngine(block, tx, contract, ws)
 	if err != nil {
 		return util.NewUint128(), "", err
 	}
 	defer engine.Dispose()
 
+	if block.trace != nil {
+		engine.SetTrace(block.trace)
+	}
+
 	if err := engine.SetExecutionLimits(limitedGas.Uint64(), DefaultLimitsOfTotalMemorySize); err != nil {
 		return util.NewUint128(), "", err
 	}
@@ -126,6 +165,7 @@ func (payload *DeployPayload) Execute(limitedGas *util.Uint128, tx *Transaction,
 	// Deploy and Init.
 	result, exeErr := engine.DeployAndInit(payload.Source, payload.SourceType, payload.Args)
 	gasCout := engine.ExecutionInstructions()
+	payload.refund = engine.ExecutionRefund()
 	instructions, err := util.NewUint128FromInt(int64(gasCout))
 	if err != nil {
 		return util.NewUint128(), "", err
@@ -135,3 +175,7 @@ func (payload *DeployPayload) Execute(limitedGas *util.Uint128, tx *Transaction,
 	}
 	return instructions, result, exeErr
 }
+
+func (payload *DeployPayload) gasRefund() uint64 {
+	return payload.refund
+}
@@ -0,0 +1,127 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"encoding/json"
+
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/util"
+)
+
+// DipReward is the reward of a contract's developer in an epoch
+type DipReward struct {
+	Contract  *Address
+	Developer *Address
+	Callers   uint64
+	Value     *util.Uint128
+}
+
+// DipRewardEvent is the event data of one dip reward
+type DipRewardEvent struct {
+	Contract  string `json:"contract"`
+	Developer string `json:"developer"`
+	Callers   uint64 `json:"callers"`
+	Value     string `json:"value"`
+}
+
+// DipEvent is the event data of dip transactions
+type DipEvent struct {
+	Hash    string            `json:"hash"`
+	Epoch   uint64            `json:"epoch"`
+	Rewards []*DipRewardEvent `json:"rewards"`
+}
+
+// DipPayload carry the epoch to settle developer rewards
+type DipPayload struct {
+	Epoch uint64
+}
+
+// LoadDipPayload from bytes
+func LoadDipPayload(bytes []byte) (*DipPayload, error) {
+	payload := &DipPayload{}
+	if err := json.Unmarshal(bytes, payload); err != nil {
+		return nil, ErrInvalidArgument
+	}
+	return NewDipPayload(payload.Epoch), nil
+}
+
+// NewDipPayload with epoch
+func NewDipPayload(epoch uint64) *DipPayload {
+	return &DipPayload{
+		Epoch: epoch,
+	}
+}
+
+// ToBytes serialize payload
+func (payload *DipPayload) ToBytes() ([]byte, error) {
+	return json.Marshal(payload)
+}
+
+// BaseGasCount returns base gas count
+func (payload *DipPayload) BaseGasCount() *util.Uint128 {
+	base, _ := util.NewUint128FromInt(20)
+	return base
+}
+
+// Execute dip payload in tx, pay the developers of the epoch from the dip reward account
+func (payload *DipPayload) Execute(limitedGas *util.Uint128, tx *Transaction, block *Block, ws WorldState) (*util.Uint128, string, error) {
+	if block == nil || tx == nil {
+		return util.NewUint128(), "", ErrNilArgument
+	}
+	if block.dip == nil {
+		return util.NewUint128(), "", ErrDipNotEnabled
+	}
+
+	rewards, err := block.dip.RewardDevelopers(payload.Epoch, block, ws)
+	if err != nil {
+		return util.NewUint128(), "", err
+	}
+
+	event := &DipEvent{
+		Hash:    tx.hash.String(),
+		Epoch:   payload.Epoch,
+		Rewards: []*DipRewardEvent{},
+	}
+	for _, reward := range rewards {
+		acc, err := ws.GetOrCreateUserAccount(reward.Developer.Bytes())
+		if err != nil {
+			return util.NewUint128(), "", err
+		}
+		if err := acc.AddBalance(reward.Value); err != nil {
+			return util.NewUint128(), "", err
+		}
+		event.Rewards = append(event.Rewards, &DipRewardEvent{
+			Contract:  reward.Contract.String(),
+			Developer: reward.Developer.String(),
+			Callers:   reward.Callers,
+			Value:     reward.Value.String(),
+		})
+	}
+
+	data, err := json.Marshal(event)
+	if err != nil {
+		return util.NewUint128(), "", err
+	}
+	ws.RecordEvent(tx.hash, &state.Event{
+		Topic: TopicDip,
+		Data:  string(data),
+	})
+	return util.NewUint128(), "", nil
+}
@@ -0,0 +1,123 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"encoding/json"
+
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/util"
+)
+
+// SlashEvent is the event data of evidence transactions
+type SlashEvent struct {
+	Hash      string `json:"hash"`
+	Evidence  string `json:"evidence"`
+	Offender  string `json:"offender"`
+	Timestamp int64  `json:"timestamp"`
+	Burned    string `json:"burned"`
+}
+
+// EvidencePayload carry a double mint evidence to slash the offender
+type EvidencePayload struct {
+	Evidence []byte
+}
+
+// LoadEvidencePayload from bytes
+func LoadEvidencePayload(bytes []byte) (*EvidencePayload, error) {
+	payload := &EvidencePayload{}
+	if err := json.Unmarshal(bytes, payload); err != nil {
+		return nil, ErrInvalidArgument
+	}
+	if _, err := LoadDoubleMintEvidence(payload.Evidence); err != nil {
+		return nil, ErrInvalidEvidence
+	}
+	return payload, nil
+}
+
+// NewEvidencePayload with evidence
+func NewEvidencePayload(evidence *DoubleMintEvidence) (*EvidencePayload, error) {
+	data, err := evidence.ToBytes()
+	if err != nil {
+		return nil, err
+	}
+	return &EvidencePayload{
+		Evidence: data,
+	}, nil
+}
+
+// ToBytes serialize payload
+func (payload *EvidencePayload) ToBytes() ([]byte, error) {
+	return json.Marshal(payload)
+}
+
+// BaseGasCount returns base gas count
+func (payload *EvidencePayload) BaseGasCount() *util.Uint128 {
+	base, _ := util.NewUint128FromInt(20)
+	return base
+}
+
+// Execute evidence payload in tx, the offender is removed from candidates and its balance is burned
+func (payload *EvidencePayload) Execute(limitedGas *util.Uint128, tx *Transaction, block *Block, ws WorldState) (*util.Uint128, string, error) {
+	if block == nil || tx == nil {
+		return util.NewUint128(), "", ErrNilArgument
+	}
+
+	evidence, err := LoadDoubleMintEvidence(payload.Evidence)
+	if err != nil {
+		return util.NewUint128(), "", ErrInvalidEvidence
+	}
+	// evidences are checked at the time of the block, which is the same on all nodes
+	offender, err := evidence.Verify(block.ChainID(), block.Timestamp(), ws)
+	if err != nil {
+		return util.NewUint128(), "", err
+	}
+	// the offender cannot pay gas after being slashed
+	if offender.Equals(tx.from) {
+		return util.NewUint128(), "", ErrInvalidEvidence
+	}
+	if err := ws.Slash(offender.Bytes()); err != nil {
+		return util.NewUint128(), "", err
+	}
+
+	acc, err := ws.GetOrCreateUserAccount(offender.Bytes())
+	if err != nil {
+		return util.NewUint128(), "", err
+	}
+	burned := acc.Balance()
+	if err := acc.SubBalance(burned); err != nil {
+		return util.NewUint128(), "", err
+	}
+
+	data, err := json.Marshal(&SlashEvent{
+		Hash:      tx.hash.String(),
+		Evidence:  evidence.Hash().String(),
+		Offender:  offender.String(),
+		Timestamp: evidence.Timestamp(),
+		Burned:    burned.String(),
+	})
+	if err != nil {
+		return util.NewUint128(), "", err
+	}
+	ws.RecordEvent(tx.hash, &state.Event{
+		Topic: TopicSlash,
+		Data:  string(data),
+	})
+	return util.NewUint128(), "", nil
+}
@@ -0,0 +1,143 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"os"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/core/pb"
+)
+
+// txJournal is an append-only file of local transactions, so that they survive restarts.
+// Each transaction is stored as the length of its protobuf bytes followed by the bytes.
+type txJournal struct {
+	path   string
+	writer *os.File
+}
+
+func newTxJournal(path string) *txJournal {
+	return &txJournal{path: path}
+}
+
+// load read the transactions in journal and add them, the broken tail is ignored.
+func (journal *txJournal) load(add func(*Transaction) error) (int, int, error) {
+	file, err := os.Open(journal.path)
+	if os.IsNotExist(err) {
+		return 0, 0, nil
+	}
+	if err != nil {
+		return 0, 0, err
+	}
+	defer file.Close()
+
+	total, dropped := 0, 0
+	reader := bufio.NewReader(file)
+	for {
+		var size uint32
+		if err := binary.Read(reader, binary.BigEndian, &size); err != nil {
+			break
+		}
+		data := make([]byte, size)
+		if _, err := io.ReadFull(reader, data); err != nil {
+			break
+		}
+		total++
+
+		pbTx := new(corepb.Transaction)
+		tx := new(Transaction)
+		if err := proto.Unmarshal(data, pbTx); err != nil {
+			dropped++
+			continue
+		}
+		if err := tx.FromProto(pbTx); err != nil {
+			dropped++
+			continue
+		}
+		if err := add(tx); err != nil {
+			dropped++
+		}
+	}
+	return total, dropped, nil
+}
+
+// insert append tx to journal.
+func (journal *txJournal) insert(tx *Transaction) error {
+	if journal.writer == nil {
+		return ErrJournalNotOpened
+	}
+	return writeJournalTx(journal.writer, tx)
+}
+
+// rotate regenerate journal with the transactions, and open it for appending.
+func (journal *txJournal) rotate(txs []*Transaction) error {
+	if journal.writer != nil {
+		if err := journal.writer.Close(); err != nil {
+			return err
+		}
+		journal.writer = nil
+	}
+
+	replacement, err := os.OpenFile(journal.path+".new", os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	for _, tx := range txs {
+		if err := writeJournalTx(replacement, tx); err != nil {
+			replacement.Close()
+			return err
+		}
+	}
+	replacement.Close()
+
+	if err := os.Rename(journal.path+".new", journal.path); err != nil {
+		return err
+	}
+	journal.writer, err = os.OpenFile(journal.path, os.O_WRONLY|os.O_APPEND, 0644)
+	return err
+}
+
+// close close the journal file.
+func (journal *txJournal) close() error {
+	if journal.writer == nil {
+		return nil
+	}
+	err := journal.writer.Close()
+	journal.writer = nil
+	return err
+}
+
+func writeJournalTx(writer io.Writer, tx *Transaction) error {
+	msg, err := tx.ToProto()
+	if err != nil {
+		return err
+	}
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	if err := binary.Write(writer, binary.BigEndian, uint32(len(data))); err != nil {
+		return err
+	}
+	_, err = writer.Write(data)
+	return err
+}
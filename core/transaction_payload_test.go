@@ -159,6 +159,8 @@ func TestLoadDeployPayload(t *testing.T) {
 	deployTx := mockDeployTransaction(0, 0)
 	deployPayload, _ := deployTx.LoadPayload()
 	deployData, _ := deployPayload.ToBytes()
+	upgradablePayload, _ := NewDeployPayload("source", SourceTypeJavaScript, "", "", true)
+	upgradableData, _ := upgradablePayload.ToBytes()
 
 	tests := []struct {
 		name      string
@@ -190,6 +192,14 @@ func TestLoadDeployPayload(t *testing.T) {
 			want:      deployPayload,
 			wantEqual: true,
 		},
+
+		{
+			name:      "upgradable",
+			bytes:     upgradableData,
+			parse:     true,
+			want:      upgradablePayload,
+			wantEqual: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -294,3 +304,90 @@ func TestPayload_Execute(t *testing.T) {
 
 	block.RollBack()
 }
+
+func TestLoadCandidatePayload(t *testing.T) {
+	login, _ := NewCandidatePayload(LoginAction)
+	tests := []struct {
+		name    string
+		bytes   []byte
+		want    *CandidatePayload
+		wantErr error
+	}{
+		{
+			name:    "parse faild",
+			bytes:   []byte("data"),
+			want:    nil,
+			wantErr: ErrInvalidArgument,
+		},
+
+		{
+			name:    "invalid action",
+			bytes:   []byte(`{"Action":"vote"}`),
+			want:    nil,
+			wantErr: ErrInvalidCandidatePayloadAction,
+		},
+
+		{
+			name:    "login",
+			bytes:   []byte(`{"Action":"login"}`),
+			want:    login,
+			wantErr: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := LoadCandidatePayload(tt.bytes)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestLoadDelegatePayload(t *testing.T) {
+	delegatee := mockAddress().String()
+	do, _ := NewDelegatePayload(DelegateAction, delegatee)
+	doData, _ := do.ToBytes()
+	tests := []struct {
+		name    string
+		bytes   []byte
+		want    *DelegatePayload
+		wantErr error
+	}{
+		{
+			name:    "parse faild",
+			bytes:   []byte("data"),
+			want:    nil,
+			wantErr: ErrInvalidArgument,
+		},
+
+		{
+			name:    "invalid action",
+			bytes:   []byte(`{"Action":"vote","Delegatee":"` + delegatee + `"}`),
+			want:    nil,
+			wantErr: ErrInvalidDelegatePayloadAction,
+		},
+
+		{
+			name:    "invalid delegatee",
+			bytes:   []byte(`{"Action":"do","Delegatee":"0x00"}`),
+			want:    nil,
+			wantErr: ErrInvalidAddressFormat,
+		},
+
+		{
+			name:    "do",
+			bytes:   doData,
+			want:    do,
+			wantErr: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := LoadDelegatePayload(tt.bytes)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -19,6 +19,7 @@
 package core
 
 import (
+	"sort"
 	"sync"
 	"time"
 
@@ -34,6 +35,16 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
+// TransactionReplacePriceBump is the min percent of gas price increase for a transaction
+// to replace the pending one with the same sender and nonce.
+var TransactionReplacePriceBump uint64 = 10
+
+// TxPoolExpireInterval is the interval of checking expired transactions.
+var TxPoolExpireInterval = 30 * time.Second
+
+// TxPoolJournalRotateInterval is the interval of regenerating the journal of local transactions.
+var TxPoolJournalRotateInterval = time.Hour
+
 // TransactionPool cache txs, is thread safe
 type TransactionPool struct {
 	receivedMessageCh chan net.Message
@@ -44,6 +55,14 @@ type TransactionPool struct {
 	buckets    map[byteutils.HexHash]*sorted.Slice
 	all        map[byteutils.HexHash]*Transaction
 
+	// arrival time of txs, and the txs sent from local.
+	arrivals map[byteutils.HexHash]time.Time
+	locals   map[byteutils.HexHash]bool
+
+	ttl         time.Duration // 0 means pending txs never expire.
+	senderLimit int           // max txs of a sender, 0 means unlimited.
+	journal     *txJournal
+
 	ns net.Service
 	mu sync.RWMutex
 
@@ -81,6 +100,8 @@ func NewTransactionPool(size int) (*TransactionPool, error) {
 		candidates:        sorted.NewSlice(gasCmp),
 		buckets:           make(map[byteutils.HexHash]*sorted.Slice),
 		all:               make(map[byteutils.HexHash]*Transaction),
+		arrivals:          make(map[byteutils.HexHash]time.Time),
+		locals:            make(map[byteutils.HexHash]bool),
 		minGasPrice:       TransactionGasPrice,
 		maxGasLimit:       TransactionMaxGas,
 	}, nil
@@ -105,6 +126,17 @@ func (pool *TransactionPool) SetGasConfig(gasPrice, gasLimit *util.Uint128) erro
 	return nil
 }
 
+// SetLimitConfig config the time to live of pending txs and the max txs of a sender, 0 means unlimited.
+func (pool *TransactionPool) SetLimitConfig(ttl time.Duration, senderLimit int) {
+	pool.ttl = ttl
+	pool.senderLimit = senderLimit
+}
+
+// SetJournal config the journal file of local txs, they are reloaded when the pool starts.
+func (pool *TransactionPool) SetJournal(path string) {
+	pool.journal = newTxJournal(path)
+}
+
 // RegisterInNetwork register message subscriber in network.
 func (pool *TransactionPool) RegisterInNetwork(ns net.Service) {
 	ns.Register(net.NewSubscriber(pool, pool.receivedMessageCh, true, MessageTypeNewTx, net.MessageWeightNewTx))
@@ -125,6 +157,9 @@ func (pool *TransactionPool) Start() {
 		"size": pool.size,
 	}).Info("Starting TransactionPool...")
 
+	if pool.journal != nil {
+		pool.loadJournal()
+	}
 	go pool.loop()
 }
 
@@ -143,12 +178,26 @@ func (pool *TransactionPool) loop() {
 	}).Info("Started TransactionPool.")
 
 	timerChan := time.NewTicker(time.Second).C
+	expireChan := time.NewTicker(TxPoolExpireInterval).C
+	rotateChan := time.NewTicker(TxPoolJournalRotateInterval).C
 	for {
 		select {
 		case <-timerChan:
 			metricsReceivedTx.Update(int64(len(pool.receivedMessageCh)))
 			metricsCachedTx.Update(int64(len(pool.all)))
+		case <-expireChan:
+			pool.expire()
+		case <-rotateChan:
+			if pool.journal != nil {
+				pool.rotateJournal()
+			}
 		case <-pool.quitCh:
+			if pool.journal != nil {
+				pool.rotateJournal()
+				pool.mu.Lock()
+				pool.journal.close()
+				pool.mu.Unlock()
+			}
 			logging.CLog().WithFields(logrus.Fields{
 				"size": pool.size,
 			}).Info("Stopped TransactionPool.")
@@ -218,9 +267,9 @@ func (pool *TransactionPool) PushAndRelay(tx *Transaction) error {
 	return nil
 }
 
-// PushAndBroadcast push tx into pool and broadcast it
+// PushAndBroadcast push tx into pool and broadcast it, the tx is journaled as local.
 func (pool *TransactionPool) PushAndBroadcast(tx *Transaction) error {
-	if err := pool.Push(tx); err != nil {
+	if err := pool.push(tx, true); err != nil {
 		logging.VLog().WithFields(logrus.Fields{
 			"tx":  tx,
 			"err": err,
@@ -234,6 +283,10 @@ func (pool *TransactionPool) PushAndBroadcast(tx *Transaction) error {
 
 // Push tx into pool
 func (pool *TransactionPool) Push(tx *Transaction) error {
+	return pool.push(tx, false)
+}
+
+func (pool *TransactionPool) push(tx *Transaction, local bool) error {
 	pool.mu.Lock()
 	defer pool.mu.Unlock()
 
@@ -265,20 +318,64 @@ func (pool *TransactionPool) Push(tx *Transaction) error {
 		return err
 	}
 
+	// replace the pending tx with the same sender and nonce
+	dropped := []*Transaction{}
+	replaced := pool.sameNonceTx(tx)
+	if replaced != nil {
+		if !priceBumped(replaced, tx) {
+			return ErrUnderpricedReplacement
+		}
+		pool.removeTx(replaced)
+		dropped = append(dropped, replaced)
+	}
+
+	// keep the txs with lower nonces if the sender has too many txs
+	if replaced == nil && pool.senderLimit > 0 {
+		if bucket, ok := pool.buckets[tx.from.address.Hex()]; ok && bucket.Len() >= pool.senderLimit {
+			highest := bucket.Right().(*Transaction)
+			if highest.Nonce() <= tx.Nonce() {
+				metricsTxPoolSenderOutOfLimit.Inc(1)
+				return ErrSenderTxsOutOfLimit
+			}
+			pool.removeTx(highest)
+			dropped = append(dropped, highest)
+		}
+	}
+
 	// cache the verified tx
 	pool.pushTx(tx)
-	// drop max tx in longest bucket if full
+	// drop the tx with lowest gas price if full
 	if len(pool.all) > pool.size {
 		poollen := len(pool.all)
-		pool.dropTx()
+		drop := pool.dropTx()
 
 		logging.VLog().WithFields(logrus.Fields{
 			"tx":         tx,
+			"drop":       drop,
 			"size":       pool.size,
 			"bpoolsize":  poollen,
 			"apoolsize":  len(pool.all),
 			"bucketsize": len(pool.buckets),
 		}).Debug("drop tx")
+
+		if drop == tx {
+			return ErrTxPoolFull
+		}
+		if drop != nil {
+			dropped = append(dropped, drop)
+		}
+	}
+
+	if local {
+		pool.locals[tx.hash.Hex()] = true
+		if pool.journal != nil {
+			if err := pool.journal.insert(tx); err != nil {
+				logging.VLog().WithFields(logrus.Fields{
+					"tx":  tx,
+					"err": err,
+				}).Debug("Failed to journal local tx.")
+			}
+		}
 	}
 
 	// trigger pending transaction
@@ -286,11 +383,71 @@ func (pool *TransactionPool) Push(tx *Transaction) error {
 		Topic: TopicPendingTransaction,
 		Data:  tx.String(),
 	}
-	pool.eventEmitter.Trigger(event)
+	pool.eventEmitter.TriggerChainEvent(&ChainEvent{Event: event, Tx: tx})
 
+	for _, drop := range dropped {
+		pool.triggerDropEvent(drop)
+	}
+	return nil
+}
+
+func (pool *TransactionPool) triggerDropEvent(tx *Transaction) {
+	event := &state.Event{
+		Topic: TopicDropTransaction,
+		Data:  tx.String(),
+	}
+	pool.eventEmitter.TriggerChainEvent(&ChainEvent{Event: event, Tx: tx})
+}
+
+// sameNonceTx return the pending tx with the same sender and nonce of tx, nil if none.
+func (pool *TransactionPool) sameNonceTx(tx *Transaction) *Transaction {
+	bucket, ok := pool.buckets[tx.from.address.Hex()]
+	if !ok {
+		return nil
+	}
+	for i := 0; i < bucket.Len(); i++ {
+		v := bucket.Index(i).(*Transaction)
+		if v.Nonce() == tx.Nonce() {
+			return v
+		}
+		if v.Nonce() > tx.Nonce() {
+			break
+		}
+	}
 	return nil
 }
 
+// removeTx remove tx from its bucket, and replace the candidate if it's.
+func (pool *TransactionPool) removeTx(tx *Transaction) {
+	slot := tx.from.address.Hex()
+	bucket := pool.buckets[slot]
+	oldCandidate := bucket.Left()
+	bucket.Del(tx)
+	pool.deleteTx(tx)
+	if oldCandidate == tx {
+		pool.candidates.Del(tx)
+		if bucket.Len() > 0 {
+			pool.candidates.Push(bucket.Left())
+		}
+	}
+	if bucket.Len() == 0 {
+		delete(pool.buckets, slot)
+	}
+}
+
+// priceBumped return whether the gas price of tx is higher than the old one by TransactionReplacePriceBump percent.
+func priceBumped(old, tx *Transaction) bool {
+	oldPrice, err := old.gasPrice.Mul(util.NewUint128FromUint(100 + TransactionReplacePriceBump))
+	if err != nil {
+		return false
+	}
+	newPrice, err := tx.gasPrice.Mul(util.NewUint128FromUint(100))
+	if err != nil {
+		return false
+	}
+	return newPrice.Cmp(oldPrice) >= 0
+}
+
 func (pool *TransactionPool) pushTx(tx *Transaction) {
 	slot := tx.from.address.Hex()
 	bucket, ok := pool.buckets[slot]
@@ -301,6 +458,7 @@ func (pool *TransactionPool) pushTx(tx *Transaction) {
 	oldCandidate := bucket.Left()
 	bucket.Push(tx)
 	pool.all[tx.hash.Hex()] = tx
+	pool.arrivals[tx.hash.Hex()] = time.Now()
 	newCandidate := bucket.Left()
 	// replace candidate
 	if oldCandidate == nil {
@@ -313,7 +471,7 @@ func (pool *TransactionPool) pushTx(tx *Transaction) {
 
 func (pool *TransactionPool) popTx(tx *Transaction) {
 	bucket := pool.buckets[tx.from.address.Hex()]
-	delete(pool.all, tx.hash.Hex())
+	pool.deleteTx(tx)
 	bucket.PopLeft()
 	if bucket.Len() != 0 {
 		candidate := bucket.Left()
@@ -323,28 +481,114 @@ func (pool *TransactionPool) popTx(tx *Transaction) {
 	}
 }
 
-func (pool *TransactionPool) dropTx() {
-	var longestSlice *sorted.Slice
-	longestLen := 0
+func (pool *TransactionPool) dropTx() *Transaction {
+	// drop the tx with the highest nonce in a bucket, so that no nonce gap is made,
+	// the one with lowest gas price is dropped, then the one in the longest bucket.
+	var drop *Transaction
+	dropLen := 0
 	for _, v := range pool.buckets {
-		if v.Len() > longestLen {
-			longestLen = v.Len()
-			longestSlice = v
+		right := v.Right().(*Transaction)
+		if drop == nil {
+			drop, dropLen = right, v.Len()
+			continue
+		}
+		cmp := right.gasPrice.Cmp(drop.gasPrice)
+		if cmp < 0 || (cmp == 0 && v.Len() > dropLen) {
+			drop, dropLen = right, v.Len()
 		}
 	}
+	if drop == nil {
+		return nil
+	}
 	logging.VLog().WithFields(logrus.Fields{
-		"longestsize": longestLen,
-	}).Info("Drop tx from longest bucket.")
-	if longestLen > 0 {
-		drop := longestSlice.PopRight().(*Transaction)
-		if drop != nil {
-			delete(pool.all, drop.Hash().Hex())
-			if longestLen == 1 {
-				pool.candidates.Del(drop)
-				delete(pool.buckets, drop.from.address.Hex())
+		"tx":         drop,
+		"bucketsize": dropLen,
+	}).Info("Drop tx with lowest gas price.")
+	pool.removeTx(drop)
+	return drop
+}
+
+// deleteTx delete tx from all, the caller should remove it from bucket and candidates.
+func (pool *TransactionPool) deleteTx(tx *Transaction) {
+	delete(pool.all, tx.hash.Hex())
+	delete(pool.arrivals, tx.hash.Hex())
+	delete(pool.locals, tx.hash.Hex())
+}
+
+// expire drop the txs staying in pool longer than ttl,
+// with the higher nonce txs of the same sender which cannot be mined without them.
+func (pool *TransactionPool) expire() {
+	if pool.ttl == 0 {
+		return
+	}
+
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	deadline := time.Now().Add(-pool.ttl)
+	for hash, arrival := range pool.arrivals {
+		if arrival.After(deadline) {
+			continue
+		}
+		tx := pool.all[hash]
+		if tx == nil {
+			delete(pool.arrivals, hash)
+			continue
+		}
+		bucket := pool.buckets[tx.from.address.Hex()]
+		for bucket.Len() > 0 {
+			drop := bucket.Right().(*Transaction)
+			if drop.nonce < tx.nonce {
+				break
+			}
+			pool.removeTx(drop)
+			metricsTxPoolExpired.Inc(1)
+			pool.triggerDropEvent(drop)
+
+			logging.VLog().WithFields(logrus.Fields{
+				"tx":      drop,
+				"arrival": arrival,
+			}).Debug("Drop expired tx.")
+		}
+	}
+}
+
+// loadJournal push the local txs in journal into pool, then regenerate journal.
+func (pool *TransactionPool) loadJournal() {
+	total, dropped, err := pool.journal.load(func(tx *Transaction) error {
+		return pool.push(tx, true)
+	})
+	if err != nil {
+		logging.CLog().WithFields(logrus.Fields{
+			"err": err,
+		}).Error("Failed to load tx journal.")
+	} else {
+		logging.CLog().WithFields(logrus.Fields{
+			"total":   total,
+			"dropped": dropped,
+		}).Info("Loaded local txs from journal.")
+	}
+	pool.rotateJournal()
+}
+
+// rotateJournal regenerate journal with the pending local txs.
+func (pool *TransactionPool) rotateJournal() {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	txs := []*Transaction{}
+	for _, slot := range pool.sortedSlots() {
+		for _, tx := range bucketTxs(pool.buckets[slot]) {
+			if pool.locals[tx.hash.Hex()] {
+				txs = append(txs, tx)
 			}
 		}
 	}
+	if err := pool.journal.rotate(txs); err != nil {
+		logging.CLog().WithFields(logrus.Fields{
+			"err": err,
+		}).Error("Failed to rotate tx journal.")
+	}
 }
 
 // PopWithBlacklist return a tx with highest gasprice and not in the blocklist
@@ -399,7 +643,7 @@ func (pool *TransactionPool) Del(tx *Transaction) {
 		left := oldCandidate.(*Transaction)
 		for left.Nonce() <= tx.Nonce() {
 			bucket.PopLeft()
-			delete(pool.all, left.Hash().Hex())
+			pool.deleteTx(left)
 
 			logging.VLog().WithFields(logrus.Fields{
 				"tx": "tx",
@@ -429,3 +673,230 @@ func (pool *TransactionPool) Empty() bool {
 	defer pool.mu.Unlock()
 	return len(pool.all) == 0
 }
+
+// Status of pending transactions in pool
+const (
+	// TxPoolStatusExecutable the nonce is next to the sender's, can be packed in next block.
+	TxPoolStatusExecutable = "executable"
+
+	// TxPoolStatusQueued waiting for the transactions with lower nonces in pool.
+	TxPoolStatusQueued = "queued"
+
+	// TxPoolStatusNonceGap some lower nonces are missing in pool, it can't be packed until they arrive.
+	TxPoolStatusNonceGap = "nonce_gap"
+
+	// TxPoolStatusNonceTooLow the nonce is used, it will be dropped.
+	TxPoolStatusNonceTooLow = "nonce_too_low"
+)
+
+// TxPoolSenderStats is the statistics of a sender's transactions in pool.
+type TxPoolSenderStats struct {
+	Address      *Address
+	AccountNonce uint64
+	Count        int
+	MinNonce     uint64
+	MaxNonce     uint64
+
+	// Gaps is the count of missing nonces between the account nonce and the max nonce.
+	Gaps uint64
+}
+
+// TxPoolStats is the statistics of pool.
+type TxPoolStats struct {
+	Size       int
+	Capacity   int
+	Buckets    int
+	Candidates int
+	Senders    []*TxPoolSenderStats
+}
+
+// PendingTransaction is a transaction in pool with its status.
+type PendingTransaction struct {
+	*Transaction
+	Status string
+}
+
+// PendingTransactions return the transactions of the sender in pool in ascending order of nonce,
+// or of all the senders if from is nil.
+func (pool *TransactionPool) PendingTransactions(from *Address) ([]*PendingTransaction, error) {
+	pool.mu.RLock()
+	var buckets [][]*Transaction
+	if from != nil {
+		buckets = append(buckets, bucketTxs(pool.buckets[from.address.Hex()]))
+	} else {
+		for _, slot := range pool.sortedSlots() {
+			buckets = append(buckets, bucketTxs(pool.buckets[slot]))
+		}
+	}
+	pool.mu.RUnlock()
+
+	pending := []*PendingTransaction{}
+	for _, txs := range buckets {
+		if len(txs) == 0 {
+			continue
+		}
+		nonce, err := pool.accountNonce(txs[0].from)
+		if err != nil {
+			return nil, err
+		}
+		for _, tx := range txs {
+			pending = append(pending, &PendingTransaction{Transaction: tx, Status: pendingStatus(txs, nonce, tx)})
+		}
+	}
+	return pending, nil
+}
+
+// PendingTransactionStatus return the transaction of hash in pool and its status,
+// nil if it's not in pool.
+func (pool *TransactionPool) PendingTransactionStatus(hash byteutils.Hash) (*Transaction, string, error) {
+	pool.mu.RLock()
+	tx := pool.all[hash.Hex()]
+	var txs []*Transaction
+	if tx != nil {
+		txs = bucketTxs(pool.buckets[tx.from.address.Hex()])
+	}
+	pool.mu.RUnlock()
+
+	if tx == nil {
+		return nil, "", nil
+	}
+	nonce, err := pool.accountNonce(tx.from)
+	if err != nil {
+		return nil, "", err
+	}
+	return tx, pendingStatus(txs, nonce, tx), nil
+}
+
+// Stats return the statistics of pool.
+func (pool *TransactionPool) Stats() (*TxPoolStats, error) {
+	pool.mu.RLock()
+	stats := &TxPoolStats{
+		Size:       len(pool.all),
+		Capacity:   pool.size,
+		Buckets:    len(pool.buckets),
+		Candidates: pool.candidates.Len(),
+	}
+	buckets := make([][]*Transaction, 0, len(pool.buckets))
+	for _, slot := range pool.sortedSlots() {
+		buckets = append(buckets, bucketTxs(pool.buckets[slot]))
+	}
+	pool.mu.RUnlock()
+
+	stats.Senders = make([]*TxPoolSenderStats, 0, len(buckets))
+	for _, txs := range buckets {
+		if len(txs) == 0 {
+			continue
+		}
+		from := txs[0].from
+		nonce, err := pool.accountNonce(from)
+		if err != nil {
+			return nil, err
+		}
+		sender := &TxPoolSenderStats{
+			Address:      from,
+			AccountNonce: nonce,
+			Count:        len(txs),
+			MinNonce:     txs[0].Nonce(),
+			MaxNonce:     txs[len(txs)-1].Nonce(),
+		}
+		if sender.MaxNonce > nonce {
+			// count the distinct nonces after account nonce.
+			distinct := uint64(0)
+			for i, tx := range txs {
+				if tx.Nonce() > nonce && (i == 0 || tx.Nonce() != txs[i-1].Nonce()) {
+					distinct++
+				}
+			}
+			sender.Gaps = sender.MaxNonce - nonce - distinct
+		}
+		stats.Senders = append(stats.Senders, sender)
+	}
+	return stats, nil
+}
+
+func (pool *TransactionPool) sortedSlots() []byteutils.HexHash {
+	slots := make([]byteutils.HexHash, 0, len(pool.buckets))
+	for slot := range pool.buckets {
+		slots = append(slots, slot)
+	}
+	sort.Slice(slots, func(i, j int) bool { return slots[i] < slots[j] })
+	return slots
+}
+
+// PendingNonce return the nonce of the last transaction of addr in pool executable in sequence
+// after its account nonce, or the account nonce if none.
+func (pool *TransactionPool) PendingNonce(addr *Address) (uint64, error) {
+	nonce, err := pool.accountNonce(addr)
+	if err != nil {
+		return 0, err
+	}
+	pool.mu.RLock()
+	txs := bucketTxs(pool.buckets[addr.address.Hex()])
+	pool.mu.RUnlock()
+
+	for _, tx := range txs {
+		if tx.Nonce() <= nonce {
+			continue
+		}
+		if tx.Nonce() > nonce+1 {
+			break
+		}
+		nonce++
+	}
+	return nonce, nil
+}
+
+func (pool *TransactionPool) accountNonce(addr *Address) (uint64, error) {
+	acc, err := pool.bc.TailBlock().GetAccount(addr.address)
+	if err != nil {
+		return 0, err
+	}
+	return acc.Nonce(), nil
+}
+
+func bucketTxs(bucket *sorted.Slice) []*Transaction {
+	if bucket == nil {
+		return nil
+	}
+	txs := make([]*Transaction, bucket.Len())
+	for i := range txs {
+		txs[i] = bucket.Index(i).(*Transaction)
+	}
+	return txs
+}
+
+// pendingStatus return the status of tx in the sender's transactions sorted by nonce.
+func pendingStatus(txs []*Transaction, accountNonce uint64, tx *Transaction) string {
+	if tx.Nonce() <= accountNonce {
+		return TxPoolStatusNonceTooLow
+	}
+	next := accountNonce + 1
+	for _, v := range txs {
+		if v.Nonce() < next {
+			continue
+		}
+		if v.Nonce() > next {
+			return TxPoolStatusNonceGap
+		}
+		if v.Nonce() == tx.Nonce() {
+			break
+		}
+		next++
+	}
+	if tx.Nonce() == accountNonce+1 {
+		return TxPoolStatusExecutable
+	}
+	return TxPoolStatusQueued
+}
+
+// pressure return the count of txs in pool, the capacity and the gas prices of candidates.
+func (pool *TransactionPool) pressure() (int, int, []*util.Uint128) {
+	pool.mu.RLock()
+	defer pool.mu.RUnlock()
+
+	prices := make([]*util.Uint128, 0, pool.candidates.Len())
+	for i := 0; i < pool.candidates.Len(); i++ {
+		prices = append(prices, pool.candidates.Index(i).(*Transaction).gasPrice)
+	}
+	return len(pool.all), pool.size, prices
+}
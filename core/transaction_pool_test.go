@@ -19,6 +19,9 @@
 package core
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"time"
@@ -194,19 +197,20 @@ func TestTransactionPool(t *testing.T) {
 	// put tx with different chainID, should fail
 	assert.Nil(t, txs[4].Sign(signature1))
 	assert.NotNil(t, txPool.Push(txs[4]))
-	// put one new, replace txs[1]
+	// put one with the same nonce and higher gas price, replace txs[2]
 	assert.Equal(t, len(txPool.all), 3)
 	assert.Nil(t, txs[6].Sign(signature1))
 	assert.Nil(t, txPool.Push(txs[6]))
-	assert.Equal(t, len(txPool.all), 4)
+	assert.Equal(t, len(txPool.all), 3)
+	assert.Nil(t, txPool.all[txs[2].hash.Hex()])
 	// get from: other, nonce: 1, data: "da"
 	tx := txPool.Pop()
 	assert.Equal(t, txs[6].data.Payload, tx.data.Payload)
 	// put one new
-	assert.Equal(t, len(txPool.all), 3)
+	assert.Equal(t, len(txPool.all), 2)
 	assert.Nil(t, txs[5].Sign(signature2))
 	assert.Nil(t, txPool.Push(txs[5]))
-	assert.Equal(t, len(txPool.all), 4)
+	assert.Equal(t, len(txPool.all), 3)
 	// get 2 txs, txs[5], txs[0]
 	tx = txPool.Pop()
 	assert.Equal(t, txs[5].from.address, tx.from.address)
@@ -326,3 +330,283 @@ func TestTransactionPool_Pop(t *testing.T) {
 	tx = txPool.Pop()
 	assert.Equal(t, tx.sign, txs[0].sign)
 }
+
+func TestTransactionPool_Inspect(t *testing.T) {
+	ks := keystore.DefaultKS
+	priv1 := secp256k1.GeneratePrivateKey()
+	pubdata1, _ := priv1.PublicKey().Encoded()
+	from, _ := NewAddressFromPublicKey(pubdata1)
+	ks.SetKey(from.String(), priv1, []byte("passphrase"))
+	ks.Unlock(from.String(), []byte("passphrase"), time.Second*60*60*24*365)
+	key1, _ := ks.GetUnlocked(from.String())
+	signature1, _ := crypto.NewSignature(keystore.SECP256K1)
+	signature1.InitSign(key1.(keystore.PrivateKey))
+
+	priv2 := secp256k1.GeneratePrivateKey()
+	pubdata2, _ := priv2.PublicKey().Encoded()
+	other, _ := NewAddressFromPublicKey(pubdata2)
+	ks.SetKey(other.String(), priv2, []byte("passphrase"))
+	ks.Unlock(other.String(), []byte("passphrase"), time.Second*60*60*24*365)
+	key2, _ := ks.GetUnlocked(other.String())
+	signature2, _ := crypto.NewSignature(keystore.SECP256K1)
+	signature2.InitSign(key2.(keystore.PrivateKey))
+
+	neb := testNeb(t)
+	bc := neb.chain
+	txPool := bc.txPool
+
+	gasLimit, _ := util.NewUint128FromInt(200000)
+	tx1, _ := NewTransaction(bc.ChainID(), from, &Address{[]byte("to")}, util.NewUint128(), 1, TxPayloadBinaryType, []byte("1"), TransactionGasPrice, gasLimit)
+	tx2, _ := NewTransaction(bc.ChainID(), from, &Address{[]byte("to")}, util.NewUint128(), 2, TxPayloadBinaryType, []byte("2"), TransactionGasPrice, gasLimit)
+	tx3, _ := NewTransaction(bc.ChainID(), from, &Address{[]byte("to")}, util.NewUint128(), 4, TxPayloadBinaryType, []byte("3"), TransactionGasPrice, gasLimit)
+	tx4, _ := NewTransaction(bc.ChainID(), other, &Address{[]byte("to")}, util.NewUint128(), 2, TxPayloadBinaryType, []byte("4"), TransactionGasPrice, gasLimit)
+	for _, tx := range []*Transaction{tx1, tx2, tx3} {
+		assert.Nil(t, tx.Sign(signature1))
+		assert.Nil(t, txPool.Push(tx))
+	}
+	assert.Nil(t, tx4.Sign(signature2))
+	assert.Nil(t, txPool.Push(tx4))
+
+	pending, err := txPool.PendingTransactions(from)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(pending))
+	for i, tx := range []*Transaction{tx1, tx2, tx3} {
+		assert.Equal(t, tx, pending[i].Transaction)
+	}
+	pending, err = txPool.PendingTransactions(nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 4, len(pending))
+
+	tests := []struct {
+		tx     *Transaction
+		status string
+	}{
+		{tx1, TxPoolStatusExecutable},
+		{tx2, TxPoolStatusQueued},
+		{tx3, TxPoolStatusNonceGap},
+		{tx4, TxPoolStatusNonceGap},
+	}
+	for _, tt := range tests {
+		tx, status, err := txPool.PendingTransactionStatus(tt.tx.Hash())
+		assert.Nil(t, err)
+		assert.Equal(t, tt.tx, tx)
+		assert.Equal(t, tt.status, status)
+	}
+	tx, _, err := txPool.PendingTransactionStatus([]byte("unknown"))
+	assert.Nil(t, err)
+	assert.Nil(t, tx)
+
+	stats, err := txPool.Stats()
+	assert.Nil(t, err)
+	assert.Equal(t, 4, stats.Size)
+	assert.Equal(t, 2, stats.Buckets)
+	assert.Equal(t, 2, stats.Candidates)
+	for _, sender := range stats.Senders {
+		if sender.Address.Equals(from) {
+			assert.Equal(t, 3, sender.Count)
+			assert.Equal(t, uint64(1), sender.Gaps)
+		} else {
+			assert.Equal(t, uint64(1), sender.Gaps)
+		}
+	}
+}
+
+func TestTransactionPool_Replace(t *testing.T) {
+	ks := keystore.DefaultKS
+	priv1 := secp256k1.GeneratePrivateKey()
+	pubdata1, _ := priv1.PublicKey().Encoded()
+	from, _ := NewAddressFromPublicKey(pubdata1)
+	ks.SetKey(from.String(), priv1, []byte("passphrase"))
+	ks.Unlock(from.String(), []byte("passphrase"), time.Second*60*60*24*365)
+	key1, _ := ks.GetUnlocked(from.String())
+	signature1, _ := crypto.NewSignature(keystore.SECP256K1)
+	signature1.InitSign(key1.(keystore.PrivateKey))
+
+	neb := testNeb(t)
+	bc := neb.chain
+	txPool := bc.txPool
+	dropCh := register(bc.eventEmitter, TopicDropTransaction)
+	bc.eventEmitter.Start()
+	defer bc.eventEmitter.Stop()
+
+	// 105% and 110% of the gas price.
+	lowPrice, _ := TransactionGasPrice.Mul(util.NewUint128FromUint(105))
+	lowPrice, _ = lowPrice.Div(util.NewUint128FromUint(100))
+	highPrice, _ := TransactionGasPrice.Mul(util.NewUint128FromUint(110))
+	highPrice, _ = highPrice.Div(util.NewUint128FromUint(100))
+
+	gasLimit, _ := util.NewUint128FromInt(200000)
+	tx1, _ := NewTransaction(bc.ChainID(), from, &Address{[]byte("to")}, util.NewUint128(), 1, TxPayloadBinaryType, []byte("1"), TransactionGasPrice, gasLimit)
+	tx2, _ := NewTransaction(bc.ChainID(), from, &Address{[]byte("to")}, util.NewUint128(), 2, TxPayloadBinaryType, []byte("2"), TransactionGasPrice, gasLimit)
+	tx3, _ := NewTransaction(bc.ChainID(), from, &Address{[]byte("to")}, util.NewUint128(), 1, TxPayloadBinaryType, []byte("3"), lowPrice, gasLimit)
+	tx4, _ := NewTransaction(bc.ChainID(), from, &Address{[]byte("to")}, util.NewUint128(), 1, TxPayloadBinaryType, []byte("4"), highPrice, gasLimit)
+	for _, tx := range []*Transaction{tx1, tx2, tx3, tx4} {
+		assert.Nil(t, tx.Sign(signature1))
+	}
+	assert.Nil(t, txPool.Push(tx1))
+	assert.Nil(t, txPool.Push(tx2))
+
+	// not bumped enough.
+	assert.Equal(t, ErrUnderpricedReplacement, txPool.Push(tx3))
+	assert.NotNil(t, txPool.GetTransaction(tx1.Hash()))
+
+	// replace the candidate.
+	assert.Nil(t, txPool.Push(tx4))
+	assert.Nil(t, txPool.GetTransaction(tx1.Hash()))
+	assert.Equal(t, 2, len(txPool.all))
+	assert.Equal(t, 1, txPool.candidates.Len())
+
+	select {
+	case e := <-dropCh.EventChan():
+		assert.Equal(t, tx1, e.Tx)
+	case <-time.After(time.Second):
+		t.Error("drop event not received")
+	}
+
+	assert.Equal(t, tx4, txPool.Pop())
+	assert.Equal(t, tx2, txPool.Pop())
+	assert.True(t, txPool.Empty())
+}
+
+func testSigner(t *testing.T) (*Address, keystore.Signature) {
+	ks := keystore.DefaultKS
+	priv := secp256k1.GeneratePrivateKey()
+	pubdata, _ := priv.PublicKey().Encoded()
+	addr, _ := NewAddressFromPublicKey(pubdata)
+	ks.SetKey(addr.String(), priv, []byte("passphrase"))
+	ks.Unlock(addr.String(), []byte("passphrase"), time.Second*60*60*24*365)
+	key, _ := ks.GetUnlocked(addr.String())
+	signature, _ := crypto.NewSignature(keystore.SECP256K1)
+	signature.InitSign(key.(keystore.PrivateKey))
+	return addr, signature
+}
+
+func TestTransactionPool_Limits(t *testing.T) {
+	from, signature1 := testSigner(t)
+	other, signature2 := testSigner(t)
+
+	bc := testNeb(t).chain
+	txPool, _ := NewTransactionPool(3)
+	txPool.setBlockChain(bc)
+	txPool.setEventEmitter(bc.eventEmitter)
+	txPool.SetLimitConfig(time.Minute, 2)
+
+	highPrice, _ := TransactionGasPrice.Mul(util.NewUint128FromUint(2))
+	gasLimit, _ := util.NewUint128FromInt(200000)
+	tx1, _ := NewTransaction(bc.ChainID(), from, &Address{[]byte("to")}, util.NewUint128(), 1, TxPayloadBinaryType, []byte("1"), TransactionGasPrice, gasLimit)
+	tx2, _ := NewTransaction(bc.ChainID(), from, &Address{[]byte("to")}, util.NewUint128(), 3, TxPayloadBinaryType, []byte("2"), TransactionGasPrice, gasLimit)
+	tx3, _ := NewTransaction(bc.ChainID(), from, &Address{[]byte("to")}, util.NewUint128(), 4, TxPayloadBinaryType, []byte("3"), TransactionGasPrice, gasLimit)
+	tx4, _ := NewTransaction(bc.ChainID(), from, &Address{[]byte("to")}, util.NewUint128(), 2, TxPayloadBinaryType, []byte("4"), TransactionGasPrice, gasLimit)
+	tx5, _ := NewTransaction(bc.ChainID(), other, &Address{[]byte("to")}, util.NewUint128(), 1, TxPayloadBinaryType, []byte("5"), TransactionGasPrice, gasLimit)
+	tx6, _ := NewTransaction(bc.ChainID(), other, &Address{[]byte("to")}, util.NewUint128(), 2, TxPayloadBinaryType, []byte("6"), highPrice, gasLimit)
+	for _, tx := range []*Transaction{tx1, tx2, tx3, tx4} {
+		assert.Nil(t, tx.Sign(signature1))
+	}
+	for _, tx := range []*Transaction{tx5, tx6} {
+		assert.Nil(t, tx.Sign(signature2))
+	}
+
+	// per sender limit, keep the lower nonces.
+	assert.Nil(t, txPool.Push(tx1))
+	assert.Nil(t, txPool.Push(tx2))
+	assert.Equal(t, ErrSenderTxsOutOfLimit, txPool.Push(tx3))
+	assert.Nil(t, txPool.Push(tx4))
+	assert.Nil(t, txPool.GetTransaction(tx2.Hash()))
+	assert.Equal(t, 2, len(txPool.all))
+
+	// full, a tx with higher gas price evicts the highest nonce tx of the longest bucket with the lowest gas price.
+	assert.Nil(t, txPool.Push(tx5))
+	assert.Equal(t, 3, len(txPool.all))
+	assert.Nil(t, txPool.Push(tx6))
+	assert.Equal(t, 3, len(txPool.all))
+	assert.Nil(t, txPool.GetTransaction(tx4.Hash()))
+	assert.NotNil(t, txPool.GetTransaction(tx6.Hash()))
+
+	// expire, the higher nonce tx of the same sender is dropped too.
+	txPool.arrivals[tx5.Hash().Hex()] = time.Now().Add(-2 * time.Minute)
+	txPool.expire()
+	assert.Nil(t, txPool.GetTransaction(tx5.Hash()))
+	assert.Nil(t, txPool.GetTransaction(tx6.Hash()))
+	assert.NotNil(t, txPool.GetTransaction(tx1.Hash()))
+	assert.Equal(t, 1, len(txPool.all))
+	assert.Equal(t, 1, len(txPool.arrivals))
+	assert.Equal(t, 1, len(txPool.buckets))
+	assert.Equal(t, 1, txPool.candidates.Len())
+}
+
+func TestTransactionPool_PendingNonce(t *testing.T) {
+	from, signature := testSigner(t)
+
+	bc := testNeb(t).chain
+	txPool, _ := NewTransactionPool(10)
+	txPool.setBlockChain(bc)
+	txPool.setEventEmitter(bc.eventEmitter)
+
+	nonce, err := txPool.PendingNonce(from)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), nonce)
+
+	gasLimit, _ := util.NewUint128FromInt(200000)
+	for _, n := range []uint64{1, 2, 4} {
+		tx, _ := NewTransaction(bc.ChainID(), from, &Address{[]byte("to")}, util.NewUint128(), n, TxPayloadBinaryType, []byte("nas"), TransactionGasPrice, gasLimit)
+		assert.Nil(t, tx.Sign(signature))
+		assert.Nil(t, txPool.Push(tx))
+	}
+
+	// the tx of nonce 4 is not executable before nonce 3.
+	nonce, err = txPool.PendingNonce(from)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(2), nonce)
+}
+
+func TestTransactionPool_Journal(t *testing.T) {
+	from, signature := testSigner(t)
+
+	dir, err := ioutil.TempDir("", "txpool")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "transactions.journal")
+
+	bc := testNeb(t).chain
+	txPool, _ := NewTransactionPool(16)
+	txPool.setBlockChain(bc)
+	txPool.setEventEmitter(bc.eventEmitter)
+	txPool.SetJournal(path)
+	txPool.loadJournal()
+
+	gasLimit, _ := util.NewUint128FromInt(200000)
+	tx1, _ := NewTransaction(bc.ChainID(), from, &Address{[]byte("to")}, util.NewUint128(), 1, TxPayloadBinaryType, []byte("1"), TransactionGasPrice, gasLimit)
+	tx2, _ := NewTransaction(bc.ChainID(), from, &Address{[]byte("to")}, util.NewUint128(), 2, TxPayloadBinaryType, []byte("2"), TransactionGasPrice, gasLimit)
+	tx3, _ := NewTransaction(bc.ChainID(), from, &Address{[]byte("to")}, util.NewUint128(), 3, TxPayloadBinaryType, []byte("3"), TransactionGasPrice, gasLimit)
+	for _, tx := range []*Transaction{tx1, tx2, tx3} {
+		assert.Nil(t, tx.Sign(signature))
+	}
+	assert.Nil(t, txPool.push(tx1, true))
+	assert.Nil(t, txPool.push(tx2, true))
+	// remote txs are not journaled.
+	assert.Nil(t, txPool.Push(tx3))
+	assert.Nil(t, txPool.journal.close())
+
+	// reload the local txs.
+	txPool, _ = NewTransactionPool(16)
+	txPool.setBlockChain(bc)
+	txPool.setEventEmitter(bc.eventEmitter)
+	txPool.SetJournal(path)
+	txPool.loadJournal()
+	assert.Equal(t, 2, len(txPool.all))
+	assert.NotNil(t, txPool.GetTransaction(tx1.Hash()))
+	assert.NotNil(t, txPool.GetTransaction(tx2.Hash()))
+
+	// the packed txs are removed from journal when rotating.
+	assert.Equal(t, tx1, txPool.Pop())
+	txPool.rotateJournal()
+	assert.Nil(t, txPool.journal.close())
+
+	txPool, _ = NewTransactionPool(16)
+	txPool.setBlockChain(bc)
+	txPool.setEventEmitter(bc.eventEmitter)
+	txPool.SetJournal(path)
+	txPool.loadJournal()
+	assert.Equal(t, 1, len(txPool.all))
+	assert.NotNil(t, txPool.GetTransaction(tx2.Hash()))
+}
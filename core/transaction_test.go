@@ -47,7 +47,7 @@ func mockDeployTransaction(chainID uint32, nonce uint64) *Transaction {
 	`
 	sourceType := "js"
 	args := `["NebulasToken", "NAS", 1000000000]`
-	payloadObj, _ := NewDeployPayload(source, sourceType, args)
+	payloadObj, _ := NewDeployPayload(source, sourceType, args, "", false)
 	payload, _ := payloadObj.ToBytes()
 	return mockTransaction(chainID, nonce, TxPayloadDeployType, payload)
 }
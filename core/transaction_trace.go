@@ -0,0 +1,166 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"encoding/json"
+
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// Types of trace steps.
+const (
+	TraceStepStorageGet = "storage_get"
+	TraceStepStoragePut = "storage_put"
+	TraceStepStorageDel = "storage_del"
+	TraceStepTransfer   = "transfer"
+	TraceStepEvent      = "event"
+	TraceStepLog        = "log"
+	TraceStepCall       = "call"
+)
+
+// TraceStep is a host call made by the contract during a traced execution.
+type TraceStep struct {
+	Type string
+	// Key is the storage key, event topic, transfer receiver, log level or callee.
+	Key string
+	// Value is the storage value, event data, transfer amount, log message or called function.
+	Value string
+	Err   string
+	// Instructions is the count of instructions executed when the step happened.
+	Instructions uint64
+}
+
+// TransactionTrace is the result of replaying a transaction in tracing mode.
+type TransactionTrace struct {
+	Tx    *Transaction
+	Block *Block
+
+	// Event is the execution result recorded on chain.
+	Event *TransactionEvent
+
+	// Result, Err and ExecutionGas come from the replayed contract execution, Err is not truncated.
+	Result       string
+	Err          error
+	ExecutionGas uint64
+	Steps        []*TraceStep
+}
+
+// AddStep append a step to the trace.
+func (trace *TransactionTrace) AddStep(step *TraceStep) {
+	trace.Steps = append(trace.Steps, step)
+}
+
+// TraceTransaction re-execute the transaction on chain upon the state of its parent block,
+// recording the host calls made by the contract.
+func (bc *BlockChain) TraceTransaction(hash byteutils.Hash) (*TransactionTrace, error) {
+	block, err := bc.transactionBlock(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	parent := bc.GetBlock(block.ParentHash())
+	if parent == nil {
+		return nil, ErrMissingParentBlock
+	}
+	if parent.StatePruned() {
+		return nil, ErrStatePruned
+	}
+
+	event, err := block.FetchExecutionResultEvent(hash)
+	if err != nil {
+		return nil, err
+	}
+	txEvent := new(TransactionEvent)
+	if err := json.Unmarshal([]byte(event.Data), txEvent); err != nil {
+		return nil, err
+	}
+
+	sandbox, err := NewBlock(bc.chainID, block.Coinbase(), parent)
+	if err != nil {
+		return nil, err
+	}
+	defer sandbox.RollBack()
+	sandbox.header.hash = block.Hash()
+	sandbox.header.timestamp = block.Timestamp()
+
+	// replay the preceding transactions in order, then the traced one.
+	for _, tx := range block.transactions {
+		var trace *TransactionTrace
+		if tx.Hash().Equals(hash) {
+			trace = &TransactionTrace{Tx: tx, Block: block, Event: txEvent, Steps: make([]*TraceStep, 0)}
+		}
+		sandbox.trace = trace
+
+		txWorldState, err := sandbox.WorldState().Prepare(tx.Hash().String())
+		if err != nil {
+			return nil, err
+		}
+		if _, err := sandbox.ExecuteTransaction(tx, txWorldState); err != nil {
+			logging.VLog().WithFields(logrus.Fields{
+				"tx":    tx,
+				"block": block,
+				"err":   err,
+			}).Debug("Failed to replay transaction.")
+			return nil, err
+		}
+		if _, err := txWorldState.CheckAndUpdate(); err != nil {
+			return nil, err
+		}
+
+		if trace != nil {
+			return trace, nil
+		}
+	}
+	return nil, ErrTransactionNotFound
+}
+
+// transactionBlock return the canonical block packing the transaction.
+func (bc *BlockChain) transactionBlock(hash byteutils.Hash) (*Block, error) {
+	tail := bc.TailBlock()
+	if _, err := tail.GetTransaction(hash); err != nil {
+		return nil, ErrTransactionNotFound
+	}
+
+	// the txs trie is accumulated along the chain, search the first block containing the tx.
+	// blocks with pruned states are skipped, their children will fail on the parent check.
+	lo, hi := uint64(1), tail.Height()
+	for lo < hi {
+		mid := lo + (hi-lo)/2
+		block := bc.GetBlockOnCanonicalChainByHeight(mid)
+		found := false
+		if block != nil && !block.StatePruned() {
+			_, err := block.GetTransaction(hash)
+			found = err == nil
+		}
+		if found {
+			hi = mid
+		} else {
+			lo = mid + 1
+		}
+	}
+
+	block := bc.GetBlockOnCanonicalChainByHeight(lo)
+	if block == nil {
+		return nil, ErrTransactionNotFound
+	}
+	return block, nil
+}
@@ -0,0 +1,105 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"encoding/json"
+
+	"github.com/nebulasio/go-nebulas/util"
+)
+
+// UpgradePayload carry the new source of a contract
+type UpgradePayload struct {
+	SourceType string
+	Source     string
+	ABI        string `json:",omitempty"`
+}
+
+// LoadUpgradePayload from bytes
+func LoadUpgradePayload(bytes []byte) (*UpgradePayload, error) {
+	payload := &UpgradePayload{}
+	if err := json.Unmarshal(bytes, payload); err != nil {
+		return nil, ErrInvalidArgument
+	}
+	return NewUpgradePayload(payload.Source, payload.SourceType, payload.ABI)
+}
+
+// NewUpgradePayload with source & optional abi
+func NewUpgradePayload(source, sourceType, abi string) (*UpgradePayload, error) {
+	if len(source) == 0 {
+		return nil, ErrInvalidDeploySource
+	}
+
+	if sourceType != SourceTypeTypeScript && sourceType != SourceTypeJavaScript {
+		return nil, ErrInvalidDeploySourceType
+	}
+
+	if len(abi) > 0 {
+		if _, err := ParseContractABI(abi); err != nil {
+			return nil, err
+		}
+	}
+
+	return &UpgradePayload{
+		Source:     source,
+		SourceType: sourceType,
+		ABI:        abi,
+	}, nil
+}
+
+// ToBytes serialize payload
+func (payload *UpgradePayload) ToBytes() ([]byte, error) {
+	return json.Marshal(payload)
+}
+
+// BaseGasCount returns base gas count
+func (payload *UpgradePayload) BaseGasCount() *util.Uint128 {
+	base, _ := util.NewUint128FromInt(60)
+	return base
+}
+
+// Execute upgrade payload in tx, replace the source of contract tx.to and keep its storage.
+// Only the deployer of a contract deployed with the upgradable flag can upgrade it.
+// The abi of the contract is replaced too, an upgrade without abi removes it.
+func (payload *UpgradePayload) Execute(limitedGas *util.Uint128, tx *Transaction, block *Block, ws WorldState) (*util.Uint128, string, error) {
+	if block == nil || tx == nil {
+		return util.NewUint128(), "", ErrNilArgument
+	}
+
+	contract, err := CheckContract(tx.to, ws)
+	if err != nil {
+		return util.NewUint128(), "", err
+	}
+
+	authorized, err := checkContractUpgrader(contract, tx.from)
+	if err != nil {
+		return util.NewUint128(), "", err
+	}
+	if !authorized {
+		return util.NewUint128(), "", ErrUnauthorizedContractUpgrade
+	}
+
+	if err := recordContractUpgrade(contract, tx); err != nil {
+		return util.NewUint128(), "", err
+	}
+	if err := setContractABI(contract, payload.ABI); err != nil {
+		return util.NewUint128(), "", err
+	}
+	return util.NewUint128(), "", nil
+}
@@ -34,15 +34,21 @@ import (
 	"github.com/nebulasio/go-nebulas/consensus/pb"
 	"github.com/nebulasio/go-nebulas/core/pb"
 	"github.com/nebulasio/go-nebulas/neblet/pb"
+	"github.com/nebulasio/go-nebulas/nr/pb"
 	"github.com/nebulasio/go-nebulas/storage"
 	"github.com/nebulasio/go-nebulas/util"
 )
 
 // Payload Types
 const (
-	TxPayloadBinaryType = "binary"
-	TxPayloadDeployType = "deploy"
-	TxPayloadCallType   = "call"
+	TxPayloadBinaryType    = "binary"
+	TxPayloadDeployType    = "deploy"
+	TxPayloadCallType      = "call"
+	TxPayloadCandidateType = "candidate"
+	TxPayloadDelegateType  = "delegate"
+	TxPayloadDipType       = "dip"
+	TxPayloadEvidenceType  = "evidence"
+	TxPayloadUpgradeType   = "upgrade"
 )
 
 // Const.
@@ -71,6 +77,13 @@ const (
 var (
 	ErrInvalidBlockOnCanonicalChain                      = errors.New("invalid block, it's not on canonical chain")
 	ErrNotBlockInCanonicalChain                          = errors.New("cannot find the block in canonical chain")
+	ErrStatePruned                                       = errors.New("the states of block are pruned")
+	ErrStorageNotIterable                                = errors.New("storage doesn't support iteration")
+	ErrChainNotEmpty                                     = errors.New("the chain has blocks after genesis")
+	ErrInvalidStateSyncTail                              = errors.New("invalid block whose states are synced from peers")
+	ErrInvalidLogRange                                   = errors.New("invalid block range of logs")
+	ErrTooManyLogs                                       = errors.New("too many logs matched, narrow the block range")
+	ErrLogRangeTooLarge                                  = errors.New("block range of logs is too large")
 	ErrInvalidBlockCannotFindParentInLocal               = errors.New("invalid block received, download its parent from others")
 	ErrCannotFindBlockAtGivenHeight                      = errors.New("cannot find a block at given height which is less than tail block's height")
 	ErrInvalidBlockCannotFindParentInLocalAndTryDownload = errors.New("invalid block received, download its parent from others")
@@ -83,6 +96,8 @@ var (
 	ErrGenesisNotEqualTokenInDB      = errors.New("Failed to check. genesis TokenDistribution not equal in db")
 	ErrGenesisNotEqualDynastyLenInDB = errors.New("Failed to check. genesis dynasty length not equal in db")
 	ErrGenesisNotEqualTokenLenInDB   = errors.New("Failed to check. genesis TokenDistribution length not equal in db")
+	ErrGenesisNotEqualDposConfInDB   = errors.New("Failed to check. genesis dpos parameters not equal in db")
+	ErrInvalidGenesisDposConf        = errors.New("invalid dpos parameters in genesis")
 
 	ErrLinkToWrongParentBlock = errors.New("link the block to a block who is not its parent")
 	ErrMissingParentBlock     = errors.New("cannot find the block's parent block in storage")
@@ -117,10 +132,21 @@ var (
 	ErrContractDeployFailed               = errors.New("contract deploy failed")
 	ErrContractCheckFailed                = errors.New("contract check failed")
 	ErrContractTransactionAddressNotEqual = errors.New("contract transaction from-address not equal to to-address")
-
-	ErrDuplicatedTransaction = errors.New("duplicated transaction")
-	ErrSmallTransactionNonce = errors.New("cannot accept a transaction with smaller nonce")
-	ErrLargeTransactionNonce = errors.New("cannot accept a transaction with too bigger nonce")
+	ErrUnauthorizedContractUpgrade        = errors.New("contract can only be upgraded by the deployer of an upgradable contract")
+	ErrInvalidContractABI                 = errors.New("invalid contract abi")
+	ErrContractABINotFound                = errors.New("contract has no abi")
+	ErrFunctionNotInContractABI           = errors.New("function is not declared in contract abi")
+	ErrArgsMismatchContractABI            = errors.New("args mismatch the contract abi")
+	ErrFunctionNotPayable                 = errors.New("function is not payable")
+	ErrReadOnlyFunctionWrite              = errors.New("read-only function cannot change the contract storage")
+
+	ErrDuplicatedTransaction  = errors.New("duplicated transaction")
+	ErrUnderpricedReplacement = errors.New("replacement transaction's gas price is not bumped enough")
+	ErrSenderTxsOutOfLimit    = errors.New("too many pending transactions of the sender")
+	ErrTxPoolFull             = errors.New("transaction pool is full of transactions with higher gas price")
+	ErrJournalNotOpened       = errors.New("transaction journal is not opened")
+	ErrSmallTransactionNonce  = errors.New("cannot accept a transaction with smaller nonce")
+	ErrLargeTransactionNonce  = errors.New("cannot accept a transaction with too bigger nonce")
 
 	ErrInvalidAddress         = errors.New("address: invalid address")
 	ErrInvalidAddressFormat   = errors.New("address: invalid address format")
@@ -131,6 +157,16 @@ var (
 	ErrInvalidDelegatePayloadAction      = errors.New("invalid transaction vote payload action")
 	ErrInvalidDelegateToNonCandidate     = errors.New("cannot delegate to non-candidate")
 	ErrInvalidUnDelegateFromNonDelegatee = errors.New("cannot un-delegate from non-delegatee")
+	ErrDipNotEnabled                     = errors.New("developer incentive protocol is not enabled")
+
+	ErrInvalidEvidence         = errors.New("invalid double mint evidence")
+	ErrInvalidEvidenceHash     = errors.New("invalid block hash in double mint evidence")
+	ErrInvalidEvidenceSigner   = errors.New("blocks in double mint evidence are signed by different miners")
+	ErrInvalidEvidenceProposer = errors.New("blocks in double mint evidence are not signed by the scheduled proposer")
+	ErrInvalidProtoToEvidence  = errors.New("protobuf message cannot be converted into DoubleMintEvidence")
+	ErrEvidenceExpired         = errors.New("double mint evidence is older than the kept dynasties")
+	ErrDuplicatedEvidence      = errors.New("duplicated double mint evidence")
+	ErrEvidencePoolFull        = errors.New("evidence pool is full")
 
 	ErrCloneWorldState           = errors.New("Failed to clone world state")
 	ErrCloneAccountState         = errors.New("Failed to clone account state")
@@ -158,6 +194,7 @@ var (
 
 	ErrInvalidTransactionResultEvent  = errors.New("invalid transaction result event, the last event in tx's events should be result event")
 	ErrNotFoundTransactionResultEvent = errors.New("transaction result event is not found ")
+	ErrTransactionNotFound            = errors.New("transaction is not found on chain")
 
 	// nvm error
 	ErrExecutionFailed = errors.New("execution failed")
@@ -178,12 +215,19 @@ type TxPayload interface {
 	Execute(limitedGas *util.Uint128, tx *Transaction, block *Block, ws WorldState) (*util.Uint128, string, error)
 }
 
+// gasRefunder is implemented by the payloads refunding part of their execution gas,
+// the refund is only deducted from the charged gas.
+type gasRefunder interface {
+	gasRefund() uint64
+}
+
 // MessageType
 const (
 	MessageTypeNewBlock                   = "newblock"
 	MessageTypeParentBlockDownloadRequest = "dlblock"
 	MessageTypeBlockDownloadResponse      = "dlreply"
 	MessageTypeNewTx                      = "newtx"
+	MessageTypeDoubleMintEvidence         = "dmevidence"
 )
 
 // Consensus interface of consensus algorithm.
@@ -250,9 +294,31 @@ type SmartContractEngine interface {
 	DeployAndInit(source, sourceType, args string) (string, error)
 	Call(source, sourceType, function, args string) (string, error)
 	ExecutionInstructions() uint64
+	ExecutionRefund() uint64
+	SetTrace(trace *TransactionTrace)
 	Dispose()
 }
 
+// NR interface of nebulas rank
+type NR interface {
+	Start()
+	Stop()
+
+	GetNRByPeriod(period uint64) (*nrpb.NRData, error)
+	PeriodOfHeight(height uint64) uint64
+}
+
+// Dip interface of developer incentive protocol
+type Dip interface {
+	Start()
+	Stop()
+
+	// RecordContractCalls counts the successful contract calls of the block.
+	RecordContractCalls(block *Block) error
+	// RewardDevelopers settles the rewards of a finished epoch, only once per epoch.
+	RewardDevelopers(epoch uint64, block *Block, ws WorldState) ([]*DipReward, error)
+}
+
 // Neblet interface breaks cycle import dependency and hides unused services.
 type Neblet interface {
 	Genesis() *corepb.Genesis
@@ -266,6 +332,8 @@ type Neblet interface {
 	IsActiveSyncing() bool
 	AccountManager() AccountManager
 	Nvm() NVM
+	Nr() NR
+	Dip() Dip
 	StartPprof(string) error
 }
 
@@ -284,7 +352,16 @@ type WorldState interface {
 	Dynasty() ([]byteutils.Hash, error)
 	DynastyRoot() byteutils.Hash
 
+	LoginCandidate(candidate byteutils.Hash) error
+	LogoutCandidate(candidate byteutils.Hash) error
+	Delegate(delegator byteutils.Hash, delegatee byteutils.Hash) error
+	UnDelegate(delegator byteutils.Hash, delegatee byteutils.Hash) error
+	Slash(offender byteutils.Hash) error
+	VerifyProposer(root *consensuspb.ConsensusRoot, proposer byteutils.Hash) error
+	EvidenceSince(now int64) (int64, error)
+
 	RecordGas(from string, gas *util.Uint128) error
 
+	Prepare(txid interface{}) (state.TxWorldState, error)
 	Reset() error
 }
@@ -0,0 +1,474 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package dip
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// Errors
+var (
+	ErrEpochNotFinished = errors.New("dip epoch has not finished yet")
+	ErrEpochRewarded    = errors.New("dip epoch has been rewarded")
+	ErrInvalidCallsData = errors.New("invalid dip contract calls data")
+	ErrDipNotActivated  = errors.New("dip is not activated at the height")
+)
+
+// Const
+const (
+	// DefaultEpochLength is the number of blocks in an epoch, one day of 15s blocks.
+	DefaultEpochLength uint64 = 5760
+
+	// SubmitDelay is the number of blocks other miners wait for the first
+	// proposer of the next epoch to submit the reward transaction.
+	SubmitDelay uint64 = 64
+)
+
+var (
+	// ActivationHeight is the height from which the contract callers are recorded and rewarded.
+	ActivationHeight uint64 = 2000000
+
+	// RewardPerEpoch is the most shared by the developers of the called contracts in an epoch, 100 NAS.
+	RewardPerEpoch, _ = util.NewUint128FromString("100000000000000000000")
+
+	// RewardAddress is the account keeping the contract callers and the rewarded epochs.
+	// The rewards are paid from its balance, which is funded by transfers, no NAS is minted.
+	RewardAddress, _ = core.NewAddressFromPublicKey([]byte("nebulas developer incentive protocol"))
+
+	// TransactionGasLimit of the reward transaction.
+	TransactionGasLimit = util.NewUint128FromUint(100000)
+)
+
+var (
+	callsPrefix    = []byte("dip_calls_")
+	callersPrefix  = []byte("dip_callers_")
+	rewardedPrefix = []byte("dip_rewarded_")
+)
+
+// Dip counts the contract callers of every epoch and rewards the developers
+// once the epoch finished.
+type Dip struct {
+	neb core.Neblet
+
+	epochLength uint64
+
+	mu            sync.Mutex
+	submittedFlag bool
+	submitted     uint64
+
+	quitCh chan int
+}
+
+// NewDIP returns a new dip.
+func NewDIP(neb core.Neblet) *Dip {
+	return &Dip{
+		neb:         neb,
+		epochLength: DefaultEpochLength,
+		quitCh:      make(chan int, 1),
+	}
+}
+
+// Start start the dip loop.
+func (d *Dip) Start() {
+	logging.CLog().Info("Starting Dip...")
+
+	go d.loop()
+}
+
+// Stop stop the dip loop.
+func (d *Dip) Stop() {
+	logging.CLog().Info("Stopping Dip...")
+
+	d.quitCh <- 0
+}
+
+func (d *Dip) loop() {
+	logging.CLog().Info("Started Dip.")
+	timerChan := time.NewTicker(15 * time.Second).C
+	for {
+		select {
+		case <-d.quitCh:
+			logging.CLog().Info("Stopped Dip.")
+			return
+		case <-timerChan:
+			d.submitReward()
+		}
+	}
+}
+
+// EpochOfHeight returns the epoch the block height belongs to.
+func (d *Dip) EpochOfHeight(height uint64) uint64 {
+	if height <= 1 {
+		return 0
+	}
+	return (height - 1) / d.epochLength
+}
+
+// epochRange returns the first and the last block height of the epoch.
+func (d *Dip) epochRange(epoch uint64) (uint64, uint64) {
+	return epoch*d.epochLength + 1, (epoch + 1) * d.epochLength
+}
+
+func epochKey(prefix []byte, epoch uint64) []byte {
+	key := append([]byte{}, prefix...)
+	return append(key, byteutils.FromUint64(epoch)...)
+}
+
+func callsKey(epoch uint64, contract byteutils.Hash) []byte {
+	return append(epochKey(callsPrefix, epoch), contract...)
+}
+
+func callerKey(epoch uint64, contract byteutils.Hash, caller byteutils.Hash) []byte {
+	key := append(epochKey(callersPrefix, epoch), contract...)
+	return append(key, caller...)
+}
+
+// RecordContractCalls counts the distinct callers of the contracts successfully called
+// in the block, using the execution result events of its transactions.
+func (d *Dip) RecordContractCalls(block *core.Block) error {
+	if block.Height() < ActivationHeight {
+		return nil
+	}
+	ws := block.WorldState()
+
+	var acc state.Account
+	epoch := d.EpochOfHeight(block.Height())
+	for _, tx := range block.Transactions() {
+		if tx.Type() != core.TxPayloadCallType {
+			continue
+		}
+		ok, err := core.TransactionSucceeded(ws, tx.Hash())
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+		if acc == nil {
+			if acc, err = ws.GetOrCreateUserAccount(RewardAddress.Bytes()); err != nil {
+				return err
+			}
+		}
+		if err := recordCaller(acc, epoch, tx.To().Bytes(), tx.From().Bytes()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// recordCaller count the caller of the contract in the epoch, if not counted yet.
+func recordCaller(acc state.Account, epoch uint64, contract byteutils.Hash, caller byteutils.Hash) error {
+	key := callerKey(epoch, contract, caller)
+	// nil error means the caller is counted already.
+	if _, err := acc.Get(key); err != storage.ErrKeyNotFound {
+		return err
+	}
+	// keep the contract and the caller in the value, account iterators only expose values.
+	if err := acc.Put(key, append(append([]byte{}, contract...), caller...)); err != nil {
+		return err
+	}
+
+	key = callsKey(epoch, contract)
+	count := uint64(0)
+	bytes, err := acc.Get(key)
+	if err != nil && err != storage.ErrKeyNotFound {
+		return err
+	}
+	if err == nil {
+		if len(bytes) != len(contract)+8 {
+			return ErrInvalidCallsData
+		}
+		count = byteutils.Uint64(bytes[len(contract):])
+	}
+	return acc.Put(key, append(append([]byte{}, contract...), byteutils.FromUint64(count+1)...))
+}
+
+// RewardDevelopers settles the rewards of a finished epoch. The balance of RewardAddress,
+// at most RewardPerEpoch, is shared by the developers in proportion to the callers of their contracts.
+func (d *Dip) RewardDevelopers(epoch uint64, block *core.Block, ws core.WorldState) ([]*core.DipReward, error) {
+	if block.Height() < ActivationHeight {
+		return nil, ErrDipNotActivated
+	}
+	if _, end := d.epochRange(epoch); block.Height() <= end {
+		return nil, ErrEpochNotFinished
+	}
+
+	acc, err := ws.GetOrCreateUserAccount(RewardAddress.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	rewarded, err := isRewarded(acc, epoch)
+	if err != nil {
+		return nil, err
+	}
+	if rewarded {
+		return nil, ErrEpochRewarded
+	}
+
+	contracts, callers, err := epochCalls(acc, epoch)
+	if err != nil {
+		return nil, err
+	}
+	values, err := shareReward(acc.Balance(), callers)
+	if err != nil {
+		return nil, err
+	}
+
+	rewards := []*core.DipReward{}
+	paid := util.NewUint128()
+	for i, contract := range contracts {
+		developer, err := developerOf(ws, contract)
+		if err != nil {
+			return nil, err
+		}
+		addr, err := core.AddressParseFromBytes(contract)
+		if err != nil {
+			return nil, err
+		}
+		rewards = append(rewards, &core.DipReward{
+			Contract:  addr,
+			Developer: developer,
+			Callers:   callers[i],
+			Value:     values[i],
+		})
+		if paid, err = paid.Add(values[i]); err != nil {
+			return nil, err
+		}
+		if err := acc.Del(callsKey(epoch, contract)); err != nil {
+			return nil, err
+		}
+	}
+	if err := acc.SubBalance(paid); err != nil {
+		return nil, err
+	}
+	if err := clearCallers(acc, epoch); err != nil {
+		return nil, err
+	}
+
+	if err := acc.Put(epochKey(rewardedPrefix, epoch), byteutils.FromUint64(block.Height())); err != nil {
+		return nil, err
+	}
+	return rewards, nil
+}
+
+// shareReward split the balance, at most RewardPerEpoch, in proportion to the callers.
+func shareReward(balance *util.Uint128, callers []uint64) ([]*util.Uint128, error) {
+	budget := RewardPerEpoch
+	if balance.Cmp(budget) < 0 {
+		budget = balance
+	}
+	total := uint64(0)
+	for _, c := range callers {
+		total += c
+	}
+
+	values := make([]*util.Uint128, len(callers))
+	for i, c := range callers {
+		value, err := budget.Mul(util.NewUint128FromUint(c))
+		if err != nil {
+			return nil, err
+		}
+		if values[i], err = value.Div(util.NewUint128FromUint(total)); err != nil {
+			return nil, err
+		}
+	}
+	return values, nil
+}
+
+// clearCallers delete the callers recorded in the rewarded epoch.
+func clearCallers(acc state.Account, epoch uint64) error {
+	keys := [][]byte{}
+	iter, err := acc.Iterator(epochKey(callersPrefix, epoch))
+	if err == storage.ErrKeyNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	exist, err := iter.Next()
+	for exist {
+		value := iter.Value()
+		if len(value) != core.AddressLength*2 {
+			return ErrInvalidCallsData
+		}
+		keys = append(keys, callerKey(epoch, value[:core.AddressLength], value[core.AddressLength:]))
+		exist, err = iter.Next()
+	}
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if err := acc.Del(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func isRewarded(acc state.Account, epoch uint64) (bool, error) {
+	_, err := acc.Get(epochKey(rewardedPrefix, epoch))
+	if err == storage.ErrKeyNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// epochCalls returns the called contracts of the epoch and the counts of their callers.
+func epochCalls(acc state.Account, epoch uint64) ([]byteutils.Hash, []uint64, error) {
+	contracts := []byteutils.Hash{}
+	calls := []uint64{}
+
+	iter, err := acc.Iterator(epochKey(callsPrefix, epoch))
+	if err == storage.ErrKeyNotFound {
+		return contracts, calls, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	exist, err := iter.Next()
+	for exist {
+		value := iter.Value()
+		if len(value) != core.AddressLength+8 {
+			return nil, nil, ErrInvalidCallsData
+		}
+		contracts = append(contracts, byteutils.Hash(value[:core.AddressLength]))
+		calls = append(calls, byteutils.Uint64(value[core.AddressLength:]))
+		exist, err = iter.Next()
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return contracts, calls, nil
+}
+
+// developerOf returns the sender of the transaction deploying the contract.
+func developerOf(ws core.WorldState, contract byteutils.Hash) (*core.Address, error) {
+	acc, err := ws.GetContractAccount(contract)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := core.GetTransaction(acc.BirthPlace(), ws)
+	if err != nil {
+		return nil, err
+	}
+	return tx.From(), nil
+}
+
+// submitReward sends the reward transaction of the last finished epoch from
+// the miner. The proposer of the first block after the epoch submits it, other
+// miners only do it if the epoch is still not rewarded SubmitDelay blocks later.
+func (d *Dip) submitReward() {
+	conf := d.neb.Config().Chain
+	if !conf.StartMine || conf.EnableRemoteSignServer || !d.neb.Consensus().Enable() {
+		return
+	}
+
+	chain := d.neb.BlockChain()
+	tail := chain.TailBlock()
+	current := d.EpochOfHeight(tail.Height())
+	if current == 0 {
+		return
+	}
+	epoch := current - 1
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.submittedFlag && d.submitted >= epoch {
+		return
+	}
+
+	miner, err := core.AddressParse(conf.Miner)
+	if err != nil {
+		return
+	}
+	_, end := d.epochRange(epoch)
+	first := chain.GetBlockOnCanonicalChainByHeight(end + 1)
+	if first == nil {
+		return
+	}
+	if !byteutils.Equal(first.ConsensusRoot().Proposer, miner.Bytes()) && tail.Height() < end+SubmitDelay {
+		return
+	}
+
+	ws, err := tail.WorldState().Clone()
+	if err != nil {
+		return
+	}
+	acc, err := ws.GetOrCreateUserAccount(RewardAddress.Bytes())
+	if err != nil {
+		return
+	}
+	if rewarded, err := isRewarded(acc, epoch); err != nil || rewarded {
+		return
+	}
+	if contracts, _, err := epochCalls(acc, epoch); err != nil || len(contracts) == 0 {
+		return
+	}
+	minerAcc, err := ws.GetOrCreateUserAccount(miner.Bytes())
+	if err != nil {
+		return
+	}
+
+	payload, err := core.NewDipPayload(epoch).ToBytes()
+	if err != nil {
+		return
+	}
+	tx, err := core.NewTransaction(chain.ChainID(), miner, miner, util.NewUint128(), minerAcc.Nonce()+1, core.TxPayloadDipType, payload, chain.GasPrice(), TransactionGasLimit)
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"epoch": epoch,
+			"err":   err,
+		}).Error("Failed to create dip reward transaction.")
+		return
+	}
+	if err := d.neb.AccountManager().SignTransaction(miner, tx); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"epoch": epoch,
+			"err":   err,
+		}).Error("Failed to sign dip reward transaction.")
+		return
+	}
+	if err := chain.TransactionPool().PushAndBroadcast(tx); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"epoch": epoch,
+			"tx":    tx,
+			"err":   err,
+		}).Error("Failed to push dip reward transaction.")
+		return
+	}
+
+	d.submittedFlag, d.submitted = true, epoch
+	logging.VLog().WithFields(logrus.Fields{
+		"epoch": epoch,
+		"tx":    tx,
+	}).Info("Submitted dip reward transaction.")
+}
@@ -0,0 +1,145 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package dip
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDip_Epoch(t *testing.T) {
+	d := &Dip{epochLength: 10}
+	tests := []struct {
+		height uint64
+		epoch  uint64
+	}{
+		{0, 0}, {1, 0}, {10, 0}, {11, 1}, {20, 1}, {21, 2},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.epoch, d.EpochOfHeight(tt.height), "height %d", tt.height)
+	}
+
+	start, end := d.epochRange(2)
+	assert.Equal(t, uint64(21), start)
+	assert.Equal(t, uint64(30), end)
+}
+
+func TestEpochCalls(t *testing.T) {
+	stor, _ := storage.NewMemoryStorage()
+	as, err := state.NewAccountState(nil, stor)
+	assert.Nil(t, err)
+	acc, err := as.GetOrCreateUserAccount(RewardAddress.Bytes())
+	assert.Nil(t, err)
+
+	contracts, calls, err := epochCalls(acc, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(contracts))
+	assert.Equal(t, 0, len(calls))
+
+	c1, _ := core.NewContractAddressFromData([]byte("from"), byteutils.FromUint64(1))
+	c2, _ := core.NewContractAddressFromData([]byte("from"), byteutils.FromUint64(2))
+	put := func(epoch uint64, contract *core.Address, count uint64) {
+		value := append(append([]byte{}, contract.Bytes()...), byteutils.FromUint64(count)...)
+		assert.Nil(t, acc.Put(callsKey(epoch, contract.Bytes()), value))
+	}
+	put(1, c1, 3)
+	put(1, c2, 5)
+	put(2, c1, 7)
+
+	contracts, calls, err = epochCalls(acc, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(contracts))
+	found := make(map[byteutils.HexHash]uint64)
+	for i, c := range contracts {
+		found[c.Hex()] = calls[i]
+	}
+	assert.Equal(t, uint64(3), found[byteutils.Hash(c1.Bytes()).Hex()])
+	assert.Equal(t, uint64(5), found[byteutils.Hash(c2.Bytes()).Hex()])
+
+	contracts, calls, err = epochCalls(acc, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(contracts))
+	assert.Equal(t, uint64(7), calls[0])
+
+	rewarded, err := isRewarded(acc, 1)
+	assert.Nil(t, err)
+	assert.False(t, rewarded)
+	assert.Nil(t, acc.Put(epochKey(rewardedPrefix, 1), byteutils.FromUint64(11)))
+	rewarded, err = isRewarded(acc, 1)
+	assert.Nil(t, err)
+	assert.True(t, rewarded)
+}
+
+func TestRecordCaller(t *testing.T) {
+	stor, _ := storage.NewMemoryStorage()
+	as, err := state.NewAccountState(nil, stor)
+	assert.Nil(t, err)
+	acc, err := as.GetOrCreateUserAccount(RewardAddress.Bytes())
+	assert.Nil(t, err)
+
+	contract, _ := core.NewContractAddressFromData([]byte("from"), byteutils.FromUint64(1))
+	alice, _ := core.NewAddressFromPublicKey([]byte("alice"))
+	bob, _ := core.NewAddressFromPublicKey([]byte("bob"))
+
+	// repeated calls from the same caller are counted once.
+	for _, caller := range []*core.Address{alice, alice, bob, alice} {
+		assert.Nil(t, recordCaller(acc, 1, contract.Bytes(), caller.Bytes()))
+	}
+	assert.Nil(t, recordCaller(acc, 2, contract.Bytes(), alice.Bytes()))
+
+	contracts, callers, err := epochCalls(acc, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(contracts))
+	assert.Equal(t, uint64(2), callers[0])
+
+	assert.Nil(t, clearCallers(acc, 1))
+	_, err = acc.Get(callerKey(1, contract.Bytes(), alice.Bytes()))
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+	_, err = acc.Get(callerKey(2, contract.Bytes(), alice.Bytes()))
+	assert.Nil(t, err)
+}
+
+func TestShareReward(t *testing.T) {
+	// the reward is capped by RewardPerEpoch.
+	balance, err := RewardPerEpoch.Mul(util.NewUint128FromUint(2))
+	assert.Nil(t, err)
+	values, err := shareReward(balance, []uint64{1, 3})
+	assert.Nil(t, err)
+	quarter, err := RewardPerEpoch.Div(util.NewUint128FromUint(4))
+	assert.Nil(t, err)
+	assert.Equal(t, quarter.String(), values[0].String())
+	rest, err := RewardPerEpoch.Sub(quarter)
+	assert.Nil(t, err)
+	assert.Equal(t, rest.String(), values[1].String())
+
+	// only the funded balance is shared.
+	values, err = shareReward(util.NewUint128FromUint(10), []uint64{1, 1, 2})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"2", "2", "5"}, []string{values[0].String(), values[1].String(), values[2].String()})
+
+	values, err = shareReward(util.NewUint128(), []uint64{1})
+	assert.Nil(t, err)
+	assert.Equal(t, "0", values[0].String())
+}
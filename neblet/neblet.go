@@ -21,12 +21,15 @@ import (
 
 	"github.com/nebulasio/go-nebulas/account"
 	"github.com/nebulasio/go-nebulas/consensus/dpos"
+	"github.com/nebulasio/go-nebulas/consensus/pod"
 	"github.com/nebulasio/go-nebulas/core"
 	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/dip"
 	"github.com/nebulasio/go-nebulas/metrics"
 	"github.com/nebulasio/go-nebulas/neblet/pb"
 	nebnet "github.com/nebulasio/go-nebulas/net"
 	"github.com/nebulasio/go-nebulas/nf/nvm"
+	"github.com/nebulasio/go-nebulas/nr"
 	"github.com/nebulasio/go-nebulas/rpc"
 	"github.com/nebulasio/go-nebulas/storage"
 	nsync "github.com/nebulasio/go-nebulas/sync"
@@ -40,6 +43,15 @@ var (
 
 	// ErrIncompatibleStorageSchemeVersion throws when the storage schema has been changed
 	ErrIncompatibleStorageSchemeVersion = errors.New("incompatible storage schema version, pls migrate your storage")
+
+	// ErrUnsupportedConsensus throws when the consensus in config is unknown
+	ErrUnsupportedConsensus = errors.New("unsupported consensus, should be dpos or pod")
+)
+
+// Supported consensus engines
+const (
+	DposConsensus = "dpos"
+	PodConsensus  = "pod"
 )
 
 var (
@@ -72,6 +84,10 @@ type Neblet struct {
 
 	nvm core.NVM
 
+	nr core.NR
+
+	dip core.Dip
+
 	running bool
 }
 
@@ -128,9 +144,18 @@ func (n *Neblet) Setup() {
 	// nvm
 	n.nvm = nvm.NewNebulasVM()
 
+	// dip
+	n.dip = dip.NewDIP(n)
+
 	// core
 	n.eventEmitter = core.NewEventEmitter(40960)
-	n.consensus = dpos.NewDpos()
+	n.consensus, err = newConsensus(n.config.Chain.Consensus)
+	if err != nil {
+		logging.CLog().WithFields(logrus.Fields{
+			"consensus": n.config.Chain.Consensus,
+			"err":       err,
+		}).Fatal("Failed to create consensus.")
+	}
 	n.blockChain, err = core.NewBlockChain(n)
 	if err != nil {
 		logging.CLog().WithFields(logrus.Fields{
@@ -150,8 +175,12 @@ func (n *Neblet) Setup() {
 		}).Fatal("Failed to setup blockchain.")
 	}
 
+	// nr
+	n.nr = nr.NewService(n)
+
 	// sync
 	n.syncService = nsync.NewService(n.blockChain, n.netService)
+	n.syncService.EnableStateSync(n.config.Chain.EnableStateSync)
 	n.blockChain.SetSyncService(n.syncService)
 
 	// rpc
@@ -160,6 +189,17 @@ func (n *Neblet) Setup() {
 	logging.CLog().Info("Setuped Neblet.")
 }
 
+func newConsensus(name string) (core.Consensus, error) {
+	switch name {
+	case "", DposConsensus:
+		return dpos.NewDpos(), nil
+	case PodConsensus:
+		return pod.NewPod(), nil
+	default:
+		return nil, ErrUnsupportedConsensus
+	}
+}
+
 // StartPprof start pprof http listen
 func (n *Neblet) StartPprof(listen string) error {
 	if len(listen) > 0 {
@@ -218,8 +258,17 @@ func (n *Neblet) Start() {
 	n.blockChain.Start()
 	n.blockChain.BlockPool().Start()
 	n.blockChain.TransactionPool().Start()
+	n.blockChain.EvidencePool().Start()
+	if n.config.Chain.PruneKeepBlocks > 0 {
+		n.blockChain.Pruner().Start()
+	}
+	if n.config.Chain.EnableAddressIndex {
+		n.blockChain.AddressIndexer().Start()
+	}
 	n.eventEmitter.Start()
 	n.syncService.Start()
+	n.nr.Start()
+	n.dip.Start()
 
 	// start consensus
 	chainConf := n.config.Chain
@@ -273,6 +322,16 @@ func (n *Neblet) Stop() {
 		n.consensus = nil
 	}
 
+	if n.dip != nil {
+		n.dip.Stop()
+		n.dip = nil
+	}
+
+	if n.nr != nil {
+		n.nr.Stop()
+		n.nr = nil
+	}
+
 	if n.syncService != nil {
 		n.syncService.Stop()
 		n.syncService = nil
@@ -284,6 +343,13 @@ func (n *Neblet) Stop() {
 	}
 
 	if n.blockChain != nil {
+		if n.config.Chain.PruneKeepBlocks > 0 {
+			n.blockChain.Pruner().Stop()
+		}
+		if n.config.Chain.EnableAddressIndex {
+			n.blockChain.AddressIndexer().Stop()
+		}
+		n.blockChain.EvidencePool().Stop()
 		n.blockChain.TransactionPool().Stop()
 		n.blockChain.BlockPool().Stop()
 		n.blockChain.Stop()
@@ -374,6 +440,16 @@ func (n *Neblet) Nvm() core.NVM {
 	return n.nvm
 }
 
+// Nr return nebulas rank service
+func (n *Neblet) Nr() core.NR {
+	return n.nr
+}
+
+// Dip return developer incentive protocol
+func (n *Neblet) Dip() core.Dip {
+	return n.dip
+}
+
 // TryStartProfiling try start pprof
 func (n *Neblet) TryStartProfiling() {
 	if n.config.App == nil {
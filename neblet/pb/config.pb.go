@@ -165,31 +165,47 @@ func (m *NetworkConfig) GetNetworkId() uint32 {
 
 type ChainConfig struct {
 	// ChainID.
-	ChainId uint32 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id"`
+	ChainId uint32 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 	// genesis conf file path
-	Genesis string `protobuf:"bytes,2,opt,name=genesis,proto3" json:"genesis"`
+	Genesis string `protobuf:"bytes,2,opt,name=genesis,proto3" json:"genesis,omitempty"`
 	// Data dir.
-	Datadir string `protobuf:"bytes,11,opt,name=datadir,proto3" json:"datadir"`
+	Datadir string `protobuf:"bytes,11,opt,name=datadir,proto3" json:"datadir,omitempty"`
 	// Key dir.
-	Keydir string `protobuf:"bytes,12,opt,name=keydir,proto3" json:"keydir"`
+	Keydir string `protobuf:"bytes,12,opt,name=keydir,proto3" json:"keydir,omitempty"`
 	// Start mine at launch
-	StartMine bool `protobuf:"varint,20,opt,name=start_mine,json=startMine,proto3" json:"start_mine"`
+	StartMine bool `protobuf:"varint,20,opt,name=start_mine,json=startMine,proto3" json:"start_mine,omitempty"`
 	// Coinbase.
-	Coinbase string `protobuf:"bytes,21,opt,name=coinbase,proto3" json:"coinbase"`
+	Coinbase string `protobuf:"bytes,21,opt,name=coinbase,proto3" json:"coinbase,omitempty"`
 	// Miner.
-	Miner string `protobuf:"bytes,22,opt,name=miner,proto3" json:"miner"`
+	Miner string `protobuf:"bytes,22,opt,name=miner,proto3" json:"miner,omitempty"`
 	// Passphrase.
-	Passphrase string `protobuf:"bytes,23,opt,name=passphrase,proto3" json:"passphrase"`
+	Passphrase string `protobuf:"bytes,23,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
 	// Enable remote sign server
-	EnableRemoteSignServer bool `protobuf:"varint,24,opt,name=enable_remote_sign_server,json=enableRemoteSignServer,proto3" json:"enable_remote_sign_server"`
+	EnableRemoteSignServer bool `protobuf:"varint,24,opt,name=enable_remote_sign_server,json=enableRemoteSignServer,proto3" json:"enable_remote_sign_server,omitempty"`
 	// Remote sign server
-	RemoteSignServer string `protobuf:"bytes,25,opt,name=remote_sign_server,json=remoteSignServer,proto3" json:"remote_sign_server"`
+	RemoteSignServer string `protobuf:"bytes,25,opt,name=remote_sign_server,json=remoteSignServer,proto3" json:"remote_sign_server,omitempty"`
 	// Lowest GasPrice.
-	GasPrice string `protobuf:"bytes,26,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price"`
+	GasPrice string `protobuf:"bytes,26,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
 	// Max GasLimit.
-	GasLimit string `protobuf:"bytes,27,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit"`
+	GasLimit string `protobuf:"bytes,27,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
 	// Supported signature cipher list. ["ECC_SECP256K1"]
-	SignatureCiphers []string `protobuf:"bytes,28,rep,name=signature_ciphers,json=signatureCiphers" json:"signature_ciphers"`
+	SignatureCiphers []string `protobuf:"bytes,28,rep,name=signature_ciphers,json=signatureCiphers" json:"signature_ciphers,omitempty"`
+	// Consensus engine, "dpos" or "pod". Default is "dpos".
+	Consensus string `protobuf:"bytes,29,opt,name=consensus,proto3" json:"consensus,omitempty"`
+	// Number of blocks before LIB whose states are kept by online pruning. 0 disables online pruning.
+	PruneKeepBlocks uint64 `protobuf:"varint,30,opt,name=prune_keep_blocks,json=pruneKeepBlocks,proto3" json:"prune_keep_blocks,omitempty"`
+	// Sync the states at a recent LIB from peers instead of replaying all blocks when the chain is empty.
+	EnableStateSync bool `protobuf:"varint,31,opt,name=enable_state_sync,json=enableStateSync,proto3" json:"enable_state_sync,omitempty"`
+	// Index the transactions on canonical chain by their from and to addresses.
+	EnableAddressIndex bool `protobuf:"varint,32,opt,name=enable_address_index,json=enableAddressIndex,proto3" json:"enable_address_index,omitempty"`
+	// Seconds a pending transaction stays in pool before dropped. 0 means never.
+	TxPoolTtl uint64 `protobuf:"varint,33,opt,name=tx_pool_ttl,json=txPoolTtl,proto3" json:"tx_pool_ttl,omitempty"`
+	// Max pending transactions of a sender in pool. 0 means unlimited.
+	TxPoolSenderLimit uint32 `protobuf:"varint,34,opt,name=tx_pool_sender_limit,json=txPoolSenderLimit,proto3" json:"tx_pool_sender_limit,omitempty"`
+	// Journal file of local transactions, relative to datadir if not absolute. Empty disables journaling.
+	TxPoolJournal string `protobuf:"bytes,35,opt,name=tx_pool_journal,json=txPoolJournal,proto3" json:"tx_pool_journal,omitempty"`
+	// Count of recent blocks sampled to suggest gas prices. 0 means 20.
+	GasPriceOracleBlocks uint32 `protobuf:"varint,36,opt,name=gas_price_oracle_blocks,json=gasPriceOracleBlocks,proto3" json:"gas_price_oracle_blocks,omitempty"`
 }
 
 func (m *ChainConfig) Reset()                    { *m = ChainConfig{} }
@@ -288,6 +304,62 @@ func (m *ChainConfig) GetSignatureCiphers() []string {
 	return nil
 }
 
+func (m *ChainConfig) GetConsensus() string {
+	if m != nil {
+		return m.Consensus
+	}
+	return ""
+}
+
+func (m *ChainConfig) GetPruneKeepBlocks() uint64 {
+	if m != nil {
+		return m.PruneKeepBlocks
+	}
+	return 0
+}
+
+func (m *ChainConfig) GetEnableStateSync() bool {
+	if m != nil {
+		return m.EnableStateSync
+	}
+	return false
+}
+
+func (m *ChainConfig) GetEnableAddressIndex() bool {
+	if m != nil {
+		return m.EnableAddressIndex
+	}
+	return false
+}
+
+func (m *ChainConfig) GetTxPoolTtl() uint64 {
+	if m != nil {
+		return m.TxPoolTtl
+	}
+	return 0
+}
+
+func (m *ChainConfig) GetTxPoolSenderLimit() uint32 {
+	if m != nil {
+		return m.TxPoolSenderLimit
+	}
+	return 0
+}
+
+func (m *ChainConfig) GetTxPoolJournal() string {
+	if m != nil {
+		return m.TxPoolJournal
+	}
+	return ""
+}
+
+func (m *ChainConfig) GetGasPriceOracleBlocks() uint32 {
+	if m != nil {
+		return m.GasPriceOracleBlocks
+	}
+	return 0
+}
+
 type RPCConfig struct {
 	// RPC listen addresses.
 	RpcListen []string `protobuf:"bytes,1,rep,name=rpc_listen,json=rpcListen" json:"rpc_listen"`
@@ -577,63 +649,74 @@ func init() {
 func init() { proto.RegisterFile("config.proto", fileDescriptorConfig) }
 
 var fileDescriptorConfig = []byte{
-	// 926 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x55, 0xc1, 0x6e, 0xe3, 0x36,
-	0x13, 0xfe, 0xed, 0x24, 0x8e, 0x35, 0x4e, 0xb2, 0x5e, 0x6e, 0x36, 0x61, 0x36, 0x7f, 0x77, 0x53,
-	0x03, 0x01, 0x0c, 0x6c, 0x61, 0xa0, 0x69, 0x2f, 0x3d, 0xf4, 0xb0, 0x30, 0x50, 0x20, 0x48, 0x52,
-	0x04, 0x4a, 0x7b, 0x16, 0x64, 0x69, 0x2c, 0x13, 0x91, 0x45, 0x82, 0xa4, 0xb3, 0x1b, 0xf4, 0xd2,
-	0x17, 0xe8, 0xe3, 0xf4, 0x59, 0xfa, 0x34, 0x05, 0x8a, 0x19, 0x51, 0x96, 0x63, 0xec, 0x8d, 0xf3,
-	0x7d, 0x1f, 0x67, 0xc8, 0xe1, 0xa7, 0x11, 0x1c, 0x64, 0xba, 0x9a, 0xab, 0x62, 0x62, 0xac, 0xf6,
-	0x5a, 0xf4, 0x2b, 0x9c, 0x95, 0xe8, 0xcd, 0x6c, 0xf4, 0x57, 0x17, 0x7a, 0x53, 0xa6, 0xc4, 0xf7,
-	0xb0, 0x5f, 0xa1, 0xff, 0xac, 0xed, 0xa3, 0xec, 0x5c, 0x74, 0xc6, 0x83, 0xab, 0xd3, 0x49, 0x23,
-	0x9b, 0xfc, 0x5a, 0x13, 0xb5, 0x32, 0x6e, 0x74, 0xe2, 0x23, 0xec, 0x65, 0x8b, 0x54, 0x55, 0xb2,
-	0xcb, 0x1b, 0xde, 0xb6, 0x1b, 0xa6, 0x04, 0x07, 0x79, 0xad, 0x11, 0x97, 0xb0, 0x63, 0x4d, 0x26,
-	0x77, 0x58, 0xfa, 0xa6, 0x95, 0xc6, 0xf7, 0xd3, 0x20, 0x24, 0x9e, 0x72, 0x3a, 0x9f, 0x7a, 0x27,
-	0xf3, 0xed, 0x9c, 0x0f, 0x04, 0x37, 0x39, 0x59, 0x23, 0xc6, 0xb0, 0xbb, 0x54, 0x2e, 0x93, 0xc8,
-	0xda, 0xe3, 0x56, 0x7b, 0xa7, 0x5c, 0x16, 0xa4, 0xac, 0xa0, 0xea, 0xa9, 0x31, 0x72, 0xbe, 0x5d,
-	0xfd, 0x93, 0x31, 0x4d, 0xf5, 0xd4, 0x98, 0xd1, 0x1f, 0x70, 0xf8, 0xe2, 0xae, 0x42, 0xc0, 0xae,
-	0x43, 0xcc, 0x65, 0xe7, 0x62, 0x67, 0x1c, 0xc5, 0xbc, 0x16, 0x27, 0xd0, 0x2b, 0x95, 0xf3, 0x48,
-	0xf7, 0x26, 0x34, 0x44, 0xe2, 0x03, 0x0c, 0x8c, 0x55, 0x4f, 0xa9, 0xc7, 0xe4, 0x11, 0x9f, 0xf9,
-	0xa6, 0x51, 0x0c, 0x01, 0xba, 0xc1, 0x67, 0xf1, 0x0d, 0x40, 0x68, 0x5d, 0xa2, 0x72, 0xb9, 0x7b,
-	0xd1, 0x19, 0x1f, 0xc6, 0x51, 0x40, 0xae, 0xf3, 0xd1, 0xdf, 0x3b, 0x30, 0xd8, 0x68, 0x9c, 0x38,
-	0x83, 0x3e, 0xb7, 0x8e, 0xc4, 0x1d, 0x16, 0xef, 0x73, 0x7c, 0x9d, 0x0b, 0x09, 0xfb, 0x05, 0x56,
-	0xe8, 0x94, 0xe3, 0xde, 0x47, 0x71, 0x13, 0x12, 0x93, 0xa7, 0x3e, 0xcd, 0x95, 0x95, 0x83, 0x9a,
-	0x09, 0x21, 0x1d, 0xfb, 0x11, 0x9f, 0x89, 0x38, 0x60, 0x22, 0x44, 0x74, 0x2a, 0xe7, 0x53, 0xeb,
-	0x93, 0xa5, 0xaa, 0x50, 0x1e, 0x5f, 0x74, 0xc6, 0xfd, 0x38, 0x62, 0xe4, 0x4e, 0x55, 0x28, 0xde,
-	0x41, 0x3f, 0xd3, 0xaa, 0x9a, 0xa5, 0x0e, 0xe5, 0x5b, 0xde, 0xb8, 0x8e, 0xc5, 0x31, 0xec, 0xd1,
-	0x26, 0x2b, 0x4f, 0x98, 0xa8, 0x03, 0xf1, 0x1e, 0xc0, 0xa4, 0xce, 0x99, 0x85, 0xa5, 0x3d, 0xa7,
-	0xa1, 0x0d, 0x6b, 0x44, 0xfc, 0x04, 0x67, 0x58, 0xa5, 0xb3, 0x12, 0x13, 0x8b, 0x4b, 0xed, 0x31,
-	0x71, 0xaa, 0xa8, 0x12, 0x87, 0xf6, 0x09, 0xad, 0x94, 0x5c, 0xff, 0xa4, 0x16, 0xc4, 0xcc, 0x3f,
-	0xa8, 0xa2, 0x7a, 0x60, 0x56, 0x7c, 0x07, 0xe2, 0x2b, 0x7b, 0xce, 0xb8, 0xc4, 0xd0, 0x6e, 0xab,
-	0xcf, 0x21, 0x2a, 0x52, 0x97, 0x18, 0xab, 0x32, 0x94, 0xef, 0xea, 0xb3, 0x17, 0xa9, 0xbb, 0xa7,
-	0xb8, 0x21, 0x4b, 0xb5, 0x54, 0x5e, 0x9e, 0xaf, 0xc9, 0x5b, 0x8a, 0xc5, 0x47, 0x78, 0x4d, 0x05,
-	0x52, 0xbf, 0xb2, 0x98, 0x64, 0xca, 0x2c, 0xd0, 0x3a, 0xf9, 0x7f, 0x7e, 0xed, 0xe1, 0x9a, 0x98,
-	0xd6, 0xf8, 0xe8, 0x9f, 0x0e, 0x44, 0x6b, 0x17, 0x53, 0x3b, 0xad, 0xc9, 0x92, 0xe0, 0x90, 0xda,
-	0x37, 0x91, 0x35, 0xd9, 0xed, 0xda, 0x24, 0x0b, 0xef, 0x4d, 0xf2, 0xc2, 0x41, 0x40, 0xd0, 0x96,
-	0x60, 0xa9, 0xf3, 0x55, 0x89, 0x72, 0xa7, 0x15, 0xdc, 0x31, 0x42, 0x67, 0xcb, 0x74, 0x55, 0x61,
-	0xe6, 0x95, 0xae, 0xea, 0xf3, 0x3b, 0x36, 0xd3, 0x5e, 0x3c, 0x6c, 0x09, 0xbe, 0x87, 0xdb, 0x28,
-	0xc7, 0xb2, 0x3d, 0x96, 0x85, 0x72, 0x2c, 0x38, 0x87, 0x88, 0x05, 0x99, 0xb6, 0x4e, 0xf6, 0xb8,
-	0x58, 0x9f, 0x80, 0xa9, 0xb6, 0x6e, 0xf4, 0x6f, 0x07, 0xa2, 0xf5, 0x17, 0x42, 0xd2, 0x52, 0x17,
-	0x49, 0x89, 0x4f, 0x58, 0xb2, 0x21, 0xa3, 0xb8, 0x5f, 0xea, 0xe2, 0x96, 0x62, 0x32, 0x2b, 0x91,
-	0x73, 0x55, 0x62, 0x63, 0xc9, 0x52, 0x17, 0xbf, 0xa8, 0x12, 0xc5, 0x29, 0xd0, 0x32, 0x49, 0x0b,
-	0xe4, 0x6f, 0xe2, 0x30, 0xee, 0x95, 0xba, 0xf8, 0x54, 0xa0, 0x98, 0xc0, 0x9b, 0x60, 0x84, 0xcc,
-	0xa6, 0x6e, 0x91, 0x58, 0x34, 0xda, 0x7a, 0xbe, 0x4b, 0x3f, 0x7e, 0x5d, 0x53, 0x53, 0x62, 0x62,
-	0x26, 0xc4, 0x18, 0x86, 0x9b, 0xc2, 0x64, 0x65, 0x4b, 0xbe, 0x51, 0x14, 0x1f, 0x65, 0xad, 0xec,
-	0x77, 0x5b, 0xd2, 0x14, 0x31, 0xc6, 0xea, 0xb9, 0xec, 0x6d, 0x4f, 0x91, 0x7b, 0x82, 0x9b, 0x29,
-	0xc2, 0x1a, 0xfa, 0x64, 0x9e, 0xd0, 0x3a, 0xa5, 0x2b, 0x1e, 0x3a, 0x51, 0xdc, 0x84, 0xa3, 0x0a,
-	0x06, 0x1b, 0xfa, 0xed, 0xb7, 0xab, 0x5b, 0xb0, 0xf9, 0x76, 0xef, 0x01, 0x32, 0xb3, 0xa2, 0x1d,
-	0x6d, 0x1b, 0x36, 0x10, 0xe2, 0x97, 0xb8, 0x6c, 0xf8, 0x30, 0x20, 0x5a, 0x64, 0x74, 0x03, 0xd0,
-	0x4e, 0x2e, 0xf1, 0x33, 0x9c, 0xe7, 0x38, 0x4f, 0x57, 0xa5, 0xa7, 0x79, 0xe2, 0xbc, 0xb6, 0xc8,
-	0xfd, 0x25, 0x43, 0xa2, 0x0d, 0xe5, 0x65, 0x90, 0xdc, 0x04, 0x05, 0x75, 0x7c, 0x4a, 0xfc, 0xe8,
-	0xcf, 0x2e, 0x0c, 0x36, 0x66, 0xa6, 0xb8, 0x84, 0xa3, 0xd0, 0xed, 0x25, 0x7a, 0xab, 0x32, 0xc7,
-	0x19, 0xfa, 0xf1, 0x61, 0x8d, 0xde, 0xd5, 0xa0, 0xb8, 0x87, 0x61, 0xdd, 0x5e, 0x55, 0x15, 0x8d,
-	0x09, 0xc9, 0xa5, 0x47, 0x57, 0x97, 0x5f, 0x9d, 0xc5, 0x93, 0xb8, 0x51, 0xd7, 0xfe, 0x8c, 0x5f,
-	0xd9, 0x97, 0x80, 0xf8, 0x11, 0xfa, 0xaa, 0x9a, 0x97, 0xab, 0x2f, 0xf9, 0x8c, 0x67, 0xd2, 0xe0,
-	0x4a, 0xb6, 0x99, 0xae, 0x03, 0x13, 0x9e, 0x64, 0xad, 0x14, 0xdf, 0xc2, 0x41, 0x38, 0x67, 0xe2,
-	0xd3, 0xc2, 0xc9, 0x03, 0xf6, 0xe6, 0x20, 0x60, 0xbf, 0xa5, 0x85, 0x1b, 0x7d, 0x80, 0x57, 0x5b,
-	0xc5, 0xc5, 0x01, 0xf4, 0x9b, 0x8c, 0xc3, 0xff, 0x8d, 0xbe, 0xc0, 0xd1, 0xcb, 0xfc, 0x34, 0xcf,
-	0x17, 0xda, 0xf9, 0xd0, 0x3c, 0x5e, 0x13, 0xc6, 0xbe, 0xeb, 0xb2, 0x39, 0x79, 0x2d, 0x8e, 0xa0,
-	0x9b, 0xcf, 0xc2, 0x0b, 0x75, 0xf3, 0x19, 0x69, 0x56, 0x0e, 0x2d, 0x7b, 0x33, 0x8a, 0x79, 0x4d,
-	0x93, 0x91, 0xa6, 0xda, 0x67, 0x6d, 0xf3, 0x60, 0xc3, 0x75, 0x3c, 0xeb, 0xf1, 0x9f, 0xf6, 0x87,
-	0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x24, 0xd8, 0xd3, 0xa0, 0x79, 0x07, 0x00, 0x00,
+	// 1099 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x56, 0x4d, 0x6f, 0x1b, 0x37,
+	0x10, 0xad, 0xfc, 0xa1, 0x68, 0x47, 0xfe, 0x0a, 0xa3, 0xc4, 0x4c, 0x9c, 0x38, 0x8a, 0xda, 0x14,
+	0x42, 0x53, 0xb8, 0x6d, 0xda, 0x1e, 0x7a, 0xe8, 0x21, 0x15, 0x50, 0xc0, 0xb5, 0xdd, 0x1a, 0xeb,
+	0xf4, 0xbc, 0x58, 0xed, 0x8e, 0x56, 0xac, 0x29, 0x92, 0x20, 0x29, 0xc7, 0x46, 0x2f, 0xfd, 0x03,
+	0xf9, 0x7d, 0xfd, 0x35, 0x05, 0x0a, 0xce, 0x72, 0x25, 0x59, 0xc8, 0x6d, 0xe7, 0xbd, 0x47, 0x0e,
+	0xf9, 0x38, 0x1c, 0x2e, 0xec, 0x14, 0x5a, 0x4d, 0x44, 0x75, 0x62, 0xac, 0xf6, 0x9a, 0x75, 0x14,
+	0x8e, 0x25, 0x7a, 0x33, 0x1e, 0x7c, 0xdc, 0x80, 0xf6, 0x88, 0x28, 0xf6, 0x1d, 0x3c, 0x50, 0xe8,
+	0x3f, 0x68, 0x7b, 0xcd, 0x5b, 0xfd, 0xd6, 0xb0, 0xfb, 0xf6, 0xf0, 0xa4, 0x91, 0x9d, 0xfc, 0x5e,
+	0x13, 0xb5, 0x32, 0x6d, 0x74, 0xec, 0x0d, 0x6c, 0x17, 0xd3, 0x5c, 0x28, 0xbe, 0x41, 0x03, 0x1e,
+	0x2f, 0x07, 0x8c, 0x02, 0x1c, 0xe5, 0xb5, 0x86, 0xbd, 0x86, 0x4d, 0x6b, 0x0a, 0xbe, 0x49, 0xd2,
+	0x47, 0x4b, 0x69, 0x7a, 0x39, 0x8a, 0xc2, 0xc0, 0x87, 0x39, 0x9d, 0xcf, 0xbd, 0xe3, 0xe5, 0xfa,
+	0x9c, 0x57, 0x01, 0x6e, 0xe6, 0x24, 0x0d, 0x1b, 0xc2, 0xd6, 0x4c, 0xb8, 0x82, 0x23, 0x69, 0x7b,
+	0x4b, 0xed, 0x85, 0x70, 0x45, 0x94, 0x92, 0x22, 0x64, 0xcf, 0x8d, 0xe1, 0x93, 0xf5, 0xec, 0xef,
+	0x8c, 0x69, 0xb2, 0xe7, 0xc6, 0x0c, 0xfe, 0x86, 0xdd, 0x7b, 0x7b, 0x65, 0x0c, 0xb6, 0x1c, 0x62,
+	0xc9, 0x5b, 0xfd, 0xcd, 0x61, 0x92, 0xd2, 0x37, 0x7b, 0x02, 0x6d, 0x29, 0x9c, 0xc7, 0xb0, 0xef,
+	0x80, 0xc6, 0x88, 0xbd, 0x84, 0xae, 0xb1, 0xe2, 0x26, 0xf7, 0x98, 0x5d, 0xe3, 0x1d, 0xed, 0x34,
+	0x49, 0x21, 0x42, 0x67, 0x78, 0xc7, 0x5e, 0x00, 0x44, 0xeb, 0x32, 0x51, 0xf2, 0xad, 0x7e, 0x6b,
+	0xb8, 0x9b, 0x26, 0x11, 0x39, 0x2d, 0x07, 0x1f, 0xdb, 0xd0, 0x5d, 0x31, 0x8e, 0x3d, 0x85, 0x0e,
+	0x59, 0x17, 0xc4, 0x2d, 0x12, 0x3f, 0xa0, 0xf8, 0xb4, 0x64, 0x1c, 0x1e, 0x54, 0xa8, 0xd0, 0x09,
+	0x47, 0xde, 0x27, 0x69, 0x13, 0x06, 0xa6, 0xcc, 0x7d, 0x5e, 0x0a, 0xcb, 0xbb, 0x35, 0x13, 0xc3,
+	0xb0, 0xec, 0x6b, 0xbc, 0x0b, 0xc4, 0x0e, 0x11, 0x31, 0x0a, 0xab, 0x72, 0x3e, 0xb7, 0x3e, 0x9b,
+	0x09, 0x85, 0xbc, 0xd7, 0x6f, 0x0d, 0x3b, 0x69, 0x42, 0xc8, 0x85, 0x50, 0xc8, 0x9e, 0x41, 0xa7,
+	0xd0, 0x42, 0x8d, 0x73, 0x87, 0xfc, 0x31, 0x0d, 0x5c, 0xc4, 0xac, 0x07, 0xdb, 0x61, 0x90, 0xe5,
+	0x4f, 0x88, 0xa8, 0x03, 0x76, 0x0c, 0x60, 0x72, 0xe7, 0xcc, 0xd4, 0x86, 0x31, 0x87, 0xd1, 0x86,
+	0x05, 0xc2, 0x7e, 0x82, 0xa7, 0xa8, 0xf2, 0xb1, 0xc4, 0xcc, 0xe2, 0x4c, 0x7b, 0xcc, 0x9c, 0xa8,
+	0x54, 0xe6, 0xd0, 0xde, 0xa0, 0xe5, 0x9c, 0xf2, 0x3f, 0xa9, 0x05, 0x29, 0xf1, 0x57, 0xa2, 0x52,
+	0x57, 0xc4, 0xb2, 0xaf, 0x81, 0x7d, 0x62, 0xcc, 0x53, 0x4a, 0x71, 0x60, 0xd7, 0xd5, 0x47, 0x90,
+	0x54, 0xb9, 0xcb, 0x8c, 0x15, 0x05, 0xf2, 0x67, 0xf5, 0xda, 0xab, 0xdc, 0x5d, 0x86, 0xb8, 0x21,
+	0xa5, 0x98, 0x09, 0xcf, 0x8f, 0x16, 0xe4, 0x79, 0x88, 0xd9, 0x1b, 0x78, 0x18, 0x12, 0xe4, 0x7e,
+	0x6e, 0x31, 0x2b, 0x84, 0x99, 0xa2, 0x75, 0xfc, 0x39, 0x9d, 0xf6, 0xc1, 0x82, 0x18, 0xd5, 0x38,
+	0x7b, 0x0e, 0x49, 0xa1, 0x95, 0x43, 0xe5, 0xe6, 0x8e, 0xbf, 0xa0, 0x99, 0x96, 0x00, 0xfb, 0x0a,
+	0x1e, 0x1a, 0x3b, 0x57, 0xa1, 0x26, 0xd0, 0x64, 0x63, 0xa9, 0x8b, 0x6b, 0xc7, 0x8f, 0xfb, 0xad,
+	0xe1, 0x56, 0xba, 0x4f, 0xc4, 0x19, 0xa2, 0xf9, 0x85, 0xe0, 0xa0, 0x8d, 0xce, 0x84, 0xfa, 0xc6,
+	0xcc, 0xdd, 0xa9, 0x82, 0xbf, 0x24, 0x47, 0xf6, 0x6b, 0x22, 0x5c, 0x02, 0xbc, 0xba, 0x53, 0x05,
+	0xfb, 0x16, 0x7a, 0x51, 0x9b, 0x97, 0xa5, 0x45, 0xe7, 0x32, 0xa1, 0x4a, 0xbc, 0xe5, 0x7d, 0x92,
+	0xb3, 0x9a, 0x7b, 0x57, 0x53, 0xa7, 0x81, 0x61, 0xc7, 0xd0, 0xf5, 0xb7, 0x99, 0xd1, 0x5a, 0x66,
+	0xde, 0x4b, 0xfe, 0x8a, 0xd6, 0x90, 0xf8, 0xdb, 0x4b, 0xad, 0xe5, 0x7b, 0x2f, 0xd9, 0x37, 0xd0,
+	0x6b, 0x78, 0x87, 0xaa, 0x44, 0x1b, 0xcd, 0x19, 0x50, 0xed, 0x3d, 0xac, 0x85, 0x57, 0xc4, 0xd4,
+	0x2e, 0x7d, 0x09, 0xfb, 0xcd, 0x80, 0xbf, 0xf4, 0xdc, 0xaa, 0x5c, 0xf2, 0xcf, 0x69, 0xfb, 0xbb,
+	0xb5, 0xf6, 0xb7, 0x1a, 0x64, 0x3f, 0xc2, 0xe1, 0xe2, 0x1c, 0x32, 0x6d, 0xf3, 0x42, 0x62, 0x63,
+	0xc4, 0x17, 0x34, 0x77, 0xaf, 0x39, 0x95, 0x3f, 0x88, 0xac, 0xdd, 0x18, 0xfc, 0xdb, 0x82, 0x64,
+	0xd1, 0x1d, 0x42, 0x99, 0x5a, 0x53, 0x64, 0xf1, 0xe6, 0xd5, 0xf7, 0x31, 0xb1, 0xa6, 0x38, 0x5f,
+	0x5c, 0xbe, 0xa9, 0xf7, 0x26, 0xbb, 0x77, 0x33, 0x21, 0x40, 0x6b, 0x82, 0x99, 0x2e, 0xe7, 0x12,
+	0xf9, 0xe6, 0x52, 0x70, 0x41, 0x48, 0x38, 0xf3, 0x42, 0x2b, 0x85, 0x85, 0x17, 0x5a, 0xd5, 0x5b,
+	0x77, 0x74, 0x49, 0xb7, 0xd3, 0x83, 0x25, 0x41, 0x3b, 0x77, 0x2b, 0xe9, 0x48, 0xb6, 0x4d, 0xb2,
+	0x98, 0x8e, 0x04, 0x47, 0x90, 0x90, 0xa0, 0xd0, 0xd6, 0xf1, 0x36, 0x25, 0xeb, 0x04, 0x60, 0xa4,
+	0xad, 0x1b, 0xfc, 0xd7, 0x82, 0x64, 0xd1, 0x79, 0x82, 0x54, 0xea, 0x2a, 0x93, 0x78, 0x83, 0x92,
+	0x2e, 0x7a, 0x92, 0x76, 0xa4, 0xae, 0xce, 0x43, 0x1c, 0x9a, 0x40, 0x20, 0x27, 0x42, 0x62, 0x73,
+	0xd5, 0xa5, 0xae, 0x7e, 0x15, 0x12, 0xd9, 0x21, 0x84, 0xcf, 0x2c, 0xaf, 0x90, 0x7a, 0xcd, 0x6e,
+	0xda, 0x96, 0xba, 0x7a, 0x57, 0x21, 0x3b, 0x81, 0x47, 0xb1, 0x34, 0x0a, 0x9b, 0xbb, 0x69, 0x66,
+	0xd1, 0x68, 0xeb, 0x69, 0x2f, 0x9d, 0x34, 0x56, 0xd8, 0x28, 0x30, 0x29, 0x11, 0x6c, 0x08, 0x07,
+	0xab, 0xc2, 0x6c, 0x6e, 0x25, 0xed, 0x28, 0x49, 0xf7, 0x8a, 0xa5, 0xec, 0x4f, 0x2b, 0x43, 0x77,
+	0x36, 0xc6, 0xea, 0x09, 0x6f, 0xaf, 0x77, 0xe7, 0xcb, 0x00, 0x37, 0xdd, 0x99, 0x34, 0xa1, 0x15,
+	0xdd, 0xa0, 0x75, 0x42, 0x2b, 0x6a, 0xe6, 0x49, 0xda, 0x84, 0x03, 0x05, 0xdd, 0x15, 0xfd, 0xfa,
+	0xd9, 0xd5, 0x16, 0xac, 0x9e, 0xdd, 0x31, 0x40, 0x61, 0xe6, 0x61, 0xc4, 0xd2, 0x86, 0x15, 0x24,
+	0xf0, 0x33, 0x9c, 0x35, 0x7c, 0x6c, 0xbc, 0x4b, 0x64, 0x70, 0x06, 0xb0, 0x7c, 0x11, 0xd8, 0xcf,
+	0x70, 0x54, 0xe2, 0x24, 0x9f, 0x4b, 0x1f, 0xfa, 0xb4, 0xf3, 0xda, 0x22, 0xf9, 0x1b, 0x2e, 0x3a,
+	0xda, 0x98, 0x9e, 0x47, 0xc9, 0x59, 0x54, 0x04, 0xc7, 0x47, 0x81, 0x1f, 0xfc, 0xb3, 0x01, 0xdd,
+	0x95, 0xb7, 0x88, 0xbd, 0x86, 0xbd, 0xe8, 0xf6, 0x0c, 0xbd, 0x15, 0x85, 0xa3, 0x19, 0x3a, 0xe9,
+	0x6e, 0x8d, 0x5e, 0xd4, 0x20, 0xbb, 0x84, 0x83, 0xda, 0x5e, 0xa1, 0xaa, 0xa6, 0x08, 0x43, 0x95,
+	0xee, 0xbd, 0x7d, 0xfd, 0xc9, 0x37, 0xee, 0x24, 0x6d, 0xd4, 0x75, 0x7d, 0xa6, 0xfb, 0xf6, 0x3e,
+	0xc0, 0x7e, 0x80, 0x8e, 0x50, 0x13, 0x39, 0xbf, 0x2d, 0xc7, 0xd4, 0xeb, 0xbb, 0x6f, 0xf9, 0x72,
+	0xa6, 0xd3, 0xc8, 0xc4, 0x23, 0x59, 0x28, 0xd9, 0x2b, 0xd8, 0x89, 0xeb, 0xcc, 0x7c, 0x5e, 0x39,
+	0xbe, 0x43, 0xb5, 0xd9, 0x8d, 0xd8, 0xfb, 0xbc, 0x72, 0x83, 0x97, 0xb0, 0xbf, 0x96, 0x9c, 0xed,
+	0x40, 0xa7, 0x99, 0xf1, 0xe0, 0xb3, 0xc1, 0x2d, 0xec, 0xdd, 0x9f, 0x3f, 0xbc, 0x93, 0x53, 0xed,
+	0x7c, 0x34, 0x8f, 0xbe, 0x03, 0x46, 0x75, 0xb7, 0x41, 0xc5, 0x49, 0xdf, 0x6c, 0x0f, 0x36, 0xca,
+	0x71, 0x3c, 0xa1, 0x8d, 0x72, 0x1c, 0x34, 0x73, 0x87, 0x96, 0x6a, 0x33, 0x49, 0xe9, 0x3b, 0xbc,
+	0x38, 0xe1, 0xb5, 0xf8, 0xa0, 0x6d, 0x19, 0xcb, 0x70, 0x11, 0x8f, 0xdb, 0xf4, 0x07, 0xf3, 0xfd,
+	0xff, 0x03, 0x00, 0x9c, 0x75, 0x2a, 0x7c, 0xd1, 0x08, 0x00, 0x00,
 }
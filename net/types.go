@@ -42,6 +42,14 @@ const (
 	ChainChunkData = "chunkdata"
 )
 
+// State Sync Message Type
+const (
+	ChainGetStateRoot = "getstateroot"
+	ChainStateRoot    = "stateroot"
+	ChainGetTrieNodes = "gettrienodes"
+	ChainTrieNodes    = "trienodes"
+)
+
 // Sync Errors
 var (
 	ErrPeersIsNotEnough = errors.New("peers is not enough")
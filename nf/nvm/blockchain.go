@@ -160,6 +160,7 @@ func TransferFunc(handler unsafe.Pointer, to *C.char, v *C.char, gasCnt *C.size_
 	if amount.Cmp(util.NewUint128()) > 0 {
 		err = engine.ctx.contract.SubBalance(amount)
 		if err != nil {
+			engine.traceStep(core.TraceStepTransfer, addr.String(), amount.String(), err)
 			logging.VLog().WithFields(logrus.Fields{
 				"handler": uint64(uintptr(handler)),
 				"key":     C.GoString(to),
@@ -170,6 +171,7 @@ func TransferFunc(handler unsafe.Pointer, to *C.char, v *C.char, gasCnt *C.size_
 
 		err = toAcc.AddBalance(amount)
 		if err != nil {
+			engine.traceStep(core.TraceStepTransfer, addr.String(), amount.String(), err)
 			logging.VLog().WithFields(logrus.Fields{
 				"account": toAcc,
 				"amount":  amount,
@@ -180,6 +182,7 @@ func TransferFunc(handler unsafe.Pointer, to *C.char, v *C.char, gasCnt *C.size_
 		}
 	}
 
+	engine.traceStep(core.TraceStepTransfer, addr.String(), amount.String(), nil)
 	return TransferFuncSuccess
 }
 
@@ -195,3 +198,32 @@ func VerifyAddressFunc(handler unsafe.Pointer, address *C.char, gasCnt *C.size_t
 	}
 	return int(addr.Type())
 }
+
+// ContractCallFunc calls a function of another contract, the result or the error message is returned in result.
+//export ContractCallFunc
+func ContractCallFunc(handler unsafe.Pointer, address *C.char, funcName *C.char, args *C.char, v *C.char, result **C.char, gasCnt *C.size_t) int {
+	engine, _ := getEngineByStorageHandler(uint64(uintptr(handler)))
+	if engine == nil || engine.ctx.block == nil {
+		logging.VLog().Error("get engine failed!")
+		*result = C.CString(ErrEngineNotStart.Error())
+		return 1
+	}
+
+	ret, instructions, err := engine.callContract(C.GoString(address), C.GoString(funcName), C.GoString(args), C.GoString(v))
+
+	// calculate Gas.
+	*gasCnt = C.size_t(ContractCallBaseGas + instructions)
+
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"handler":  uint64(uintptr(handler)),
+			"address":  C.GoString(address),
+			"function": C.GoString(funcName),
+			"err":      err,
+		}).Debug("ContractCallFunc call contract failed.")
+		*result = C.CString(err.Error())
+		return 1
+	}
+	*result = C.CString(ret)
+	return 0
+}
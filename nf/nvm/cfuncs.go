@@ -37,6 +37,7 @@ char *GetTxByHashFunc(void *handler, const char *hash, size_t *gasCnt);
 char *GetAccountStateFunc(void *handler, const char *address, size_t *gasCnt);
 int TransferFunc(void *handler, const char *to, const char *value, size_t *gasCnt);
 int VerifyAddressFunc(void *handler, const char *address, size_t *gasCnt);
+int ContractCallFunc(void *handler, const char *address, const char *funcName, const char *args, const char *value, char **result, size_t *gasCnt);
 
 // event.
 void EventTriggerFunc(void *handler, const char *topic, const char *data, size_t *gasCnt);
@@ -72,6 +73,9 @@ int TransferFunc_cgo(void *handler, const char *to, const char *value, size_t *g
 int VerifyAddressFunc_cgo(void *handler, const char *address, size_t *gasCnt) {
 	return VerifyAddressFunc(handler, address, gasCnt);
 };
+int ContractCallFunc_cgo(void *handler, const char *address, const char *funcName, const char *args, const char *value, char **result, size_t *gasCnt) {
+	return ContractCallFunc(handler, address, funcName, args, value, result, gasCnt);
+};
 
 void EventTriggerFunc_cgo(void *handler, const char *topic, const char *data, size_t *gasCnt) {
 	EventTriggerFunc(handler, topic, data, gasCnt);
@@ -0,0 +1,166 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nvm
+
+import (
+	"fmt"
+
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// contractCallTransaction is the transaction seen by a contract called by another contract,
+// it keeps the hash, nonce and gas of the original transaction.
+type contractCallTransaction struct {
+	Transaction
+	from  *core.Address
+	to    *core.Address
+	value *util.Uint128
+}
+
+func (tx *contractCallTransaction) From() *core.Address {
+	return tx.from
+}
+
+func (tx *contractCallTransaction) To() *core.Address {
+	return tx.to
+}
+
+func (tx *contractCallTransaction) Value() *util.Uint128 {
+	return tx.value
+}
+
+// callContract runs function of the contract at address in a nested engine on a nested world state,
+// the changes and storage refund of the callee are merged only when it succeeds.
+// It returns the result and the instructions executed by the callee.
+func (e *V8Engine) callContract(address, function, args, value string) (string, uint64, error) {
+	if e.callDepth >= MaxContractCallDepth {
+		return "", 0, ErrExceedMaxContractCallDepth
+	}
+
+	addr, err := core.AddressParse(address)
+	if err != nil {
+		return "", 0, err
+	}
+	amount, err := util.NewUint128FromString(value)
+	if err != nil {
+		return "", 0, err
+	}
+	caller, err := core.AddressParseFromBytes(e.ctx.contract.Address())
+	if err != nil {
+		return "", 0, err
+	}
+
+	// the callee shares the instruction budget of the caller.
+	used := e.executedInstructions() + ContractCallBaseGas
+	if e.limitsOfExecutionInstructions <= used {
+		return "", 0, ErrInsufficientGas
+	}
+	limit := e.limitsOfExecutionInstructions - used
+
+	e.nestedCalls++
+	txid := fmt.Sprintf("%s-%d-%d", e.ctx.tx.Hash(), e.callDepth+1, e.nestedCalls)
+	nested, err := e.ctx.state.Prepare(txid)
+	if err != nil {
+		return "", 0, err
+	}
+
+	result, instructions, refund, err := e.runNestedCall(nested, caller, addr, amount, function, args, limit)
+	e.traceStep(core.TraceStepCall, addr.String(), function, err)
+	if err != nil {
+		if err := nested.Reset(); err != nil {
+			logging.VLog().WithFields(logrus.Fields{
+				"txid": txid,
+				"err":  err,
+			}).Error("Failed to reset nested world state.")
+		}
+		nested.Close()
+		return result, instructions, err
+	}
+
+	if _, err := nested.CheckAndUpdate(); err != nil {
+		nested.Close()
+		return "", instructions, err
+	}
+	nested.Close()
+	e.storageRefund += refund
+	return result, instructions, nil
+}
+
+func (e *V8Engine) runNestedCall(nested state.TxWorldState, caller, addr *core.Address, amount *util.Uint128, function, args string, limit uint64) (string, uint64, uint64, error) {
+	contract, err := core.CheckContract(addr, nested)
+	if err != nil {
+		return "", 0, 0, err
+	}
+	if err := core.CheckContractCall(contract, function, args, amount); err != nil {
+		return "", 0, 0, err
+	}
+	source, sourceType, err := core.ContractSource(contract, nested)
+	if err != nil {
+		return "", 0, 0, err
+	}
+
+	if amount.Cmp(util.NewUint128()) > 0 {
+		callerAcc, err := nested.GetOrCreateUserAccount(caller.Bytes())
+		if err != nil {
+			return "", 0, 0, err
+		}
+		if err := callerAcc.SubBalance(amount); err != nil {
+			return "", 0, 0, err
+		}
+		if err := contract.AddBalance(amount); err != nil {
+			return "", 0, 0, err
+		}
+	}
+
+	tx := &contractCallTransaction{
+		Transaction: e.ctx.tx,
+		from:        caller,
+		to:          addr,
+		value:       amount,
+	}
+	ctx, err := NewContext(e.ctx.block, tx, contract, nested)
+	if err != nil {
+		return "", 0, 0, err
+	}
+
+	engine := NewV8Engine(ctx)
+	defer engine.Dispose()
+
+	engine.callDepth = e.callDepth + 1
+	if e.trace != nil {
+		engine.SetTrace(e.trace)
+	}
+	if err := engine.SetExecutionLimits(limit, e.limitsOfTotalMemorySize); err != nil {
+		return "", 0, 0, err
+	}
+
+	varsHash := contract.VarsHash()
+	result, err := engine.Call(source, sourceType, function, args)
+	if err != nil && err == core.ErrExecutionFailed && len(result) > 0 {
+		err = fmt.Errorf("Call: %s", result)
+	}
+	if err == nil {
+		err = core.CheckReadOnlyCall(contract, function, varsHash)
+	}
+	return result, engine.ExecutionInstructions(), engine.ExecutionRefund(), err
+}
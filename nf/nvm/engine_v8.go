@@ -38,6 +38,7 @@ char *GetTxByHashFunc_cgo(void *handler, const char *hash);
 char *GetAccountStateFunc_cgo(void *handler, const char *address);
 int TransferFunc_cgo(void *handler, const char *to, const char *value);
 int VerifyAddressFunc_cgo(void *handler, const char *address);
+int ContractCallFunc_cgo(void *handler, const char *address, const char *funcName, const char *args, const char *value, char **result, size_t *gasCnt);
 
 void EventTriggerFunc_cgo(void *handler, const char *topic, const char *data, size_t *gasCnt);
 
@@ -91,6 +92,12 @@ type V8Engine struct {
 	actualTotalMemorySize                   uint64
 	lcsHandler                              uint64
 	gcsHandler                              uint64
+	trace                                   *core.TransactionTrace
+	traceID                                 int
+	callDepth                               int
+	nestedCalls                             int
+	storageRefund                           uint64
+	executionRefund                         uint64
 }
 
 type sourceModuleItem struct {
@@ -114,7 +121,7 @@ func InitV8Engine() {
 	C.InitializeStorage((C.StorageGetFunc)(unsafe.Pointer(C.StorageGetFunc_cgo)), (C.StoragePutFunc)(unsafe.Pointer(C.StoragePutFunc_cgo)), (C.StorageDelFunc)(unsafe.Pointer(C.StorageDelFunc_cgo)))
 
 	// Blockchain.
-	C.InitializeBlockchain((C.GetTxByHashFunc)(unsafe.Pointer(C.GetTxByHashFunc_cgo)), (C.GetAccountStateFunc)(unsafe.Pointer(C.GetAccountStateFunc_cgo)), (C.TransferFunc)(unsafe.Pointer(C.TransferFunc_cgo)), (C.VerifyAddressFunc)(unsafe.Pointer(C.VerifyAddressFunc_cgo)))
+	C.InitializeBlockchain((C.GetTxByHashFunc)(unsafe.Pointer(C.GetTxByHashFunc_cgo)), (C.GetAccountStateFunc)(unsafe.Pointer(C.GetAccountStateFunc_cgo)), (C.TransferFunc)(unsafe.Pointer(C.TransferFunc_cgo)), (C.VerifyAddressFunc)(unsafe.Pointer(C.VerifyAddressFunc_cgo)), (C.ContractCallFunc)(unsafe.Pointer(C.ContractCallFunc_cgo)))
 
 	// Event.
 	C.InitializeEvent((C.EventTriggerFunc)(unsafe.Pointer(C.EventTriggerFunc_cgo)))
@@ -180,6 +187,8 @@ func (e *V8Engine) Dispose() {
 	delete(engines, e.v8engine)
 	enginesLock.Unlock()
 
+	e.disposeTrace()
+
 	C.DeleteEngine(e.v8engine)
 }
 
@@ -228,6 +237,21 @@ func (e *V8Engine) ExecutionInstructions() uint64 {
 	return e.actualCountOfExecutionInstructions
 }
 
+// ExecutionRefund returns the instructions refunded from the charged gas of a successful execution.
+func (e *V8Engine) ExecutionRefund() uint64 {
+	return e.executionRefund
+}
+
+// storageGasScheduled returns whether the storage gas schedule is applied in the block.
+func (e *V8Engine) storageGasScheduled() bool {
+	return e.ctx.block.Height() >= StorageGasScheduleHeight
+}
+
+// executedInstructions returns the instructions executed so far in the running script.
+func (e *V8Engine) executedInstructions() uint64 {
+	return uint64(e.v8engine.stats.count_of_executed_instructions)
+}
+
 // TranspileTypeScript transpile typescript to javascript and return it.
 func (e *V8Engine) TranspileTypeScript(source string) (string, int, error) {
 	cSource := C.CString(source)
@@ -324,6 +348,16 @@ func (e *V8Engine) RunScriptSource(source string, sourceLineOffset int) (string,
 		e.actualCountOfExecutionInstructions = e.limitsOfExecutionInstructions //ToDo memory pass whether exhaust ?
 	}
 
+	// refund deleted storage keys, at most half of the executed instructions.
+	// the refund is only deducted from the charged gas, the limits must cover all executed instructions.
+	e.executionRefund = 0
+	if err == nil && e.storageRefund > 0 {
+		e.executionRefund = e.storageRefund
+		if e.executionRefund > e.actualCountOfExecutionInstructions/2 {
+			e.executionRefund = e.actualCountOfExecutionInstructions / 2
+		}
+	}
+
 	return result, err
 }
 
@@ -450,14 +484,30 @@ func (e *V8Engine) prepareRunnableContractScript(source, function, args string)
 	runnableSource = fmt.Sprintf(`Blockchain.blockParse("%s");
 									Blockchain.transactionParse("%s");
 									Object.freeze(Blockchain);
+									require("random.js").seed("%s");
 									var __contract = require("%s");
 									var __instance = new __contract();
 									__instance["%s"].apply(__instance, JSON.parse("%s"));`,
-		formatArgs(string(blockJSON)), formatArgs(string(txJSON)),
+		formatArgs(string(blockJSON)), formatArgs(string(txJSON)), e.randomSeed(),
 		ModuleID, function, formatArgs(string(argsInput)))
 	return runnableSource, 0, nil
 }
 
+// randomSeed returns the seed of Math.random, the same on every node executing the transaction.
+// The seed is empty before DateRandomHeight, which keeps Date and Math.random disabled.
+// The hash of the block is unknown before it is sealed, so the parent hash is used with the timestamp
+// and coinbase of the block, which the sender cannot know when signing the transaction.
+// The proposer of the block still knows the seed, and can bias the result by choosing the coinbase
+// or dropping the transaction, so contracts must not rely on it when the proposer can profit from it.
+func (e *V8Engine) randomSeed() string {
+	block := e.ctx.block
+	if block.Height() < DateRandomHeight {
+		return ""
+	}
+	return byteutils.Hex(hash.Sha3256(block.ParentHash(), byteutils.FromInt64(block.Timestamp()),
+		block.Coinbase().Bytes(), e.ctx.tx.Hash(), e.ctx.contract.Address()))
+}
+
 func getEngineByStorageHandler(handler uint64) (*V8Engine, Account) {
 	storagesLock.RLock()
 	engine := storages[handler]
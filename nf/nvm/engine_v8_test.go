@@ -29,6 +29,7 @@ import (
 	"sync"
 	"testing"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/nebulasio/go-nebulas/consensus/dpos"
 
 	"encoding/json"
@@ -64,6 +65,11 @@ func (block *testBlock) Hash() byteutils.Hash {
 	return []byte("59fc526072b09af8a8ca9732dae17132c4e9127e43cf2232")
 }
 
+// ParentHash mock
+func (block *testBlock) ParentHash() byteutils.Hash {
+	return []byte("0dbd2b5a6f7ba9a8ec5a6cc4a7bd9b7c8e1c5b6f2a6b9bd0")
+}
+
 // Height mock
 func (block *testBlock) Height() uint64 {
 	return 1
@@ -121,9 +127,9 @@ func TestRunScriptSource(t *testing.T) {
 		{"test/test_storage_class.js", nil, "\"\""},
 		{"test/test_storage.js", nil, "\"\""},
 		{"test/test_eval.js", core.ErrExecutionFailed, "EvalError: Code generation from strings disallowed for this context"},
-		{"test/test_date.js", core.ErrExecutionFailed, "TypeError: Date.now is not a function"},
+		{"test/test_date.js", core.ErrExecutionFailed, "Error: Date is only available in contract execution."},
 		{"test/test_bignumber_random.js", core.ErrExecutionFailed, "Error: BigNumber.random is not allowed in nvm."},
-		{"test/test_random.js", core.ErrExecutionFailed, "Error: Math.random func is not allowed in nvm."},
+		{"test/test_random.js", core.ErrExecutionFailed, "Error: Math.random is only available in contract execution."},
 	}
 
 	for _, tt := range tests {
@@ -1169,3 +1175,257 @@ func TestTransferValueFromContracts(t *testing.T) {
 		})
 	}
 }
+
+func TestTraceContract(t *testing.T) {
+	data, err := ioutil.ReadFile("./test/trace_contract.js")
+	assert.Nil(t, err, "contract path read error")
+
+	mem, _ := storage.NewMemoryStorage()
+	context, _ := state.NewWorldState(dpos.NewDpos(), mem)
+	contract, _ := context.CreateContractAccount([]byte("account2"), nil)
+	ctx, err := NewContext(mockBlock(), mockTransaction(), contract, context)
+	assert.Nil(t, err)
+
+	engine := NewV8Engine(ctx)
+	engine.SetExecutionLimits(10000, 10000000)
+	_, err = engine.DeployAndInit(string(data), "js", "")
+	assert.Nil(t, err)
+	engine.Dispose()
+
+	trace := &core.TransactionTrace{}
+	engine = NewV8Engine(ctx)
+	engine.SetExecutionLimits(10000, 10000000)
+	engine.SetTrace(trace)
+	_, err = engine.Call(string(data), "js", "save", "[1]")
+	assert.Nil(t, err)
+	engine.Dispose()
+
+	types := []string{}
+	instructions := uint64(0)
+	for _, step := range trace.Steps {
+		types = append(types, step.Type)
+		assert.True(t, step.Instructions >= instructions)
+		instructions = step.Instructions
+	}
+	assert.Equal(t, []string{core.TraceStepLog, core.TraceStepStoragePut, core.TraceStepEvent}, types)
+	assert.Equal(t, "info", trace.Steps[0].Key)
+	assert.Equal(t, "save 1", trace.Steps[0].Value)
+	assert.Equal(t, "value", trace.Steps[1].Key)
+	assert.Equal(t, "1", trace.Steps[1].Value)
+	assert.Equal(t, EventNameSpaceContract+".saved", trace.Steps[2].Key)
+
+	// engines without trace are not affected.
+	engine = NewV8Engine(ctx)
+	engine.SetExecutionLimits(10000, 10000000)
+	result, err := engine.Call(string(data), "js", "get", "")
+	assert.Nil(t, err)
+	assert.Equal(t, "1", result)
+	engine.Dispose()
+	assert.Equal(t, 3, len(trace.Steps))
+	assert.Equal(t, 0, len(tracedEngines))
+}
+
+func mockDeployContract(t *testing.T, ws state.WorldState, nonce uint64, source string) *core.Address {
+	from, _ := core.AddressParse("n1FkntVUMPAsESuCAAPK711omQk19JotBjM")
+	payload, err := core.NewDeployPayload(source, core.SourceTypeJavaScript, "", "", false)
+	assert.Nil(t, err)
+	payloadBytes, err := payload.ToBytes()
+	assert.Nil(t, err)
+	gasPrice, _ := util.NewUint128FromString("1000000")
+	gasLimit, _ := util.NewUint128FromString("2000000")
+	tx, err := core.NewTransaction(1, from, from, util.NewUint128(), nonce, core.TxPayloadDeployType, payloadBytes, gasPrice, gasLimit)
+	assert.Nil(t, err)
+	signature, _ := crypto.NewSignature(keystore.SECP256K1)
+	signature.InitSign(secp256k1.GeneratePrivateKey())
+	assert.Nil(t, tx.Sign(signature))
+	addr, err := tx.GenerateContractAddress()
+	assert.Nil(t, err)
+
+	txWorldState, err := ws.Prepare(tx.Hash().String())
+	assert.Nil(t, err)
+	pbTx, err := tx.ToProto()
+	assert.Nil(t, err)
+	txBytes, err := proto.Marshal(pbTx)
+	assert.Nil(t, err)
+	assert.Nil(t, txWorldState.PutTx(tx.Hash(), txBytes))
+	contract, err := txWorldState.CreateContractAccount(addr.Bytes(), tx.Hash())
+	assert.Nil(t, err)
+
+	ctx, err := NewContext(mockBlock(), tx, contract, txWorldState)
+	assert.Nil(t, err)
+	engine := NewV8Engine(ctx)
+	engine.SetExecutionLimits(100000, 10000000)
+	_, err = engine.DeployAndInit(source, core.SourceTypeJavaScript, "")
+	assert.Nil(t, err)
+	engine.Dispose()
+
+	event, err := json.Marshal(&core.TransactionEvent{Hash: tx.Hash().String(), Status: core.TxExecutionSuccess})
+	assert.Nil(t, err)
+	txWorldState.RecordEvent(tx.Hash(), &state.Event{Topic: core.TopicTransactionExecutionResult, Data: string(event)})
+	_, err = txWorldState.CheckAndUpdate()
+	assert.Nil(t, err)
+	return addr
+}
+
+func TestContractCall(t *testing.T) {
+	callerSource, err := ioutil.ReadFile("./test/contract_call_caller.js")
+	assert.Nil(t, err, "contract path read error")
+	calleeSource, err := ioutil.ReadFile("./test/contract_call_callee.js")
+	assert.Nil(t, err, "contract path read error")
+
+	mem, _ := storage.NewMemoryStorage()
+	context, _ := state.NewWorldState(dpos.NewDpos(), mem)
+	assert.Nil(t, context.Begin())
+	callerAddr := mockDeployContract(t, context, 1, string(callerSource))
+	calleeAddr := mockDeployContract(t, context, 2, string(calleeSource))
+
+	tx := mockNormalTransaction("n1FkntVUMPAsESuCAAPK711omQk19JotBjM", callerAddr.String(), "0")
+	txWorldState, err := context.Prepare(tx.Hash().String())
+	assert.Nil(t, err)
+	caller, err := txWorldState.GetContractAccount(callerAddr.Bytes())
+	assert.Nil(t, err)
+	assert.Nil(t, caller.AddBalance(newUint128FromIntWrapper(100)))
+
+	call := func(function, args string) (string, error) {
+		ctx, err := NewContext(mockBlock(), tx, caller, txWorldState)
+		assert.Nil(t, err)
+		engine := NewV8Engine(ctx)
+		defer engine.Dispose()
+		engine.SetExecutionLimits(100000, 10000000)
+		return engine.Call(string(callerSource), core.SourceTypeJavaScript, function, args)
+	}
+
+	// the callee sees the caller contract as sender and receives the value.
+	result, err := call("save", fmt.Sprintf("[\"%s\", 1, 10]", calleeAddr))
+	assert.Nil(t, err)
+	assert.Equal(t, fmt.Sprintf("{\"value\":1,\"from\":\"%s\",\"amount\":\"10\"}", callerAddr), result)
+	assert.Equal(t, newUint128FromIntWrapper(90), caller.Balance())
+	callee, err := txWorldState.GetContractAccount(calleeAddr.Bytes())
+	assert.Nil(t, err)
+	assert.Equal(t, newUint128FromIntWrapper(10), callee.Balance())
+
+	// a failed call is rolled back and surfaces as an error in the caller.
+	result, err = call("saveAndFail", fmt.Sprintf("[\"%s\", 2]", calleeAddr))
+	assert.Nil(t, err)
+	assert.Equal(t, "\"Call: Error: callee failed\"", result)
+	result, err = call("get", fmt.Sprintf("[\"%s\"]", calleeAddr))
+	assert.Nil(t, err)
+	assert.Equal(t, "1", result)
+
+	// a call without enough value fails the caller.
+	_, err = call("save", fmt.Sprintf("[\"%s\", 3, 1000]", calleeAddr))
+	assert.NotNil(t, err)
+	assert.Equal(t, newUint128FromIntWrapper(90), caller.Balance())
+
+	// nested calls are limited in depth and share the caller's budget.
+	_, err = call("recurse", fmt.Sprintf("[\"%s\"]", callerAddr))
+	assert.Equal(t, core.ErrExecutionFailed, err)
+
+	// only the events of the successful call are kept.
+	_, err = txWorldState.CheckAndUpdate()
+	assert.Nil(t, err)
+	events, err := context.FetchEvents(tx.Hash())
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(events))
+	assert.Equal(t, EventNameSpaceContract+".saved", events[0].Topic)
+}
+
+func TestDateAndRandom(t *testing.T) {
+	data, err := ioutil.ReadFile("./test/date_random_contract.js")
+	assert.Nil(t, err, "contract path read error")
+
+	mem, _ := storage.NewMemoryStorage()
+	context, _ := state.NewWorldState(dpos.NewDpos(), mem)
+	contract, _ := context.CreateContractAccount([]byte("account2"), nil)
+
+	call := func(tx *core.Transaction, function, args string) (string, error) {
+		ctx, err := NewContext(mockBlock(), tx, contract, context)
+		assert.Nil(t, err)
+		engine := NewV8Engine(ctx)
+		defer engine.Dispose()
+		engine.SetExecutionLimits(100000, 10000000)
+		return engine.Call(string(data), "js", function, args)
+	}
+
+	// Date and Math.random throw before their height.
+	defer func(height uint64) { DateRandomHeight = height }(DateRandomHeight)
+	DateRandomHeight = mockBlock().Height() + 1
+	tx := mockTransaction()
+	result, err := call(tx, "now", "")
+	assert.Equal(t, core.ErrExecutionFailed, err)
+	assert.Equal(t, "Error: Date is not allowed in nvm.", result)
+	result, err = call(tx, "random", "[1]")
+	assert.Equal(t, core.ErrExecutionFailed, err)
+	assert.Equal(t, "Error: Math.random func is not allowed in nvm.", result)
+	_, err = call(tx, "reseed", "")
+	assert.Equal(t, core.ErrExecutionFailed, err)
+
+	DateRandomHeight = mockBlock().Height()
+	result, err = call(tx, "now", "")
+	assert.Nil(t, err)
+	assert.Equal(t, "[0,0,0,0,\"Thu, 01 Jan 1970 00:00:00 GMT\"]", result)
+
+	result, err = call(tx, "utc", "")
+	assert.Nil(t, err)
+	assert.Equal(t, "1514862245000", result)
+
+	_, err = call(tx, "parse", "[\"2018-01-01\"]")
+	assert.Equal(t, core.ErrExecutionFailed, err)
+
+	// the same transaction always gets the same numbers, others get different ones.
+	first, err := call(tx, "random", "[5]")
+	assert.Nil(t, err)
+	second, err := call(tx, "random", "[5]")
+	assert.Nil(t, err)
+	assert.Equal(t, first, second)
+	other, err := call(mockNormalTransaction("n1FkntVUMPAsESuCAAPK711omQk19JotBjM", "n1JNHZJEUvfBYfjDRD14Q73FX62nJAzXkMR", "1"), "random", "[5]")
+	assert.Nil(t, err)
+	assert.NotEqual(t, first, other)
+
+	// contracts cannot reseed the generator.
+	_, err = call(tx, "reseed", "")
+	assert.Equal(t, core.ErrExecutionFailed, err)
+}
+
+func TestStorageGas(t *testing.T) {
+	mem, _ := storage.NewMemoryStorage()
+	context, _ := state.NewWorldState(dpos.NewDpos(), mem)
+	contract, _ := context.CreateContractAccount([]byte("account2"), nil)
+	ctx, err := NewContext(mockBlock(), mockTransaction(), contract, context)
+	assert.Nil(t, err)
+
+	run := func(source string) (uint64, uint64) {
+		engine := NewV8Engine(ctx)
+		defer engine.Dispose()
+		engine.SetExecutionLimits(100000, 10000000)
+		assert.Nil(t, engine.AddModule("storage_gas.js", source, 0))
+		_, err := engine.RunScriptSource("var x = require(\"storage_gas.js\");", 0)
+		assert.Nil(t, err)
+		return engine.ExecutionInstructions(), engine.ExecutionRefund()
+	}
+	put := "LocalContractStorage.set(\"k\", \"value\");"
+	del := "var a = 0; for (var i = 0; i < 100; i++) { a += i; } LocalContractStorage.del(\"k\");"
+
+	// the schedule is not applied before its height.
+	defer func(height uint64) { StorageGasScheduleHeight = height }(StorageGasScheduleHeight)
+	StorageGasScheduleHeight = mockBlock().Height() + 1
+	created, _ := run(put)
+	updated, _ := run(put)
+	assert.Equal(t, created, updated)
+	_, refund := run(del)
+	assert.Equal(t, uint64(0), refund)
+
+	// writing a new key costs more than overwriting it.
+	StorageGasScheduleHeight = mockBlock().Height()
+	created, _ = run(put)
+	updated, _ = run(put)
+	assert.Equal(t, uint64(StorageGasPerNewKey), created-updated)
+
+	// deleting an existing key is refunded, deleting a missing one is not,
+	// the refund is not deducted from the executed instructions.
+	deleted, refund := run(del)
+	assert.Equal(t, uint64(StorageRefundPerDelKey), refund)
+	missing, refund := run(del)
+	assert.Equal(t, uint64(0), refund)
+	assert.Equal(t, deleted, missing)
+}
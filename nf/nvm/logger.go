@@ -28,6 +28,9 @@ import (
 //export V8Log
 func V8Log(level int, msg *C.char) {
 	s := C.GoString(msg)
+	if level > traceLogLevelMask {
+		level = traceLog(level, s)
+	}
 
 	switch level {
 	case 1:
@@ -26,6 +26,7 @@ import (
 	"unsafe"
 
 	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/core"
 	"github.com/nebulasio/go-nebulas/util/logging"
 	"github.com/sirupsen/logrus"
 )
@@ -63,7 +64,7 @@ func parseStorageKey(key string) (string, string, error) {
 // StorageGetFunc export StorageGetFunc
 //export StorageGetFunc
 func StorageGetFunc(handler unsafe.Pointer, key *C.char, gasCnt *C.size_t) *C.char {
-	_, storage := getEngineByStorageHandler(uint64(uintptr(handler)))
+	engine, storage := getEngineByStorageHandler(uint64(uintptr(handler)))
 	if storage == nil {
 		logging.VLog().Error("get storage failed!")
 		return nil
@@ -85,6 +86,7 @@ func StorageGetFunc(handler unsafe.Pointer, key *C.char, gasCnt *C.size_t) *C.ch
 	}
 
 	val, err := storage.Get(trie.HashDomains(domainKey, itemKey))
+	engine.traceStep(core.TraceStepStorageGet, k, string(val), err)
 	if err != nil {
 		if err != ErrKeyNotFound {
 			logging.VLog().WithFields(logrus.Fields{
@@ -102,7 +104,7 @@ func StorageGetFunc(handler unsafe.Pointer, key *C.char, gasCnt *C.size_t) *C.ch
 // StoragePutFunc export StoragePutFunc
 //export StoragePutFunc
 func StoragePutFunc(handler unsafe.Pointer, key *C.char, value *C.char, gasCnt *C.size_t) int {
-	_, storage := getEngineByStorageHandler(uint64(uintptr(handler)))
+	engine, storage := getEngineByStorageHandler(uint64(uintptr(handler)))
 	if storage == nil {
 		return 1
 	}
@@ -111,7 +113,12 @@ func StoragePutFunc(handler unsafe.Pointer, key *C.char, value *C.char, gasCnt *
 	v := []byte(C.GoString(value))
 
 	// calculate Gas.
-	*gasCnt = C.size_t(len(k) + len(v))
+	schedule := engine.storageGasScheduled()
+	if schedule {
+		*gasCnt = C.size_t((len(k) + len(v)) * StorageGasPerByte)
+	} else {
+		*gasCnt = C.size_t(len(k) + len(v))
+	}
 
 	domainKey, itemKey, err := parseStorageKey(k)
 	if err != nil {
@@ -123,7 +130,15 @@ func StoragePutFunc(handler unsafe.Pointer, key *C.char, value *C.char, gasCnt *
 		return 1
 	}
 
-	err = storage.Put(trie.HashDomains(domainKey, itemKey), v)
+	hashedKey := trie.HashDomains(domainKey, itemKey)
+	if schedule {
+		if _, err := storage.Get(hashedKey); err == ErrKeyNotFound {
+			*gasCnt += C.size_t(StorageGasPerNewKey)
+		}
+	}
+
+	err = storage.Put(hashedKey, v)
+	engine.traceStep(core.TraceStepStoragePut, k, string(v), err)
 	if err != nil && err != ErrKeyNotFound {
 		logging.VLog().WithFields(logrus.Fields{
 			"handler": uint64(uintptr(handler)),
@@ -139,7 +154,7 @@ func StoragePutFunc(handler unsafe.Pointer, key *C.char, value *C.char, gasCnt *
 // StorageDelFunc export StorageDelFunc
 //export StorageDelFunc
 func StorageDelFunc(handler unsafe.Pointer, key *C.char, gasCnt *C.size_t) int {
-	_, storage := getEngineByStorageHandler(uint64(uintptr(handler)))
+	engine, storage := getEngineByStorageHandler(uint64(uintptr(handler)))
 	if storage == nil {
 		return 1
 	}
@@ -160,6 +175,10 @@ func StorageDelFunc(handler unsafe.Pointer, key *C.char, gasCnt *C.size_t) int {
 	}
 
 	err = storage.Del(trie.HashDomains(domainKey, itemKey))
+	engine.traceStep(core.TraceStepStorageDel, k, "", err)
+	if err == nil && engine.storageGasScheduled() {
+		engine.storageRefund += StorageRefundPerDelKey
+	}
 	if err != nil && err != ErrKeyNotFound {
 		logging.VLog().WithFields(logrus.Fields{
 			"handler": uint64(uintptr(handler)),
@@ -0,0 +1,45 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+"use strict";
+
+var CallerContract = function () {
+};
+
+CallerContract.prototype = {
+    init: function () {
+    },
+    save: function (callee, value, amount) {
+        return Blockchain.call(callee, "save", [value], amount);
+    },
+    saveAndFail: function (callee, value) {
+        try {
+            Blockchain.call(callee, "fail", [value]);
+        } catch (e) {
+            return e.message;
+        }
+    },
+    get: function (callee) {
+        return Blockchain.call(callee, "get", []);
+    },
+    recurse: function (self) {
+        return Blockchain.call(self, "recurse", [self]);
+    }
+};
+
+module.exports = CallerContract;
@@ -0,0 +1,53 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+"use strict";
+
+var DateRandomContract = function () {
+};
+
+DateRandomContract.prototype = {
+    init: function () {
+    },
+    now: function () {
+        var date = new Date();
+        return [Date.now(), date.getTime(), date.getHours(), date.getTimezoneOffset(), date.toString()];
+    },
+    utc: function () {
+        return new Date(2018, 0, 2, 3, 4, 5).getTime();
+    },
+    parse: function (str) {
+        return new Date(str).getTime();
+    },
+    random: function (n) {
+        var values = [];
+        for (var i = 0; i < n; i++) {
+            var value = Math.random();
+            if (value < 0 || value >= 1) {
+                throw new Error("random out of range: " + value);
+            }
+            values.push(value);
+        }
+        return values;
+    },
+    reseed: function () {
+        require("random.js").seed("00000000000000000000000000000001");
+    }
+};
+
+module.exports = DateRandomContract;
@@ -0,0 +1,138 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nvm
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/nebulasio/go-nebulas/core"
+)
+
+const (
+	// traceLogLevelShift the bits of the log level passed by the tracing console, the rest carries the trace id.
+	traceLogLevelShift = 8
+	traceLogLevelMask  = 1<<traceLogLevelShift - 1
+	maxTraceID         = 1 << 22
+)
+
+var (
+	tracedEngines     = make(map[int]*V8Engine)
+	tracedEnginesIdx  = 0
+	tracedEnginesLock = sync.Mutex{}
+
+	traceLogLevels = map[int]string{1: "debug", 2: "warn", 3: "info", 4: "error"}
+)
+
+// traceConsoleSource replaces lib/console.js of a traced engine, tagging the log level with the trace id
+// so that the output can be attributed to the engine.
+const traceConsoleSource = `'use strict';
+
+function Console() {}
+
+function log(...args) {
+    var level = args.shift();
+    if (typeof (level) != 'number') {
+        throw 'level must be number.';
+    }
+
+    var msg = '';
+    for (var i = 0; i < args.length - 1; i++) {
+        msg += format(args[i]) + ' ';
+    }
+    msg += format(args[args.length - 1]);
+
+    _native_log(%d + level, msg);
+}
+
+function format(obj) {
+    if (typeof (obj) == 'object') {
+        return JSON.stringify(obj);
+    }
+    return obj;
+}
+
+[
+    ['debug', 1],
+    ['warn', 2],
+    ['info', 3],
+    ['log', 3],
+    ['error', 4]
+].forEach(function (val) {
+    Console.prototype[val[0]] = log.bind(null, val[1]);
+});
+
+module.exports = new Console();
+module.exports.Console = Console;
+`
+
+// SetTrace enable tracing mode, the host calls of the contract are recorded to trace.
+func (e *V8Engine) SetTrace(trace *core.TransactionTrace) {
+	if trace == nil || e.trace != nil {
+		return
+	}
+	e.trace = trace
+
+	tracedEnginesLock.Lock()
+	tracedEnginesIdx = tracedEnginesIdx%maxTraceID + 1
+	e.traceID = tracedEnginesIdx
+	tracedEngines[e.traceID] = e
+	tracedEnginesLock.Unlock()
+
+	e.modules.Add(NewModule("console.js", fmt.Sprintf(traceConsoleSource, e.traceID<<traceLogLevelShift), 0))
+}
+
+// disposeTrace remove the engine from traced engines.
+func (e *V8Engine) disposeTrace() {
+	if e.trace == nil {
+		return
+	}
+	tracedEnginesLock.Lock()
+	delete(tracedEngines, e.traceID)
+	tracedEnginesLock.Unlock()
+}
+
+// traceStep record a host call of the contract in tracing mode.
+func (e *V8Engine) traceStep(typ, key, value string, err error) {
+	if e == nil || e.trace == nil {
+		return
+	}
+	step := &core.TraceStep{
+		Type:         typ,
+		Key:          key,
+		Value:        value,
+		Instructions: e.executedInstructions(),
+	}
+	if err != nil {
+		step.Err = err.Error()
+	}
+	e.trace.AddStep(step)
+}
+
+// traceLog record the console output of a traced engine and return the original log level.
+func traceLog(level int, msg string) int {
+	id, level := level>>traceLogLevelShift, level&traceLogLevelMask
+
+	tracedEnginesLock.Lock()
+	e := tracedEngines[id]
+	tracedEnginesLock.Unlock()
+
+	e.traceStep(core.TraceStepLog, traceLogLevels[level], msg, nil)
+	return level
+}
@@ -27,6 +27,7 @@ var (
 	ErrLimitHasEmpty                   = errors.New("limit args has empty")
 	ErrSetMemorySmall                  = errors.New("set memory small than v8 limit")
 	ErrDisallowCallNotStandardFunction = errors.New("disallow call not standard function")
+	ErrExceedMaxContractCallDepth      = errors.New("exceed max contract call depth")
 )
 
 //define
@@ -50,11 +51,39 @@ const (
 	TransferAddBalance
 )
 
+// contract call limits
+const (
+	// MaxContractCallDepth the max depth of nested contract calls.
+	MaxContractCallDepth = 8
+	// ContractCallBaseGas the instructions charged for a contract call besides those of the callee.
+	ContractCallBaseGas = 1000
+)
+
+// storage gas schedule
+const (
+	// StorageGasPerByte the instructions charged for each byte of key and value written.
+	StorageGasPerByte = 10
+	// StorageGasPerNewKey the instructions charged for writing a key not yet in storage.
+	StorageGasPerNewKey = 100
+	// StorageRefundPerDelKey the instructions refunded for deleting an existing key.
+	StorageRefundPerDelKey = 50
+)
+
+// StorageGasScheduleHeight the block height since which the storage gas schedule is applied,
+// the blocks before it are charged one instruction per byte written without refunds.
+var StorageGasScheduleHeight uint64 = 2000000
+
+// DateRandomHeight the block height since which Date and Math.random are available in contracts,
+// they throw errors in the blocks before it.
+var DateRandomHeight uint64 = 2000000
+
 // Block interface breaks cycle import dependency and hides unused services.
 type Block interface {
 	Hash() byteutils.Hash
+	ParentHash() byteutils.Hash
 	Height() uint64 // ToAdd: timestamp interface
 	Timestamp() int64
+	Coinbase() *core.Address
 }
 
 // Transaction interface breaks cycle import dependency and hides unused services.
@@ -71,6 +100,7 @@ type Transaction interface {
 
 // Account interface breaks cycle import dependency and hides unused services.
 type Account interface {
+	Address() byteutils.Hash
 	Balance() *util.Uint128
 	Nonce() uint64
 	AddBalance(value *util.Uint128) error
@@ -85,4 +115,5 @@ type WorldState interface {
 	GetOrCreateUserAccount(addr byteutils.Hash) (state.Account, error)
 	GetTx(txHash byteutils.Hash) ([]byte, error)
 	RecordEvent(txHash byteutils.Hash, event *state.Event)
+	Prepare(txid interface{}) (state.TxWorldState, error)
 }
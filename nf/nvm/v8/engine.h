@@ -69,11 +69,16 @@ typedef int (*TransferFunc)(void *handler, const char *to, const char *value,
                             size_t *counterVal);
 typedef int (*VerifyAddressFunc)(void *handler, const char *address,
                                  size_t *counterVal);
+typedef int (*ContractCallFunc)(void *handler, const char *address,
+                                const char *funcName, const char *args,
+                                const char *value, char **result,
+                                size_t *counterVal);
 
 EXPORT void InitializeBlockchain(GetTxByHashFunc getTx,
                                  GetAccountStateFunc getAccount,
                                  TransferFunc transfer,
-                                 VerifyAddressFunc verifyAddress);
+                                 VerifyAddressFunc verifyAddress,
+                                 ContractCallFunc contractCall);
 
 // version
 EXPORT char *GetV8Version();
@@ -25,14 +25,17 @@ static GetTxByHashFunc sGetTxByHash = NULL;
 static GetAccountStateFunc sGetAccountState = NULL;
 static TransferFunc sTransfer = NULL;
 static VerifyAddressFunc sVerifyAddress = NULL;
+static ContractCallFunc sContractCall = NULL;
 
 void InitializeBlockchain(GetTxByHashFunc getTx, GetAccountStateFunc getAccount,
                           TransferFunc transfer,
-                          VerifyAddressFunc verifyAddress) {
+                          VerifyAddressFunc verifyAddress,
+                          ContractCallFunc contractCall) {
   sGetTxByHash = getTx;
   sGetAccountState = getAccount;
   sTransfer = transfer;
   sVerifyAddress = verifyAddress;
+  sContractCall = contractCall;
 }
 
 void NewBlockchainInstance(Isolate *isolate, Local<Context> context,
@@ -65,6 +68,11 @@ void NewBlockchainInstance(Isolate *isolate, Local<Context> context,
                 static_cast<PropertyAttribute>(PropertyAttribute::DontDelete |
                                                PropertyAttribute::ReadOnly));
 
+  blockTpl->Set(String::NewFromUtf8(isolate, "call"),
+                FunctionTemplate::New(isolate, ContractCallCallback),
+                static_cast<PropertyAttribute>(PropertyAttribute::DontDelete |
+                                               PropertyAttribute::ReadOnly));
+
   Local<Object> instance = blockTpl->NewInstance(context).ToLocalChecked();
   instance->SetInternalField(0, External::New(isolate, handler));
 
@@ -204,3 +212,68 @@ void VerifyAddressCallback(const FunctionCallbackInfo<Value> &info) {
   // record storage usage.
   IncrCounter(isolate, isolate->GetCurrentContext(), cnt);
 }
+
+// ContractCallCallback
+void ContractCallCallback(const FunctionCallbackInfo<Value> &info) {
+  Isolate *isolate = info.GetIsolate();
+  Local<Object> thisArg = info.Holder();
+  Local<External> handler = Local<External>::Cast(thisArg->GetInternalField(0));
+
+  if (info.Length() != 4) {
+    isolate->ThrowException(
+        String::NewFromUtf8(isolate, "Blockchain.call() requires 4 arguments"));
+    return;
+  }
+
+  Local<Value> address = info[0];
+  if (!address->IsString()) {
+    isolate->ThrowException(
+        String::NewFromUtf8(isolate, "address must be string"));
+    return;
+  }
+
+  Local<Value> funcName = info[1];
+  if (!funcName->IsString()) {
+    isolate->ThrowException(
+        String::NewFromUtf8(isolate, "function must be string"));
+    return;
+  }
+
+  Local<Value> args = info[2];
+  if (!args->IsString()) {
+    isolate->ThrowException(String::NewFromUtf8(isolate, "args must be string"));
+    return;
+  }
+
+  Local<Value> amount = info[3];
+  if (!amount->IsString()) {
+    isolate->ThrowException(
+        String::NewFromUtf8(isolate, "value must be string"));
+    return;
+  }
+
+  size_t cnt = 0;
+  char *result = NULL;
+
+  int ret = sContractCall(handler->Value(),
+                          *String::Utf8Value(address->ToString()),
+                          *String::Utf8Value(funcName->ToString()),
+                          *String::Utf8Value(args->ToString()),
+                          *String::Utf8Value(amount->ToString()), &result, &cnt);
+
+  // record the instructions of the callee before leaving.
+  IncrCounter(isolate, isolate->GetCurrentContext(), cnt);
+
+  if (ret != 0) {
+    isolate->ThrowException(Exception::Error(String::NewFromUtf8(
+        isolate, result != NULL ? result : "contract call failed")));
+  } else if (result == NULL) {
+    info.GetReturnValue().SetNull();
+  } else {
+    info.GetReturnValue().Set(String::NewFromUtf8(isolate, result));
+  }
+
+  if (result != NULL) {
+    free(result);
+  }
+}
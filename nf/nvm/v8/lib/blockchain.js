@@ -53,6 +53,22 @@ Blockchain.prototype = {
     },
     verifyAddress: function (address) {
         return this.nativeBlockchain.verifyAddress(address);
+    },
+    call: function (address, func, args, value) {
+        if (args === undefined || args === null) {
+            args = [];
+        }
+        if (!(args instanceof Array)) {
+            throw new Error("args must be an array");
+        }
+        if (value === undefined || value === null) {
+            value = 0;
+        }
+        if (!(value instanceof BigNumber)) {
+            value = new BigNumber(value);
+        }
+        var ret = this.nativeBlockchain.call(address, func, JSON.stringify(args), value.toString(10));
+        return JSON.parse(ret);
     }
 };
 
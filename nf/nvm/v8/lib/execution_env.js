@@ -16,12 +16,74 @@
 // along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
 //
 
-const Date = function () {
-    throw new Error("Date is not allowed in nvm.");
-};
+// Date is tied to the block timestamp, local time and string parsing are disallowed since they
+// depend on the timezone and locale of the node. It is disabled with Math.random before their height.
+const Date = (function (NativeDate) {
+    var checkEnabled = function () {
+        if (require('random.js').isDisabled()) {
+            throw new Error("Date is not allowed in nvm.");
+        }
+    };
+
+    var blockTime = function () {
+        checkEnabled();
+        if (!Blockchain.block) {
+            throw new Error("Date is only available in contract execution.");
+        }
+        return Blockchain.block.timestamp * 1000;
+    };
+
+    var NvmDate = function (...args) {
+        checkEnabled();
+        if (!(this instanceof NvmDate)) {
+            throw new Error("Date must be called with new in nvm.");
+        }
+        var date;
+        if (args.length == 0) {
+            date = new NativeDate(blockTime());
+        } else if (args.length == 1) {
+            if (typeof args[0] !== 'number' && !(args[0] instanceof NativeDate)) {
+                throw new Error("Date only accepts a timestamp or a Date in nvm.");
+            }
+            date = new NativeDate(args[0].valueOf());
+        } else {
+            date = new NativeDate(NativeDate.UTC.apply(null, args));
+        }
+        Object.setPrototypeOf(date, NvmDate.prototype);
+        return date;
+    };
+
+    NvmDate.prototype = Object.create(NativeDate.prototype, {
+        constructor: {
+            value: NvmDate
+        }
+    });
+    ['Date', 'Day', 'FullYear', 'Hours', 'Milliseconds', 'Minutes', 'Month', 'Seconds'].forEach(function (name) {
+        NvmDate.prototype['get' + name] = NativeDate.prototype['getUTC' + name];
+        if (name != 'Day') {
+            NvmDate.prototype['set' + name] = NativeDate.prototype['setUTC' + name];
+        }
+    });
+    NvmDate.prototype.getTimezoneOffset = function () {
+        return 0;
+    };
+    NvmDate.prototype.toString = NativeDate.prototype.toUTCString;
+    ['getYear', 'setYear', 'toDateString', 'toTimeString', 'toLocaleString', 'toLocaleDateString', 'toLocaleTimeString'].forEach(function (name) {
+        NvmDate.prototype[name] = function () {
+            throw new Error("Date." + name + " is not allowed in nvm.");
+        };
+    });
+
+    NvmDate.now = blockTime;
+    NvmDate.UTC = NativeDate.UTC;
+    NvmDate.parse = function () {
+        throw new Error("Date.parse is not allowed in nvm.");
+    };
+    return Object.freeze(NvmDate);
+})(this.Date);
 
 Math.random = function () {
-    throw new Error("Math.random func is not allowed in nvm.");
+    return require('random.js').random();
 };
 
 const require = (function (global) {
@@ -59,3 +59,14 @@ int VerifyAddress(void *handler, const char *address, size_t *gasCnt) {
   *gasCnt = 100;
   return 0;
 }
+
+int ContractCall(void *handler, const char *address, const char *funcName,
+                 const char *args, const char *value, char **result,
+                 size_t *gasCnt) {
+  *gasCnt = 1000;
+
+  string ret = "\"\"";
+  *result = (char *)calloc(ret.length() + 1, sizeof(char));
+  strncpy(*result, ret.c_str(), ret.length());
+  return 0;
+}
@@ -26,5 +26,8 @@ char *GetTxByHash(void *handler, const char *hash, size_t *gasCnt);
 char *GetAccountState(void *handler, const char *addres, size_t *gasCnts);
 int Transfer(void *handler, const char *to, const char *value, size_t *gasCnt);
 int VerifyAddress(void *handler, const char *address, size_t *gasCnt);
+int ContractCall(void *handler, const char *address, const char *funcName,
+                 const char *args, const char *value, char **result,
+                 size_t *gasCnt);
 
 #endif //_NEBULAS_NF_NVM_V8_LIB_FAKE_BLOCKCHAIN_H_
@@ -0,0 +1,79 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+'use strict';
+
+// Deterministic pseudo-random generator (xoshiro128**) backing Math.random in nvm.
+// It is seeded once by the engine before the contract runs, so every node gets the same sequence.
+// An empty seed disables Math.random and Date, as in the blocks before they are available.
+var state = null;
+var disabled = false;
+
+function rotl(x, k) {
+    return (x << k) | (x >>> (32 - k));
+}
+
+function seed(hex) {
+    if (state !== null || disabled) {
+        throw new Error("random seed can only be set once.");
+    }
+    if (hex === "") {
+        disabled = true;
+        return;
+    }
+    if (typeof hex !== 'string' || !/^[0-9a-f]{32,}$/.test(hex)) {
+        throw new Error("invalid random seed.");
+    }
+    var s = [];
+    for (var i = 0; i < 4; i++) {
+        s.push(parseInt(hex.substr(i * 8, 8), 16) | 0);
+    }
+    if ((s[0] | s[1] | s[2] | s[3]) === 0) {
+        s[0] = 1;
+    }
+    state = s;
+}
+
+function isDisabled() {
+    return disabled;
+}
+
+function random() {
+    if (disabled) {
+        throw new Error("Math.random func is not allowed in nvm.");
+    }
+    if (state === null) {
+        throw new Error("Math.random is only available in contract execution.");
+    }
+    var s = state;
+    var result = Math.imul(rotl(Math.imul(s[1], 5), 7), 9);
+    var t = s[1] << 9;
+    s[2] ^= s[0];
+    s[3] ^= s[1];
+    s[1] ^= s[2];
+    s[0] ^= s[3];
+    s[2] ^= t;
+    s[3] = rotl(s[3], 11);
+    return (result >>> 0) / 4294967296;
+}
+
+module.exports = {
+    seed: seed,
+    isDisabled: isDisabled,
+    random: random
+};
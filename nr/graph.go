@@ -0,0 +1,168 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nr
+
+import (
+	"math"
+	"math/big"
+	"sort"
+
+	"github.com/nebulasio/go-nebulas/nr/pb"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// node is an address in the transaction graph.
+type node struct {
+	address   byteutils.Hash
+	inValue   *util.Uint128
+	outValue  *util.Uint128
+	inDegree  uint64
+	outDegree uint64
+
+	// edges holds the total value transferred to each receiver.
+	edges map[byteutils.HexHash]*util.Uint128
+}
+
+// graph is the weighted transaction graph of one period.
+type graph struct {
+	nodes map[byteutils.HexHash]*node
+}
+
+func newGraph() *graph {
+	return &graph{nodes: make(map[byteutils.HexHash]*node)}
+}
+
+func (g *graph) node(addr byteutils.Hash) *node {
+	key := addr.Hex()
+	n, ok := g.nodes[key]
+	if !ok {
+		n = &node{
+			address:  addr,
+			inValue:  util.NewUint128(),
+			outValue: util.NewUint128(),
+			edges:    make(map[byteutils.HexHash]*util.Uint128),
+		}
+		g.nodes[key] = n
+	}
+	return n
+}
+
+// addTransfer records a transfer of value from one address to another.
+func (g *graph) addTransfer(from, to byteutils.Hash, value *util.Uint128) error {
+	sender, receiver := g.node(from), g.node(to)
+
+	outValue, err := sender.outValue.Add(value)
+	if err != nil {
+		return err
+	}
+	inValue, err := receiver.inValue.Add(value)
+	if err != nil {
+		return err
+	}
+	weight, ok := sender.edges[receiver.address.Hex()]
+	if !ok {
+		weight = util.NewUint128()
+	}
+	weight, err = weight.Add(value)
+	if err != nil {
+		return err
+	}
+
+	sender.outValue, receiver.inValue = outValue, inValue
+	sender.edges[receiver.address.Hex()] = weight
+	sender.outDegree++
+	receiver.inDegree++
+	return nil
+}
+
+// sortedKeys returns node keys in a deterministic order.
+func (g *graph) sortedKeys() []byteutils.HexHash {
+	keys := make([]byteutils.HexHash, 0, len(g.nodes))
+	for k := range g.nodes {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	return keys
+}
+
+func toFloat(v *util.Uint128) float64 {
+	f, _ := new(big.Float).SetInt(new(big.Int).SetBytes(v.Bytes())).Float64()
+	return f
+}
+
+// rank runs a value weighted PageRank over the graph and returns items
+// ordered by score in descending order.
+func (g *graph) rank() []*nrpb.NRItem {
+	keys := g.sortedKeys()
+	size := len(keys)
+	if size == 0 {
+		return nil
+	}
+	index := make(map[byteutils.HexHash]int, size)
+	for i, k := range keys {
+		index[k] = i
+	}
+
+	scores := make([]float64, size)
+	for i := range scores {
+		scores[i] = 1 / float64(size)
+	}
+	base := (1 - DampingFactor) / float64(size)
+
+	for iter := 0; iter < MaxRankIterations; iter++ {
+		next := make([]float64, size)
+		dangling := 0.0
+		for i, k := range keys {
+			n := g.nodes[k]
+			total := toFloat(n.outValue)
+			if total == 0 {
+				dangling += scores[i]
+				continue
+			}
+			for to, w := range n.edges {
+				next[index[to]] += DampingFactor * scores[i] * toFloat(w) / total
+			}
+		}
+		delta := 0.0
+		for i := range next {
+			next[i] += base + DampingFactor*dangling/float64(size)
+			delta += math.Abs(next[i] - scores[i])
+		}
+		scores = next
+		if delta < RankConvergence {
+			break
+		}
+	}
+
+	items := make([]*nrpb.NRItem, size)
+	for i, k := range keys {
+		n := g.nodes[k]
+		items[i] = &nrpb.NRItem{
+			Address:   n.address,
+			Score:     scores[i],
+			InValue:   n.inValue.String(),
+			OutValue:  n.outValue.String(),
+			InDegree:  n.inDegree,
+			OutDegree: n.outDegree,
+		}
+	}
+	sort.SliceStable(items, func(i, j int) bool { return items[i].Score > items[j].Score })
+	return items
+}
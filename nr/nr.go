@@ -0,0 +1,261 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nr
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/nr/pb"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// Errors
+var (
+	ErrPeriodNotReached = errors.New("nr period has not been irreversible yet")
+	ErrInvalidPeriod    = errors.New("invalid nr period")
+)
+
+// Const
+const (
+	// DefaultPeriodLength is the number of blocks in a period, one day of 15s blocks.
+	DefaultPeriodLength uint64 = 5760
+
+	// DampingFactor of the rank iteration.
+	DampingFactor = 0.85
+
+	// MaxRankIterations bounds the rank iteration.
+	MaxRankIterations = 100
+
+	// RankConvergence stops the iteration once scores change less than it.
+	RankConvergence = 1e-10
+)
+
+const (
+	nrDataPrefix    = "nr_data_"
+	nrNextPeriodKey = "nr_next_period"
+)
+
+// Service computes the nebulas rank of addresses period by period over
+// the irreversible canonical chain and persists the results.
+type Service struct {
+	chain   *core.BlockChain
+	storage storage.Storage
+
+	periodLength uint64
+
+	mu     sync.Mutex
+	quitCh chan int
+}
+
+// NewService returns a new nr service.
+func NewService(neb core.Neblet) *Service {
+	return &Service{
+		chain:        neb.BlockChain(),
+		storage:      neb.Storage(),
+		periodLength: DefaultPeriodLength,
+		quitCh:       make(chan int, 1),
+	}
+}
+
+// Start start the nr service loop.
+func (s *Service) Start() {
+	logging.CLog().Info("Starting NR Service...")
+
+	go s.loop()
+}
+
+// Stop stop the nr service loop.
+func (s *Service) Stop() {
+	logging.CLog().Info("Stopping NR Service...")
+
+	s.quitCh <- 0
+}
+
+func (s *Service) loop() {
+	logging.CLog().Info("Started NR Service.")
+	timerChan := time.NewTicker(time.Minute).C
+	for {
+		select {
+		case <-s.quitCh:
+			logging.CLog().Info("Stopped NR Service.")
+			return
+		case <-timerChan:
+			s.catchUp()
+		}
+	}
+}
+
+// PeriodOfHeight returns the period the block height belongs to.
+func (s *Service) PeriodOfHeight(height uint64) uint64 {
+	if height <= 1 {
+		return 0
+	}
+	return (height - 1) / s.periodLength
+}
+
+// periodRange returns the first and the last block height of the period.
+func (s *Service) periodRange(period uint64) (uint64, uint64) {
+	return period*s.periodLength + 1, (period + 1) * s.periodLength
+}
+
+// catchUp computes all finished periods which have not been ranked yet.
+func (s *Service) catchUp() {
+	next := uint64(0)
+	if bytes, err := s.storage.Get([]byte(nrNextPeriodKey)); err == nil {
+		next = byteutils.Uint64(bytes)
+	}
+
+	for {
+		if _, err := s.GetNRByPeriod(next); err != nil {
+			if err != ErrPeriodNotReached {
+				logging.VLog().WithFields(logrus.Fields{
+					"period": next,
+					"err":    err,
+				}).Error("Failed to compute nebulas rank.")
+			}
+			return
+		}
+		next++
+		if err := s.storage.Put([]byte(nrNextPeriodKey), byteutils.FromUint64(next)); err != nil {
+			logging.VLog().WithFields(logrus.Fields{
+				"period": next,
+				"err":    err,
+			}).Error("Failed to store next nr period.")
+			return
+		}
+	}
+}
+
+// GetNRByPeriod returns the nebulas rank of the period, computing and
+// persisting it when the period is irreversible but not ranked yet.
+func (s *Service) GetNRByPeriod(period uint64) (*nrpb.NRData, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, err := s.loadNRData(period)
+	if err == nil {
+		return data, nil
+	}
+	if err != storage.ErrKeyNotFound {
+		return nil, err
+	}
+
+	start, end := s.periodRange(period)
+	if end < start {
+		return nil, ErrInvalidPeriod
+	}
+	if lib := s.chain.LIB(); lib == nil || lib.Height() < end {
+		return nil, ErrPeriodNotReached
+	}
+
+	data, err = s.compute(period, start, end)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.storeNRData(data); err != nil {
+		return nil, err
+	}
+
+	logging.VLog().WithFields(logrus.Fields{
+		"period":    period,
+		"start":     start,
+		"end":       end,
+		"addresses": len(data.Items),
+	}).Info("Computed nebulas rank.")
+	return data, nil
+}
+
+// compute builds the transaction graph of the blocks in [start, end] and ranks it.
+func (s *Service) compute(period, start, end uint64) (*nrpb.NRData, error) {
+	// the events trie accumulates the events of all blocks, use the tail's one
+	// since the states of earlier blocks may be pruned.
+	ws, err := s.chain.TailBlock().WorldState().Clone()
+	if err != nil {
+		return nil, err
+	}
+	g := newGraph()
+	for height := start; height <= end; height++ {
+		block := s.chain.GetBlockOnCanonicalChainByHeight(height)
+		if block == nil {
+			return nil, core.ErrNotBlockInCanonicalChain
+		}
+		if err := addBlockTransfers(g, ws, block); err != nil {
+			return nil, err
+		}
+	}
+	return &nrpb.NRData{
+		Period:      period,
+		StartHeight: start,
+		EndHeight:   end,
+		Items:       g.rank(),
+	}, nil
+}
+
+// addBlockTransfers adds the successful value transfers in the block to the graph.
+func addBlockTransfers(g *graph, ws state.WorldState, block *core.Block) error {
+	for _, tx := range block.Transactions() {
+		if tx.Value().Cmp(util.NewUint128()) == 0 || tx.From().Equals(tx.To()) {
+			continue
+		}
+		ok, err := core.TransactionSucceeded(ws, tx.Hash())
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+		if err := g.addTransfer(tx.From().Bytes(), tx.To().Bytes(), tx.Value()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func nrDataKey(period uint64) []byte {
+	return append([]byte(nrDataPrefix), byteutils.FromUint64(period)...)
+}
+
+func (s *Service) loadNRData(period uint64) (*nrpb.NRData, error) {
+	bytes, err := s.storage.Get(nrDataKey(period))
+	if err != nil {
+		return nil, err
+	}
+	data := new(nrpb.NRData)
+	if err := proto.Unmarshal(bytes, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (s *Service) storeNRData(data *nrpb.NRData) error {
+	bytes, err := proto.Marshal(data)
+	if err != nil {
+		return err
+	}
+	return s.storage.Put(nrDataKey(data.Period), bytes)
+}
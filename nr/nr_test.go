@@ -0,0 +1,104 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nr
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/nr/pb"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGraphRank(t *testing.T) {
+	a, b, c, d := byteutils.Hash{0x01}, byteutils.Hash{0x02}, byteutils.Hash{0x03}, byteutils.Hash{0x04}
+
+	g := newGraph()
+	assert.Nil(t, g.rank())
+
+	assert.Nil(t, g.addTransfer(a, c, util.NewUint128FromUint(10)))
+	assert.Nil(t, g.addTransfer(b, c, util.NewUint128FromUint(10)))
+	assert.Nil(t, g.addTransfer(d, c, util.NewUint128FromUint(5)))
+	assert.Nil(t, g.addTransfer(c, a, util.NewUint128FromUint(1)))
+	assert.Nil(t, g.addTransfer(a, c, util.NewUint128FromUint(10)))
+
+	items := g.rank()
+	assert.Equal(t, 4, len(items))
+	// c receives most of the value, a is the only one c pays.
+	assert.Equal(t, c, byteutils.Hash(items[0].Address))
+	assert.Equal(t, a, byteutils.Hash(items[1].Address))
+	assert.Equal(t, "35", items[0].InValue)
+	assert.Equal(t, uint64(4), items[0].InDegree)
+	assert.Equal(t, "20", items[1].OutValue)
+	assert.Equal(t, uint64(2), items[1].OutDegree)
+
+	total := 0.0
+	for i, item := range items {
+		total += item.Score
+		if i > 0 {
+			assert.True(t, items[i-1].Score >= item.Score)
+		}
+	}
+	assert.InDelta(t, 1.0, total, 1e-6)
+
+	// ranking is deterministic.
+	assert.Equal(t, items, g.rank())
+}
+
+func TestService_Period(t *testing.T) {
+	s := &Service{periodLength: 10}
+	tests := []struct {
+		height uint64
+		period uint64
+	}{
+		{0, 0}, {1, 0}, {10, 0}, {11, 1}, {20, 1}, {21, 2},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.period, s.PeriodOfHeight(tt.height), "height %d", tt.height)
+	}
+
+	start, end := s.periodRange(1)
+	assert.Equal(t, uint64(11), start)
+	assert.Equal(t, uint64(20), end)
+}
+
+func TestService_StoreNRData(t *testing.T) {
+	stor, _ := storage.NewMemoryStorage()
+	s := &Service{storage: stor, periodLength: 10}
+
+	_, err := s.loadNRData(3)
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+
+	data := &nrpb.NRData{
+		Period:      3,
+		StartHeight: 31,
+		EndHeight:   40,
+		Items: []*nrpb.NRItem{
+			{Address: []byte{0x01}, Score: 0.6, InValue: "10", OutValue: "0", InDegree: 1},
+			{Address: []byte{0x02}, Score: 0.4, InValue: "0", OutValue: "10", OutDegree: 1},
+		},
+	}
+	assert.Nil(t, s.storeNRData(data))
+
+	got, err := s.GetNRByPeriod(3)
+	assert.Nil(t, err)
+	assert.Equal(t, data.String(), got.String())
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/nebulasio/go-nebulas/crypto/keystore"
 	"github.com/nebulasio/go-nebulas/net"
 	"github.com/nebulasio/go-nebulas/rpc/pb"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
 	"golang.org/x/net/context"
 )
 
@@ -235,3 +236,42 @@ func (s *AdminService) NodeInfo(ctx context.Context, req *rpcpb.NonParamsRequest
 
 	return resp, nil
 }
+
+// TraceTransaction is the RPC API handler.
+func (s *AdminService) TraceTransaction(ctx context.Context, req *rpcpb.GetTransactionByHashRequest) (*rpcpb.TraceTransactionResponse, error) {
+	neb := s.server.Neblet()
+
+	hash, err := byteutils.FromHex(req.Hash)
+	if err != nil {
+		return nil, err
+	}
+
+	trace, err := neb.BlockChain().TraceTransaction(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcpb.TraceTransactionResponse{
+		Hash:         trace.Tx.Hash().String(),
+		BlockHash:    trace.Block.Hash().String(),
+		BlockHeight:  trace.Block.Height(),
+		Status:       int32(trace.Event.Status),
+		GasUsed:      trace.Event.GasUsed,
+		Result:       trace.Result,
+		ExecutionGas: trace.ExecutionGas,
+		Steps:        make([]*rpcpb.TraceStep, len(trace.Steps)),
+	}
+	if trace.Err != nil {
+		resp.ExecuteErr = trace.Err.Error()
+	}
+	for i, step := range trace.Steps {
+		resp.Steps[i] = &rpcpb.TraceStep{
+			Type:         step.Type,
+			Key:          step.Key,
+			Value:        step.Value,
+			Err:          step.Err,
+			Instructions: step.Instructions,
+		}
+	}
+	return resp, nil
+}
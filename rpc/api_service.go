@@ -28,8 +28,10 @@ import (
 	"encoding/json"
 
 	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/common/trie"
 	"github.com/nebulasio/go-nebulas/core"
 	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/core/proof"
 	"github.com/nebulasio/go-nebulas/net"
 	"github.com/nebulasio/go-nebulas/rpc/pb"
 	"github.com/nebulasio/go-nebulas/util"
@@ -40,6 +42,21 @@ import (
 //the max number of block can be dumped once
 const maxDumpBlockCount = 10
 
+// the max number of logs can be returned once
+const maxLogsCount = 10000
+
+// the max number of blocks can be searched for logs once
+const maxLogsBlockRange = 10000
+
+// the max number of items in a batch request
+const maxBatchCount = 1000
+
+// the default and max number of transactions can be listed once by address
+const (
+	defaultAddressTxsCount = 20
+	maxAddressTxsCount     = 100
+)
+
 // APIService implements the RPC API service interface.
 type APIService struct {
 	server GRPCServer
@@ -67,22 +84,22 @@ func (s *APIService) GetNebState(ctx context.Context, req *rpcpb.NonParamsReques
 
 // GetAccountState is the RPC API handler.
 func (s *APIService) GetAccountState(ctx context.Context, req *rpcpb.GetAccountStateRequest) (*rpcpb.GetAccountStateResponse, error) {
-
 	neb := s.server.Neblet()
+	return accountState(neb, neb.BlockChain().TailBlock(), req)
+}
 
+// accountState return the account state at the block of hash or height, or the tail if neither is given.
+func accountState(neb core.Neblet, tail *core.Block, req *rpcpb.GetAccountStateRequest) (*rpcpb.GetAccountStateResponse, error) {
 	addr, err := core.AddressParse(req.Address)
 	if err != nil {
 		metricsAccountStateFailed.Mark(1)
 		return nil, err
 	}
 
-	block := neb.BlockChain().TailBlock()
-	if req.Height > 0 {
-		block = neb.BlockChain().GetBlockOnCanonicalChainByHeight(req.Height)
-		if block == nil {
-			metricsAccountStateFailed.Mark(1)
-			return nil, errors.New("block not found")
-		}
+	block, err := stateBlock(neb, tail, req.Height, req.BlockHash)
+	if err != nil {
+		metricsAccountStateFailed.Mark(1)
+		return nil, err
 	}
 
 	acc, err := block.GetAccount(addr.Bytes())
@@ -94,15 +111,45 @@ func (s *APIService) GetAccountState(ctx context.Context, req *rpcpb.GetAccountS
 	return &rpcpb.GetAccountStateResponse{Balance: acc.Balance().String(), Nonce: acc.Nonce(), Type: uint32(addr.Type())}, nil
 }
 
-// Call is the RPC API handler.
-func (s *APIService) Call(ctx context.Context, req *rpcpb.TransactionRequest) (*rpcpb.CallResponse, error) {
-	neb := s.server.Neblet()
+// stateBlock return the canonical block of hash or height whose state is still available, or tail if neither is given.
+func stateBlock(neb core.Neblet, tail *core.Block, height uint64, hash string) (*core.Block, error) {
+	block := tail
+	if len(hash) > 0 {
+		bhash, err := byteutils.FromHex(hash)
+		if err != nil {
+			return nil, err
+		}
+		block = neb.BlockChain().GetBlockOnCanonicalChainByHash(bhash)
+	} else if height > 0 {
+		block = neb.BlockChain().GetBlockOnCanonicalChainByHeight(height)
+	}
+	if block == nil {
+		return nil, errors.New("block not found")
+	}
+	if block.StatePruned() {
+		return nil, core.ErrStatePruned
+	}
+	return block, nil
+}
+
+// simulateTransaction execute the transaction request on top of the requested block.
+func simulateTransaction(neb core.Neblet, req *rpcpb.TransactionRequest) (*core.SimulateResult, error) {
 	tx, err := parseTransaction(neb, req)
 	if err != nil {
 		return nil, err
 	}
 
-	result, err := neb.BlockChain().SimulateTransactionExecution(tx)
+	block, err := stateBlock(neb, neb.BlockChain().TailBlock(), req.Height, req.BlockHash)
+	if err != nil {
+		return nil, err
+	}
+	return neb.BlockChain().SimulateTransactionExecutionAt(tx, block)
+}
+
+// Call is the RPC API handler.
+func (s *APIService) Call(ctx context.Context, req *rpcpb.TransactionRequest) (*rpcpb.CallResponse, error) {
+	neb := s.server.Neblet()
+	result, err := simulateTransaction(neb, req)
 	if err != nil {
 		return nil, err
 	}
@@ -147,9 +194,21 @@ func parseTransaction(neb core.Neblet, reqTx *rpcpb.TransactionRequest) (*core.T
 	)
 
 	if reqTx.Contract != nil {
-		if len(reqTx.Contract.Source) > 0 && len(reqTx.Contract.Function) == 0 { // TODO: reqTx.DeployContract, reqTx.CallContract
+		if reqTx.Contract.Upgrade {
+			if len(reqTx.Contract.Function) > 0 {
+				return nil, errors.New("invalid contract")
+			}
+			payloadType = core.TxPayloadUpgradeType
+			upgradePayload, err := core.NewUpgradePayload(reqTx.Contract.Source, reqTx.Contract.SourceType, reqTx.Contract.Abi)
+			if err != nil {
+				return nil, err
+			}
+			if payload, err = upgradePayload.ToBytes(); err != nil {
+				return nil, err
+			}
+		} else if len(reqTx.Contract.Source) > 0 && len(reqTx.Contract.Function) == 0 { // TODO: reqTx.DeployContract, reqTx.CallContract
 			payloadType = core.TxPayloadDeployType
-			payloadObj, err := core.NewDeployPayload(reqTx.Contract.Source, reqTx.Contract.SourceType, reqTx.Contract.Args)
+			payloadObj, err := core.NewDeployPayload(reqTx.Contract.Source, reqTx.Contract.SourceType, reqTx.Contract.Args, reqTx.Contract.Abi, reqTx.Contract.Upgradable)
 			if err != nil {
 				return nil, err
 			}
@@ -169,6 +228,24 @@ func parseTransaction(neb core.Neblet, reqTx *rpcpb.TransactionRequest) (*core.T
 		} else {
 			return nil, errors.New("invalid contract")
 		}
+	} else if reqTx.Candidate != nil {
+		payloadType = core.TxPayloadCandidateType
+		candidatePayload, err := core.NewCandidatePayload(reqTx.Candidate.Action)
+		if err != nil {
+			return nil, err
+		}
+		if payload, err = candidatePayload.ToBytes(); err != nil {
+			return nil, err
+		}
+	} else if reqTx.Delegate != nil {
+		payloadType = core.TxPayloadDelegateType
+		delegatePayload, err := core.NewDelegatePayload(reqTx.Delegate.Action, reqTx.Delegate.Delegatee)
+		if err != nil {
+			return nil, err
+		}
+		if payload, err = delegatePayload.ToBytes(); err != nil {
+			return nil, err
+		}
 	} else {
 		payloadType = core.TxPayloadBinaryType
 		if payload, err = core.NewBinaryPayload(reqTx.Binary).ToBytes(); err != nil {
@@ -184,6 +261,11 @@ func parseTransaction(neb core.Neblet, reqTx *rpcpb.TransactionRequest) (*core.T
 }
 
 func handleTransactionResponse(neb core.Neblet, tx *core.Transaction) (resp *rpcpb.SendTransactionResponse, err error) {
+	return sendTransaction(neb, neb.BlockChain().TailBlock(), tx)
+}
+
+// sendTransaction verify tx against the tail block, then push and broadcast it.
+func sendTransaction(neb core.Neblet, tailBlock *core.Block, tx *core.Transaction) (resp *rpcpb.SendTransactionResponse, err error) {
 	defer func() {
 		if err != nil {
 			metricsSendTxFailed.Mark(1)
@@ -197,7 +279,6 @@ func handleTransactionResponse(neb core.Neblet, tx *core.Transaction) (resp *rpc
 		return nil, err
 	}
 
-	tailBlock := neb.BlockChain().TailBlock()
 	acc, err := tailBlock.GetAccount(tx.From().Bytes())
 	if err != nil {
 		return nil, err
@@ -211,7 +292,7 @@ func handleTransactionResponse(neb core.Neblet, tx *core.Transaction) (resp *rpc
 		if !tx.From().Equals(tx.To()) {
 			return nil, core.ErrContractTransactionAddressNotEqual
 		}
-	} else if tx.Type() == core.TxPayloadCallType {
+	} else if tx.Type() == core.TxPayloadCallType || tx.Type() == core.TxPayloadUpgradeType {
 		if _, err := tailBlock.CheckContract(tx.To()); err != nil {
 			return nil, err
 		}
@@ -240,18 +321,25 @@ func (s *APIService) SendRawTransaction(ctx context.Context, req *rpcpb.SendRawT
 	// Validate and sign the tx, then submit it to the tx pool.
 	neb := s.server.Neblet()
 
-	pbTx := new(corepb.Transaction)
-	if err := proto.Unmarshal(req.GetData(), pbTx); err != nil {
+	tx, err := parseRawTransaction(req.GetData())
+	if err != nil {
 		metricsSendTxFailed.Mark(1)
 		return nil, err
 	}
+
+	return handleTransactionResponse(neb, tx)
+}
+
+func parseRawTransaction(data []byte) (*core.Transaction, error) {
+	pbTx := new(corepb.Transaction)
+	if err := proto.Unmarshal(data, pbTx); err != nil {
+		return nil, err
+	}
 	tx := new(core.Transaction)
 	if err := tx.FromProto(pbTx); err != nil {
-		metricsSendTxFailed.Mark(1)
 		return nil, err
 	}
-
-	return handleTransactionResponse(neb, tx)
+	return tx, nil
 }
 
 // GetBlockByHash get block info by the block hash
@@ -306,10 +394,11 @@ func (s *APIService) toBlockResponse(block *core.Block, fullFillTransaction bool
 
 	// add block transactions
 	txs := []*rpcpb.TransactionResponse{}
+	tail := neb.BlockChain().TailBlock()
 	for _, v := range block.Transactions() {
 		var tx *rpcpb.TransactionResponse
 		if fullFillTransaction {
-			tx, _ = s.toTransactionResponse(v)
+			tx, _ = toTransactionResponse(tail, v)
 		} else {
 			tx = &rpcpb.TransactionResponse{Hash: v.Hash().String()}
 		}
@@ -333,11 +422,16 @@ func (s *APIService) LatestIrreversibleBlock(ctx context.Context, req *rpcpb.Non
 func (s *APIService) GetTransactionReceipt(ctx context.Context, req *rpcpb.GetTransactionByHashRequest) (*rpcpb.TransactionResponse, error) {
 
 	neb := s.server.Neblet()
-	hash, err := byteutils.FromHex(req.GetHash())
+	return transactionReceipt(neb, neb.BlockChain().TailBlock(), req.GetHash())
+}
+
+// transactionReceipt return the transaction on tail block or in pool with its execution result.
+func transactionReceipt(neb core.Neblet, tail *core.Block, hex string) (*rpcpb.TransactionResponse, error) {
+	hash, err := byteutils.FromHex(hex)
 	if err != nil {
 		return nil, err
 	}
-	tx, err := neb.BlockChain().GetTransaction(hash)
+	tx, err := tail.GetTransaction(hash)
 	if err != nil && err != storage.ErrKeyNotFound {
 		return nil, err
 	}
@@ -350,16 +444,16 @@ func (s *APIService) GetTransactionReceipt(ctx context.Context, req *rpcpb.GetTr
 		}
 	}
 
-	return s.toTransactionResponse(tx)
+	return toTransactionResponse(tail, tx)
 }
 
-func (s *APIService) toTransactionResponse(tx *core.Transaction) (*rpcpb.TransactionResponse, error) {
+// toTransactionResponse return tx with its execution result on tail block.
+func toTransactionResponse(tail *core.Block, tx *core.Transaction) (*rpcpb.TransactionResponse, error) {
 	var (
 		status  int32
 		gasUsed string
 	)
-	neb := s.server.Neblet()
-	event, err := neb.BlockChain().TailBlock().FetchExecutionResultEvent(tx.Hash())
+	event, err := tail.FetchExecutionResultEvent(tx.Hash())
 	if err != nil && err != core.ErrNotFoundTransactionResultEvent {
 		return nil, err
 	}
@@ -375,7 +469,10 @@ func (s *APIService) toTransactionResponse(tx *core.Transaction) (*rpcpb.Transac
 	} else {
 		status = core.TxExecutionPendding
 	}
+	return newTransactionResponse(tx, status, gasUsed)
+}
 
+func newTransactionResponse(tx *core.Transaction, status int32, gasUsed string) (*rpcpb.TransactionResponse, error) {
 	resp := &rpcpb.TransactionResponse{
 		ChainId:   tx.ChainID(),
 		Hash:      tx.Hash().String(),
@@ -407,40 +504,172 @@ func (s *APIService) Subscribe(req *rpcpb.SubscribeRequest, gs rpcpb.ApiService_
 
 	neb := s.server.Neblet()
 
+	filter, err := parseEventFilter(req)
+	if err != nil {
+		return err
+	}
+
 	eventSub := core.NewEventSubscriber(1024, req.Topics)
-	neb.EventEmitter().Register(eventSub)
-	defer neb.EventEmitter().Deregister(eventSub)
 
-	var err error
+	// replay the stored events until caught up with tail, then register and replay the blocks
+	// linked meanwhile, whose events may also be received from subscriber.
+	replayed := make(map[byteutils.HexHash]bool)
+	if req.FromHeight > 0 {
+		next, err := replayEvents(neb, gs, req, filter, req.FromHeight, nil)
+		if err != nil {
+			return err
+		}
+		neb.EventEmitter().Register(eventSub)
+		defer neb.EventEmitter().Deregister(eventSub)
+		if _, err := replayEvents(neb, gs, req, filter, next, replayed); err != nil {
+			return err
+		}
+	} else {
+		neb.EventEmitter().Register(eventSub)
+		defer neb.EventEmitter().Deregister(eventSub)
+	}
+
+	var last *rpcpb.SubscribeResponse
+	send := func(event *core.ChainEvent) error {
+		if event.Block != nil && replayed[event.Block.Hash().Hex()] {
+			return nil
+		}
+		if !filter.Match(event) {
+			return nil
+		}
+		resp := toSubscribeResponse(event)
+		if err := gs.Send(resp); err != nil {
+			return err
+		}
+		last = resp
+		return nil
+	}
+
 	for {
 		select {
 		case <-gs.Context().Done():
 			return gs.Context().Err()
 		case event := <-eventSub.EventChan():
-			err = gs.Send(&rpcpb.SubscribeResponse{Topic: event.Topic, Data: event.Data})
-			if err != nil {
+			if err := send(event); err != nil {
 				return err
 			}
+		case <-eventSub.OverflowChan():
+			// deliver the events received before overflow.
+			for len(eventSub.EventChan()) > 0 {
+				if err := send(<-eventSub.EventChan()); err != nil {
+					return err
+				}
+			}
+			// the cursor of the event next to the last delivered one.
+			resp := &rpcpb.SubscribeResponse{Overflow: true}
+			if last != nil {
+				resp.Height, resp.Index = last.Height, last.Index+1
+			}
+			if err := gs.Send(resp); err != nil {
+				return err
+			}
+			return ErrSubscriptionOverflow
+		}
+	}
+}
+
+func parseEventFilter(req *rpcpb.SubscribeRequest) (*core.EventFilter, error) {
+	filter := &core.EventFilter{Fields: make(map[string]string)}
+	var err error
+	if len(req.Contract) > 0 {
+		if filter.Contract, err = core.AddressParse(req.Contract); err != nil {
+			return nil, err
+		}
+	}
+	if len(req.From) > 0 {
+		if filter.From, err = core.AddressParse(req.From); err != nil {
+			return nil, err
+		}
+	}
+	if len(req.To) > 0 {
+		if filter.To, err = core.AddressParse(req.To); err != nil {
+			return nil, err
+		}
+	}
+	for _, f := range req.Fields {
+		filter.Fields[f.Field] = f.Value
+	}
+	return filter, nil
+}
+
+// replayEvents send the matched events of blocks on canonical chain from the height to tail,
+// record the replayed blocks if replayed is not nil, return the next height to replay.
+func replayEvents(neb core.Neblet, gs rpcpb.ApiService_SubscribeServer, req *rpcpb.SubscribeRequest,
+	filter *core.EventFilter, height uint64, replayed map[byteutils.HexHash]bool) (uint64, error) {
+	topics := make(map[string]bool)
+	for _, topic := range req.Topics {
+		topics[topic] = true
+	}
+
+	tail := neb.BlockChain().TailBlock()
+	for ; height <= tail.Height(); height++ {
+		block := neb.BlockChain().GetBlockOnCanonicalChainByHeight(height)
+		if block == nil {
+			return 0, errors.New("block not found")
+		}
+		if block.StatePruned() {
+			return 0, core.ErrStatePruned
+		}
+		events, err := core.BlockEvents(block)
+		if err != nil {
+			return 0, err
+		}
+		for _, event := range events {
+			if height == req.FromHeight && event.Index < req.FromIndex {
+				continue
+			}
+			if !topics[event.Topic] || !filter.Match(event) {
+				continue
+			}
+			if err := gs.Send(toSubscribeResponse(event)); err != nil {
+				return 0, err
+			}
+		}
+		if replayed != nil {
+			replayed[block.Hash().Hex()] = true
 		}
 	}
+	return height, nil
+}
+
+func toSubscribeResponse(event *core.ChainEvent) *rpcpb.SubscribeResponse {
+	resp := &rpcpb.SubscribeResponse{Topic: event.Topic, Data: event.Data}
+	if event.Block != nil {
+		resp.Height = event.Block.Height()
+		resp.Index = event.Index
+		resp.BlockHash = event.Block.Hash().String()
+	}
+	if event.Tx != nil {
+		resp.TxHash = event.Tx.Hash().String()
+	}
+	return resp
 }
 
 // GetGasPrice get gas price from chain.
 func (s *APIService) GetGasPrice(ctx context.Context, req *rpcpb.NonParamsRequest) (*rpcpb.GasPriceResponse, error) {
 	neb := s.server.Neblet()
-	gasPrice := neb.BlockChain().GasPrice()
-	return &rpcpb.GasPriceResponse{GasPrice: gasPrice.String()}, nil
+	suggestion := neb.BlockChain().GasPriceOracle().Suggest()
+	return &rpcpb.GasPriceResponse{
+		GasPrice:     suggestion.Standard.String(),
+		Slow:         suggestion.Slow.String(),
+		Standard:     suggestion.Standard.String(),
+		Fast:         suggestion.Fast.String(),
+		Blocks:       uint32(suggestion.Blocks),
+		Transactions: uint32(suggestion.Transactions),
+		Pending:      uint32(suggestion.Pending),
+		PoolCapacity: uint32(suggestion.PoolCapacity),
+	}, nil
 }
 
 // EstimateGas Compute the smart contract gas consumption.
 func (s *APIService) EstimateGas(ctx context.Context, req *rpcpb.TransactionRequest) (*rpcpb.GasResponse, error) {
 	neb := s.server.Neblet()
-	tx, err := parseTransaction(neb, req)
-	if err != nil {
-		return nil, err
-	}
-
-	result, err := neb.BlockChain().SimulateTransactionExecution(tx)
+	result, err := simulateTransaction(neb, req)
 	if err != nil {
 		return nil, err
 	}
@@ -495,6 +724,9 @@ func (s *APIService) GetDynasty(ctx context.Context, req *rpcpb.ByBlockHeightReq
 		if block == nil {
 			return nil, errors.New("block not found")
 		}
+		if block.StatePruned() {
+			return nil, core.ErrStatePruned
+		}
 	}
 
 	miners, err := block.Dynasty()
@@ -516,3 +748,438 @@ func (s *APIService) GetDynasty(ctx context.Context, req *rpcpb.ByBlockHeightReq
 	}
 	return &rpcpb.GetDynastyResponse{Miners: result}, nil
 }
+
+// GetNebulasRank is the RPC API handler.
+func (s *APIService) GetNebulasRank(ctx context.Context, req *rpcpb.GetNebulasRankRequest) (*rpcpb.GetNebulasRankResponse, error) {
+	neb := s.server.Neblet()
+
+	var addr *core.Address
+	if len(req.Address) > 0 {
+		var err error
+		if addr, err = core.AddressParse(req.Address); err != nil {
+			return nil, err
+		}
+	}
+
+	data, err := neb.Nr().GetNRByPeriod(req.Period)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcpb.GetNebulasRankResponse{
+		Period:      data.Period,
+		StartHeight: data.StartHeight,
+		EndHeight:   data.EndHeight,
+		Ranks:       []*rpcpb.NebulasRank{},
+	}
+	for i, item := range data.Items {
+		itemAddr, err := core.AddressParseFromBytes(item.Address)
+		if err != nil {
+			return nil, err
+		}
+		if addr != nil && !addr.Equals(itemAddr) {
+			continue
+		}
+		resp.Ranks = append(resp.Ranks, &rpcpb.NebulasRank{
+			Address:  itemAddr.String(),
+			Rank:     uint64(i + 1),
+			Score:    item.Score,
+			InValue:  item.InValue,
+			OutValue: item.OutValue,
+		})
+	}
+	return resp, nil
+}
+
+// GetProof is the RPC API handler.
+func (s *APIService) GetProof(ctx context.Context, req *rpcpb.GetProofRequest) (*rpcpb.GetProofResponse, error) {
+	neb := s.server.Neblet()
+
+	addr, err := core.AddressParse(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	block := neb.BlockChain().TailBlock()
+	if req.Height > 0 {
+		block = neb.BlockChain().GetBlockOnCanonicalChainByHeight(req.Height)
+		if block == nil {
+			return nil, errors.New("block not found")
+		}
+		if block.StatePruned() {
+			return nil, core.ErrStatePruned
+		}
+	}
+
+	accTrie, err := trie.NewTrie(block.StateRoot(), neb.BlockChain().Storage(), false)
+	if err != nil {
+		return nil, err
+	}
+	accProof, err := accTrie.Prove(addr.Bytes())
+	if err != nil {
+		if err == trie.ErrNotFound {
+			return nil, errors.New("account not found")
+		}
+		return nil, err
+	}
+	accNodes, err := proof.EncodeProof(accProof)
+	if err != nil {
+		return nil, err
+	}
+	account, err := proof.VerifyAccount(block.StateRoot(), addr.Bytes(), accNodes)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcpb.GetProofResponse{
+		BlockHash:     block.Hash().String(),
+		Height:        block.Height(),
+		StateRoot:     block.StateRoot().String(),
+		AccountProof:  accNodes,
+		StorageProofs: []*rpcpb.StorageProof{},
+	}
+	if len(req.Keys) == 0 {
+		return resp, nil
+	}
+
+	varsTrie, err := trie.NewTrie(account.VarsHash, neb.BlockChain().Storage(), false)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range req.Keys {
+		storageProof := &rpcpb.StorageProof{Key: key}
+		resp.StorageProofs = append(resp.StorageProofs, storageProof)
+
+		varsProof, err := varsTrie.Prove(proof.StorageKey(key))
+		if err == trie.ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if storageProof.Proof, err = proof.EncodeProof(varsProof); err != nil {
+			return nil, err
+		}
+		if storageProof.Value, err = varsTrie.Get(proof.StorageKey(key)); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+// GetLogs is the RPC API handler.
+func (s *APIService) GetLogs(ctx context.Context, req *rpcpb.GetLogsRequest) (*rpcpb.GetLogsResponse, error) {
+	neb := s.server.Neblet()
+
+	filter := &core.LogFilter{
+		FromHeight: req.FromHeight,
+		ToHeight:   req.ToHeight,
+		Topics:     req.Topics,
+	}
+	for _, contract := range req.Contracts {
+		addr, err := core.AddressParse(contract)
+		if err != nil {
+			return nil, err
+		}
+		filter.Contracts = append(filter.Contracts, addr)
+	}
+
+	events, err := neb.BlockChain().GetLogs(filter, maxLogsBlockRange, maxLogsCount)
+	if err != nil {
+		return nil, err
+	}
+	resp := &rpcpb.GetLogsResponse{Logs: []*rpcpb.EventLog{}}
+	for _, e := range events {
+		log := &rpcpb.EventLog{
+			Topic:     e.Topic,
+			Data:      e.Data,
+			Height:    e.Block.Height(),
+			Index:     e.Index,
+			BlockHash: e.Block.Hash().String(),
+		}
+		if e.Tx != nil {
+			log.TxHash = e.Tx.Hash().String()
+		}
+		if contract := e.Contract(); contract != nil {
+			log.Contract = contract.String()
+		}
+		resp.Logs = append(resp.Logs, log)
+	}
+	return resp, nil
+}
+
+// GetTransactionsByAddress is the RPC API handler.
+func (s *APIService) GetTransactionsByAddress(ctx context.Context, req *rpcpb.GetTransactionsByAddressRequest) (*rpcpb.GetTransactionsByAddressResponse, error) {
+	neb := s.server.Neblet()
+	if !neb.Config().Chain.EnableAddressIndex {
+		return nil, ErrAddressIndexDisabled
+	}
+
+	addr, err := core.AddressParse(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	var cursor []byte
+	if len(req.Cursor) > 0 {
+		if cursor, err = byteutils.FromHex(req.Cursor); err != nil {
+			return nil, err
+		}
+	}
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = defaultAddressTxsCount
+	}
+	if limit > maxAddressTxsCount {
+		limit = maxAddressTxsCount
+	}
+
+	txs, last, err := neb.BlockChain().AddressIndexer().GetTransactions(addr, cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+	resp := &rpcpb.GetTransactionsByAddressResponse{
+		Transactions: []*rpcpb.AddressTransaction{},
+		Cursor:       byteutils.Hex(last),
+	}
+	tail := neb.BlockChain().TailBlock()
+	for _, addrTx := range txs {
+		tx, err := tail.GetTransaction(addrTx.Hash)
+		if err == storage.ErrKeyNotFound {
+			// the block is reverted but not removed from index yet.
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		txResp, err := toTransactionResponse(tail, tx)
+		if err != nil {
+			return nil, err
+		}
+		resp.Transactions = append(resp.Transactions, &rpcpb.AddressTransaction{
+			Height:      addrTx.Height,
+			Index:       addrTx.Index,
+			Transaction: txResp,
+		})
+	}
+	return resp, nil
+}
+
+// GetPendingTransactions is the RPC API handler.
+func (s *APIService) GetPendingTransactions(ctx context.Context, req *rpcpb.GetPendingTransactionsRequest) (*rpcpb.GetPendingTransactionsResponse, error) {
+	neb := s.server.Neblet()
+
+	var from *core.Address
+	if len(req.Address) > 0 {
+		addr, err := core.AddressParse(req.Address)
+		if err != nil {
+			return nil, err
+		}
+		from = addr
+	}
+
+	txs, err := neb.BlockChain().TransactionPool().PendingTransactions(from)
+	if err != nil {
+		return nil, err
+	}
+	resp := &rpcpb.GetPendingTransactionsResponse{Transactions: []*rpcpb.PendingTransaction{}}
+	for _, tx := range txs {
+		pending, err := toPendingTransaction(tx.Transaction, tx.Status)
+		if err != nil {
+			return nil, err
+		}
+		resp.Transactions = append(resp.Transactions, pending)
+	}
+	return resp, nil
+}
+
+// GetPendingTransaction is the RPC API handler.
+func (s *APIService) GetPendingTransaction(ctx context.Context, req *rpcpb.GetTransactionByHashRequest) (*rpcpb.PendingTransaction, error) {
+	neb := s.server.Neblet()
+	hash, err := byteutils.FromHex(req.GetHash())
+	if err != nil {
+		return nil, err
+	}
+	tx, status, err := neb.BlockChain().TransactionPool().PendingTransactionStatus(hash)
+	if err != nil {
+		return nil, err
+	}
+	if tx == nil {
+		return nil, errors.New("transaction not found in pool")
+	}
+	return toPendingTransaction(tx, status)
+}
+
+func toPendingTransaction(tx *core.Transaction, status string) (*rpcpb.PendingTransaction, error) {
+	txResp, err := newTransactionResponse(tx, core.TxExecutionPendding, "")
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.PendingTransaction{Transaction: txResp, Status: status}, nil
+}
+
+// GetTxPoolStats is the RPC API handler.
+func (s *APIService) GetTxPoolStats(ctx context.Context, req *rpcpb.NonParamsRequest) (*rpcpb.TxPoolStatsResponse, error) {
+	neb := s.server.Neblet()
+	stats, err := neb.BlockChain().TransactionPool().Stats()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcpb.TxPoolStatsResponse{
+		Size:       uint32(stats.Size),
+		Capacity:   uint32(stats.Capacity),
+		Buckets:    uint32(stats.Buckets),
+		Candidates: uint32(stats.Candidates),
+		Senders:    []*rpcpb.TxPoolSender{},
+	}
+	for _, sender := range stats.Senders {
+		resp.Senders = append(resp.Senders, &rpcpb.TxPoolSender{
+			Address:      sender.Address.String(),
+			AccountNonce: sender.AccountNonce,
+			Count:        uint32(sender.Count),
+			MinNonce:     sender.MinNonce,
+			MaxNonce:     sender.MaxNonce,
+			Gaps:         sender.Gaps,
+		})
+	}
+	return resp, nil
+}
+
+// SendRawTransactions is the RPC API handler.
+func (s *APIService) SendRawTransactions(ctx context.Context, req *rpcpb.SendRawTransactionsRequest) (*rpcpb.SendRawTransactionsResponse, error) {
+	if len(req.Data) > maxBatchCount {
+		return nil, ErrBatchOutOfLimit
+	}
+
+	neb := s.server.Neblet()
+	tail := neb.BlockChain().TailBlock()
+	resp := &rpcpb.SendRawTransactionsResponse{
+		Results: make([]*rpcpb.SendTransactionResult, 0, len(req.Data)),
+		Tail:    tail.Hash().String(),
+		Height:  tail.Height(),
+	}
+	for _, data := range req.Data {
+		result := &rpcpb.SendTransactionResult{}
+		tx, err := parseRawTransaction(data)
+		if err == nil {
+			result.Result, err = sendTransaction(neb, tail, tx)
+		} else {
+			metricsSendTxFailed.Mark(1)
+		}
+		if err != nil {
+			result.Error = err.Error()
+		}
+		resp.Results = append(resp.Results, result)
+	}
+	return resp, nil
+}
+
+// GetAccountStates is the RPC API handler.
+func (s *APIService) GetAccountStates(ctx context.Context, req *rpcpb.GetAccountStatesRequest) (*rpcpb.GetAccountStatesResponse, error) {
+	if len(req.Requests) > maxBatchCount {
+		return nil, ErrBatchOutOfLimit
+	}
+
+	neb := s.server.Neblet()
+	tail := neb.BlockChain().TailBlock()
+	resp := &rpcpb.GetAccountStatesResponse{
+		Results: make([]*rpcpb.AccountStateResult, 0, len(req.Requests)),
+		Tail:    tail.Hash().String(),
+		Height:  tail.Height(),
+	}
+	for _, r := range req.Requests {
+		result := &rpcpb.AccountStateResult{}
+		var err error
+		if result.Result, err = accountState(neb, tail, r); err != nil {
+			result.Error = err.Error()
+		}
+		resp.Results = append(resp.Results, result)
+	}
+	return resp, nil
+}
+
+// GetTransactionReceipts is the RPC API handler.
+func (s *APIService) GetTransactionReceipts(ctx context.Context, req *rpcpb.GetTransactionReceiptsRequest) (*rpcpb.GetTransactionReceiptsResponse, error) {
+	if len(req.Hashes) > maxBatchCount {
+		return nil, ErrBatchOutOfLimit
+	}
+
+	neb := s.server.Neblet()
+	tail := neb.BlockChain().TailBlock()
+	resp := &rpcpb.GetTransactionReceiptsResponse{
+		Results: make([]*rpcpb.TransactionReceiptResult, 0, len(req.Hashes)),
+		Tail:    tail.Hash().String(),
+		Height:  tail.Height(),
+	}
+	for _, hash := range req.Hashes {
+		result := &rpcpb.TransactionReceiptResult{}
+		var err error
+		if result.Result, err = transactionReceipt(neb, tail, hash); err != nil {
+			result.Error = err.Error()
+		}
+		resp.Results = append(resp.Results, result)
+	}
+	return resp, nil
+}
+
+// GetContractVersions is the RPC API handler.
+func (s *APIService) GetContractVersions(ctx context.Context, req *rpcpb.GetContractVersionsRequest) (*rpcpb.GetContractVersionsResponse, error) {
+	neb := s.server.Neblet()
+
+	addr, err := core.AddressParse(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	block, err := stateBlock(neb, neb.BlockChain().TailBlock(), req.Height, req.BlockHash)
+	if err != nil {
+		return nil, err
+	}
+	versions, err := block.ContractVersions(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcpb.GetContractVersionsResponse{
+		Versions: make([]*rpcpb.ContractVersion, 0, len(versions)),
+	}
+	for _, v := range versions {
+		resp.Versions = append(resp.Versions, &rpcpb.ContractVersion{
+			Version:   v.Version,
+			Hash:      v.Hash,
+			From:      v.From,
+			Timestamp: v.Timestamp,
+		})
+	}
+	return resp, nil
+}
+
+// GetContractABI is the RPC API handler.
+func (s *APIService) GetContractABI(ctx context.Context, req *rpcpb.GetContractABIRequest) (*rpcpb.GetContractABIResponse, error) {
+	neb := s.server.Neblet()
+
+	addr, err := core.AddressParse(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	block, err := stateBlock(neb, neb.BlockChain().TailBlock(), req.Height, req.BlockHash)
+	if err != nil {
+		return nil, err
+	}
+	abi, err := block.ContractABI(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcpb.GetContractABIResponse{
+		Functions: make([]*rpcpb.ContractFunction, 0, len(abi.Functions)),
+	}
+	for _, fn := range abi.Functions {
+		resp.Functions = append(resp.Functions, &rpcpb.ContractFunction{
+			Name:     fn.Name,
+			Args:     fn.Args,
+			ReadOnly: fn.ReadOnly,
+			Payable:  fn.Payable,
+		})
+	}
+	return resp, nil
+}
@@ -48,6 +48,43 @@ It has these top-level messages:
 	PprofRequest
 	PprofResponse
 	GetConfigResponse
+	CandidateRequest
+	DelegateRequest
+	GetNebulasRankRequest
+	GetNebulasRankResponse
+	NebulasRank
+	GetProofRequest
+	GetProofResponse
+	StorageProof
+	EventFieldFilter
+	GetLogsRequest
+	GetLogsResponse
+	EventLog
+	GetTransactionsByAddressRequest
+	GetTransactionsByAddressResponse
+	AddressTransaction
+	GetPendingTransactionsRequest
+	GetPendingTransactionsResponse
+	PendingTransaction
+	TxPoolStatsResponse
+	TxPoolSender
+	SendRawTransactionsRequest
+	SendRawTransactionsResponse
+	SendTransactionResult
+	GetAccountStatesRequest
+	GetAccountStatesResponse
+	AccountStateResult
+	GetTransactionReceiptsRequest
+	GetTransactionReceiptsResponse
+	TransactionReceiptResult
+	TraceTransactionResponse
+	TraceStep
+	GetContractVersionsRequest
+	GetContractVersionsResponse
+	ContractVersion
+	GetContractABIRequest
+	GetContractABIResponse
+	ContractFunction
 */
 package rpcpb
 
@@ -77,6 +114,17 @@ const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 // Request message of Subscribe rpc
 type SubscribeRequest struct {
 	Topics []string `protobuf:"bytes,1,rep,name=topics" json:"topics,omitempty"`
+	// Hex string of the contract address, only return the events of transactions calling it.
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// Hex string of the from and to address of the transactions emitting events.
+	From string `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To   string `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	// Filters on the fields of the JSON data of events.
+	Fields []*EventFieldFilter `protobuf:"bytes,5,rep,name=fields" json:"fields,omitempty"`
+	// Resume cursor, replay the events since the block height and event index in block first.
+	// If not specified, only return new events.
+	FromHeight uint64 `protobuf:"varint,6,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	FromIndex  uint64 `protobuf:"varint,7,opt,name=from_index,json=fromIndex,proto3" json:"from_index,omitempty"`
 }
 
 func (m *SubscribeRequest) Reset()                    { *m = SubscribeRequest{} }
@@ -91,10 +139,61 @@ func (m *SubscribeRequest) GetTopics() []string {
 	return nil
 }
 
+func (m *SubscribeRequest) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *SubscribeRequest) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *SubscribeRequest) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *SubscribeRequest) GetFields() []*EventFieldFilter {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+func (m *SubscribeRequest) GetFromHeight() uint64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *SubscribeRequest) GetFromIndex() uint64 {
+	if m != nil {
+		return m.FromIndex
+	}
+	return 0
+}
+
 // Request message of Subscribe rpc
 type SubscribeResponse struct {
 	Topic string `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
 	Data  string `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// Cursor of the event, the block height and event index in block. 0 height for events not in blocks.
+	Height uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Index  uint64 `protobuf:"varint,4,opt,name=index,proto3" json:"index,omitempty"`
+	// Hex string of the block hash and transaction hash emitting the event.
+	BlockHash string `protobuf:"bytes,5,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	TxHash    string `protobuf:"bytes,6,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// Events are dropped since the client is too slow, the stream is closed after it.
+	// Resume with the cursor of this response, which is next to the last received event.
+	Overflow bool `protobuf:"varint,7,opt,name=overflow,proto3" json:"overflow,omitempty"`
 }
 
 func (m *SubscribeResponse) Reset()                    { *m = SubscribeResponse{} }
@@ -116,6 +215,41 @@ func (m *SubscribeResponse) GetData() string {
 	return ""
 }
 
+func (m *SubscribeResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *SubscribeResponse) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *SubscribeResponse) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *SubscribeResponse) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *SubscribeResponse) GetOverflow() bool {
+	if m != nil {
+		return m.Overflow
+	}
+	return false
+}
+
 // Request message of non params.
 type NonParamsRequest struct {
 }
@@ -325,6 +459,8 @@ type GetAccountStateRequest struct {
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	// block account state with height. If not specified, use 0 as tail height.
 	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// block account state with hash, takes precedence over height.
+	BlockHash string `protobuf:"bytes,3,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
 }
 
 func (m *GetAccountStateRequest) Reset()                    { *m = GetAccountStateRequest{} }
@@ -346,6 +482,13 @@ func (m *GetAccountStateRequest) GetHeight() uint64 {
 	return 0
 }
 
+func (m *GetAccountStateRequest) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
 // Response message of GetAccountState rpc.
 type GetAccountStateResponse struct {
 	// Current balance in unit of 1/(10^18) nas.
@@ -468,8 +611,16 @@ type TransactionRequest struct {
 	GasLimit string `protobuf:"bytes,6,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
 	// contract sending with this transaction
 	Contract *ContractRequest `protobuf:"bytes,7,opt,name=contract" json:"contract,omitempty"`
+	// candidate sending with this transaction
+	Candidate *CandidateRequest `protobuf:"bytes,8,opt,name=candidate" json:"candidate,omitempty"`
+	// delegate sending with this transaction
+	Delegate *DelegateRequest `protobuf:"bytes,9,opt,name=delegate" json:"delegate,omitempty"`
 	// binary data for transaction
 	Binary []byte `protobuf:"bytes,10,opt,name=binary,proto3" json:"binary,omitempty"`
+	// execute on top of the block with height, only used by Call and EstimateGas. If not specified, use tail.
+	Height uint64 `protobuf:"varint,11,opt,name=height,proto3" json:"height,omitempty"`
+	// execute on top of the block with hash, takes precedence over height.
+	BlockHash string `protobuf:"bytes,12,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
 }
 
 func (m *TransactionRequest) Reset()                    { *m = TransactionRequest{} }
@@ -526,6 +677,20 @@ func (m *TransactionRequest) GetContract() *ContractRequest {
 	return nil
 }
 
+func (m *TransactionRequest) GetCandidate() *CandidateRequest {
+	if m != nil {
+		return m.Candidate
+	}
+	return nil
+}
+
+func (m *TransactionRequest) GetDelegate() *DelegateRequest {
+	if m != nil {
+		return m.Delegate
+	}
+	return nil
+}
+
 func (m *TransactionRequest) GetBinary() []byte {
 	if m != nil {
 		return m.Binary
@@ -533,6 +698,20 @@ func (m *TransactionRequest) GetBinary() []byte {
 	return nil
 }
 
+func (m *TransactionRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *TransactionRequest) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
 type ContractRequest struct {
 	// contract source code.
 	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
@@ -542,6 +721,12 @@ type ContractRequest struct {
 	Function string `protobuf:"bytes,3,opt,name=function,proto3" json:"function,omitempty"`
 	// the params of contract.
 	Args string `protobuf:"bytes,4,opt,name=args,proto3" json:"args,omitempty"`
+	// replace the source of the contract at the to address, keeping its storage.
+	Upgrade bool `protobuf:"varint,5,opt,name=upgrade,proto3" json:"upgrade,omitempty"`
+	// json abi of the contract to deploy or upgrade, calls mismatching it are rejected.
+	Abi string `protobuf:"bytes,6,opt,name=abi,proto3" json:"abi,omitempty"`
+	// let the deployer upgrade the contract, contracts deployed without it cannot be upgraded.
+	Upgradable bool `protobuf:"varint,7,opt,name=upgradable,proto3" json:"upgradable,omitempty"`
 }
 
 func (m *ContractRequest) Reset()                    { *m = ContractRequest{} }
@@ -577,6 +762,27 @@ func (m *ContractRequest) GetArgs() string {
 	return ""
 }
 
+func (m *ContractRequest) GetUpgrade() bool {
+	if m != nil {
+		return m.Upgrade
+	}
+	return false
+}
+
+func (m *ContractRequest) GetAbi() string {
+	if m != nil {
+		return m.Abi
+	}
+	return ""
+}
+
+func (m *ContractRequest) GetUpgradable() bool {
+	if m != nil {
+		return m.Upgradable
+	}
+	return false
+}
+
 // Request message of SendRawTransactionRequest rpc.
 type SendRawTransactionRequest struct {
 	// Signed data of transaction
@@ -1194,7 +1400,18 @@ func (m *SendTransactionPassphraseRequest) GetPassphrase() string {
 }
 
 type GasPriceResponse struct {
+	// Same as standard.
 	GasPrice string `protobuf:"bytes,1,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
+	// Percentiles of the gas prices in recent blocks, raised when the transaction pool is more than half full.
+	Slow     string `protobuf:"bytes,2,opt,name=slow,proto3" json:"slow,omitempty"`
+	Standard string `protobuf:"bytes,3,opt,name=standard,proto3" json:"standard,omitempty"`
+	Fast     string `protobuf:"bytes,4,opt,name=fast,proto3" json:"fast,omitempty"`
+	// Count of sampled blocks and transactions.
+	Blocks       uint32 `protobuf:"varint,5,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	Transactions uint32 `protobuf:"varint,6,opt,name=transactions,proto3" json:"transactions,omitempty"`
+	// Count of transactions in pool and its capacity.
+	Pending      uint32 `protobuf:"varint,7,opt,name=pending,proto3" json:"pending,omitempty"`
+	PoolCapacity uint32 `protobuf:"varint,8,opt,name=pool_capacity,json=poolCapacity,proto3" json:"pool_capacity,omitempty"`
 }
 
 func (m *GasPriceResponse) Reset()                    { *m = GasPriceResponse{} }
@@ -1209,6 +1426,55 @@ func (m *GasPriceResponse) GetGasPrice() string {
 	return ""
 }
 
+func (m *GasPriceResponse) GetSlow() string {
+	if m != nil {
+		return m.Slow
+	}
+	return ""
+}
+
+func (m *GasPriceResponse) GetStandard() string {
+	if m != nil {
+		return m.Standard
+	}
+	return ""
+}
+
+func (m *GasPriceResponse) GetFast() string {
+	if m != nil {
+		return m.Fast
+	}
+	return ""
+}
+
+func (m *GasPriceResponse) GetBlocks() uint32 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
+
+func (m *GasPriceResponse) GetTransactions() uint32 {
+	if m != nil {
+		return m.Transactions
+	}
+	return 0
+}
+
+func (m *GasPriceResponse) GetPending() uint32 {
+	if m != nil {
+		return m.Pending
+	}
+	return 0
+}
+
+func (m *GasPriceResponse) GetPoolCapacity() uint32 {
+	if m != nil {
+		return m.PoolCapacity
+	}
+	return 0
+}
+
 // Request message of GetTransactionByHash rpc.
 type HashRequest struct {
 	// Hex string of block/transaction hash.
@@ -1340,119 +1606,1460 @@ func (m *GetConfigResponse) GetConfig() *nebletpb.Config {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*SubscribeRequest)(nil), "rpcpb.SubscribeRequest")
-	proto.RegisterType((*SubscribeResponse)(nil), "rpcpb.SubscribeResponse")
-	proto.RegisterType((*NonParamsRequest)(nil), "rpcpb.NonParamsRequest")
-	proto.RegisterType((*NodeInfoResponse)(nil), "rpcpb.NodeInfoResponse")
-	proto.RegisterType((*RouteTable)(nil), "rpcpb.RouteTable")
-	proto.RegisterType((*GetNebStateResponse)(nil), "rpcpb.GetNebStateResponse")
-	proto.RegisterType((*AccountsResponse)(nil), "rpcpb.AccountsResponse")
-	proto.RegisterType((*GetAccountStateRequest)(nil), "rpcpb.GetAccountStateRequest")
-	proto.RegisterType((*GetAccountStateResponse)(nil), "rpcpb.GetAccountStateResponse")
-	proto.RegisterType((*CallResponse)(nil), "rpcpb.CallResponse")
-	proto.RegisterType((*ByBlockHeightRequest)(nil), "rpcpb.ByBlockHeightRequest")
-	proto.RegisterType((*GetDynastyResponse)(nil), "rpcpb.GetDynastyResponse")
-	proto.RegisterType((*TransactionRequest)(nil), "rpcpb.TransactionRequest")
-	proto.RegisterType((*ContractRequest)(nil), "rpcpb.ContractRequest")
-	proto.RegisterType((*SendRawTransactionRequest)(nil), "rpcpb.SendRawTransactionRequest")
-	proto.RegisterType((*SendTransactionResponse)(nil), "rpcpb.SendTransactionResponse")
-	proto.RegisterType((*GetBlockByHashRequest)(nil), "rpcpb.GetBlockByHashRequest")
-	proto.RegisterType((*GetBlockByHeightRequest)(nil), "rpcpb.GetBlockByHeightRequest")
-	proto.RegisterType((*GetTransactionByHashRequest)(nil), "rpcpb.GetTransactionByHashRequest")
-	proto.RegisterType((*BlockResponse)(nil), "rpcpb.BlockResponse")
-	proto.RegisterType((*TransactionResponse)(nil), "rpcpb.TransactionResponse")
-	proto.RegisterType((*NewAccountRequest)(nil), "rpcpb.NewAccountRequest")
-	proto.RegisterType((*NewAccountResponse)(nil), "rpcpb.NewAccountResponse")
-	proto.RegisterType((*UnlockAccountRequest)(nil), "rpcpb.UnlockAccountRequest")
-	proto.RegisterType((*UnlockAccountResponse)(nil), "rpcpb.UnlockAccountResponse")
-	proto.RegisterType((*LockAccountRequest)(nil), "rpcpb.LockAccountRequest")
-	proto.RegisterType((*LockAccountResponse)(nil), "rpcpb.LockAccountResponse")
-	proto.RegisterType((*SignHashRequest)(nil), "rpcpb.SignHashRequest")
-	proto.RegisterType((*SignHashResponse)(nil), "rpcpb.SignHashResponse")
-	proto.RegisterType((*SignTransactionPassphraseRequest)(nil), "rpcpb.SignTransactionPassphraseRequest")
-	proto.RegisterType((*SignTransactionPassphraseResponse)(nil), "rpcpb.SignTransactionPassphraseResponse")
-	proto.RegisterType((*SendTransactionPassphraseRequest)(nil), "rpcpb.SendTransactionPassphraseRequest")
-	proto.RegisterType((*GasPriceResponse)(nil), "rpcpb.GasPriceResponse")
-	proto.RegisterType((*HashRequest)(nil), "rpcpb.HashRequest")
-	proto.RegisterType((*GasResponse)(nil), "rpcpb.GasResponse")
-	proto.RegisterType((*EventsResponse)(nil), "rpcpb.EventsResponse")
-	proto.RegisterType((*Event)(nil), "rpcpb.Event")
-	proto.RegisterType((*PprofRequest)(nil), "rpcpb.PprofRequest")
-	proto.RegisterType((*PprofResponse)(nil), "rpcpb.PprofResponse")
-	proto.RegisterType((*GetConfigResponse)(nil), "rpcpb.GetConfigResponse")
+type CandidateRequest struct {
+	// candidate action, support login and logout.
+	Action string `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *CandidateRequest) Reset()                    { *m = CandidateRequest{} }
+func (m *CandidateRequest) String() string            { return proto.CompactTextString(m) }
+func (*CandidateRequest) ProtoMessage()               {}
+func (*CandidateRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{40} }
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *CandidateRequest) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
 
-// Client API for ApiService service
+type DelegateRequest struct {
+	// delegate action, support do and undo.
+	Action string `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	// Hex string of the delegatee account address.
+	Delegatee string `protobuf:"bytes,2,opt,name=delegatee,proto3" json:"delegatee,omitempty"`
+}
 
-type ApiServiceClient interface {
-	// Return the state of the neb.
-	GetNebState(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*GetNebStateResponse, error)
-	// Return the latest irreversible block.
-	LatestIrreversibleBlock(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*BlockResponse, error)
-	// Return the state of the account.
-	GetAccountState(ctx context.Context, in *GetAccountStateRequest, opts ...grpc.CallOption) (*GetAccountStateResponse, error)
-	// Call transaction
-	Call(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*CallResponse, error)
-	// Submit the signed transaction.
-	SendRawTransaction(ctx context.Context, in *SendRawTransactionRequest, opts ...grpc.CallOption) (*SendTransactionResponse, error)
-	// Get block info by the block hash.
-	GetBlockByHash(ctx context.Context, in *GetBlockByHashRequest, opts ...grpc.CallOption) (*BlockResponse, error)
-	// Get block info by the block height.
-	GetBlockByHeight(ctx context.Context, in *GetBlockByHeightRequest, opts ...grpc.CallOption) (*BlockResponse, error)
-	// Get transactionReceipt info by tansaction hash.
-	GetTransactionReceipt(ctx context.Context, in *GetTransactionByHashRequest, opts ...grpc.CallOption) (*TransactionResponse, error)
-	// Subscribe message
-	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (ApiService_SubscribeClient, error)
-	// Get GasPrice
-	GetGasPrice(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*GasPriceResponse, error)
-	// EstimateGas
-	EstimateGas(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*GasResponse, error)
-	GetEventsByHash(ctx context.Context, in *HashRequest, opts ...grpc.CallOption) (*EventsResponse, error)
-	GetDynasty(ctx context.Context, in *ByBlockHeightRequest, opts ...grpc.CallOption) (*GetDynastyResponse, error)
+func (m *DelegateRequest) Reset()                    { *m = DelegateRequest{} }
+func (m *DelegateRequest) String() string            { return proto.CompactTextString(m) }
+func (*DelegateRequest) ProtoMessage()               {}
+func (*DelegateRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{41} }
+
+func (m *DelegateRequest) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
 }
 
-type apiServiceClient struct {
-	cc *grpc.ClientConn
+func (m *DelegateRequest) GetDelegatee() string {
+	if m != nil {
+		return m.Delegatee
+	}
+	return ""
 }
 
-func NewApiServiceClient(cc *grpc.ClientConn) ApiServiceClient {
-	return &apiServiceClient{cc}
+// Request message of GetNebulasRank rpc.
+type GetNebulasRankRequest struct {
+	// Period of the nebulas rank.
+	Period uint64 `protobuf:"varint,1,opt,name=period,proto3" json:"period,omitempty"`
+	// Hex string of the account address, return all addresses if empty.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (c *apiServiceClient) GetNebState(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*GetNebStateResponse, error) {
-	out := new(GetNebStateResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetNebState", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetNebulasRankRequest) Reset()                    { *m = GetNebulasRankRequest{} }
+func (m *GetNebulasRankRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetNebulasRankRequest) ProtoMessage()               {}
+func (*GetNebulasRankRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{42} }
+
+func (m *GetNebulasRankRequest) GetPeriod() uint64 {
+	if m != nil {
+		return m.Period
 	}
-	return out, nil
+	return 0
 }
 
-func (c *apiServiceClient) LatestIrreversibleBlock(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*BlockResponse, error) {
-	out := new(BlockResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.ApiService/LatestIrreversibleBlock", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetNebulasRankRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return out, nil
+	return ""
 }
 
-func (c *apiServiceClient) GetAccountState(ctx context.Context, in *GetAccountStateRequest, opts ...grpc.CallOption) (*GetAccountStateResponse, error) {
-	out := new(GetAccountStateResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetAccountState", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+// Response message of GetNebulasRank rpc.
+type GetNebulasRankResponse struct {
+	Period uint64 `protobuf:"varint,1,opt,name=period,proto3" json:"period,omitempty"`
+	// Block height range of the period.
+	StartHeight uint64         `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	EndHeight   uint64         `protobuf:"varint,3,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	Ranks       []*NebulasRank `protobuf:"bytes,4,rep,name=ranks" json:"ranks,omitempty"`
+}
+
+func (m *GetNebulasRankResponse) Reset()                    { *m = GetNebulasRankResponse{} }
+func (m *GetNebulasRankResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetNebulasRankResponse) ProtoMessage()               {}
+func (*GetNebulasRankResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{43} }
+
+func (m *GetNebulasRankResponse) GetPeriod() uint64 {
+	if m != nil {
+		return m.Period
 	}
-	return out, nil
+	return 0
+}
+
+func (m *GetNebulasRankResponse) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *GetNebulasRankResponse) GetEndHeight() uint64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *GetNebulasRankResponse) GetRanks() []*NebulasRank {
+	if m != nil {
+		return m.Ranks
+	}
+	return nil
+}
+
+type NebulasRank struct {
+	// Hex string of the account address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Position in the period ranking, starts from 1.
+	Rank  uint64  `protobuf:"varint,2,opt,name=rank,proto3" json:"rank,omitempty"`
+	Score float64 `protobuf:"fixed64,3,opt,name=score,proto3" json:"score,omitempty"`
+	// Total value received and sent in the period.
+	InValue  string `protobuf:"bytes,4,opt,name=in_value,json=inValue,proto3" json:"in_value,omitempty"`
+	OutValue string `protobuf:"bytes,5,opt,name=out_value,json=outValue,proto3" json:"out_value,omitempty"`
+}
+
+func (m *NebulasRank) Reset()                    { *m = NebulasRank{} }
+func (m *NebulasRank) String() string            { return proto.CompactTextString(m) }
+func (*NebulasRank) ProtoMessage()               {}
+func (*NebulasRank) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{44} }
+
+func (m *NebulasRank) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *NebulasRank) GetRank() uint64 {
+	if m != nil {
+		return m.Rank
+	}
+	return 0
+}
+
+func (m *NebulasRank) GetScore() float64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+func (m *NebulasRank) GetInValue() string {
+	if m != nil {
+		return m.InValue
+	}
+	return ""
+}
+
+func (m *NebulasRank) GetOutValue() string {
+	if m != nil {
+		return m.OutValue
+	}
+	return ""
+}
+
+// Request message of GetProof rpc.
+type GetProofRequest struct {
+	// Hex string of the account address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Prove at the block with height. If not specified, use 0 as tail height.
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// Contract storage keys to prove, such as "totalSupply" or "@balances[n1...]".
+	Keys []string `protobuf:"bytes,3,rep,name=keys" json:"keys,omitempty"`
+}
+
+func (m *GetProofRequest) Reset()                    { *m = GetProofRequest{} }
+func (m *GetProofRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetProofRequest) ProtoMessage()               {}
+func (*GetProofRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{45} }
+
+func (m *GetProofRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GetProofRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *GetProofRequest) GetKeys() []string {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+// Response message of GetProof rpc.
+type GetProofResponse struct {
+	// Hex string of the block hash and its state root.
+	BlockHash string `protobuf:"bytes,1,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	Height    uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	StateRoot string `protobuf:"bytes,3,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	// Encoded trie nodes from the state root to the account.
+	AccountProof  [][]byte        `protobuf:"bytes,4,rep,name=account_proof,json=accountProof" json:"account_proof,omitempty"`
+	StorageProofs []*StorageProof `protobuf:"bytes,5,rep,name=storage_proofs,json=storageProofs" json:"storage_proofs,omitempty"`
+}
+
+func (m *GetProofResponse) Reset()                    { *m = GetProofResponse{} }
+func (m *GetProofResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetProofResponse) ProtoMessage()               {}
+func (*GetProofResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{46} }
+
+func (m *GetProofResponse) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *GetProofResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *GetProofResponse) GetStateRoot() string {
+	if m != nil {
+		return m.StateRoot
+	}
+	return ""
+}
+
+func (m *GetProofResponse) GetAccountProof() [][]byte {
+	if m != nil {
+		return m.AccountProof
+	}
+	return nil
+}
+
+func (m *GetProofResponse) GetStorageProofs() []*StorageProof {
+	if m != nil {
+		return m.StorageProofs
+	}
+	return nil
+}
+
+type StorageProof struct {
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// Empty value and proof if the key doesn't exist.
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// Encoded trie nodes from the vars hash of account to the key.
+	Proof [][]byte `protobuf:"bytes,3,rep,name=proof" json:"proof,omitempty"`
+}
+
+func (m *StorageProof) Reset()                    { *m = StorageProof{} }
+func (m *StorageProof) String() string            { return proto.CompactTextString(m) }
+func (*StorageProof) ProtoMessage()               {}
+func (*StorageProof) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{47} }
+
+func (m *StorageProof) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *StorageProof) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *StorageProof) GetProof() [][]byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+type EventFieldFilter struct {
+	// Field of the JSON data of events, nested fields are joined by ".", such as "Transfer.from".
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	// Expected value, non-string values are compared in JSON, such as "1" or "true".
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *EventFieldFilter) Reset()                    { *m = EventFieldFilter{} }
+func (m *EventFieldFilter) String() string            { return proto.CompactTextString(m) }
+func (*EventFieldFilter) ProtoMessage()               {}
+func (*EventFieldFilter) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{48} }
+
+func (m *EventFieldFilter) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *EventFieldFilter) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+// Request message of GetLogs rpc.
+type GetLogsRequest struct {
+	// Block range of the logs, both inclusive, at most 10000 blocks. 0 to_height is tail height, 0 from_height is to_height.
+	FromHeight uint64 `protobuf:"varint,1,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	ToHeight   uint64 `protobuf:"varint,2,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+	// Hex string of the contract addresses, return the events of transactions calling or deploying any of them.
+	Contracts []string `protobuf:"bytes,3,rep,name=contracts" json:"contracts,omitempty"`
+	// Return the events of any of the topics, such as "chain.contract.Transfer".
+	Topics []string `protobuf:"bytes,4,rep,name=topics" json:"topics,omitempty"`
+}
+
+func (m *GetLogsRequest) Reset()                    { *m = GetLogsRequest{} }
+func (m *GetLogsRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetLogsRequest) ProtoMessage()               {}
+func (*GetLogsRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{49} }
+
+func (m *GetLogsRequest) GetFromHeight() uint64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *GetLogsRequest) GetToHeight() uint64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+func (m *GetLogsRequest) GetContracts() []string {
+	if m != nil {
+		return m.Contracts
+	}
+	return nil
+}
+
+func (m *GetLogsRequest) GetTopics() []string {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+// Response message of GetLogs rpc.
+type GetLogsResponse struct {
+	Logs []*EventLog `protobuf:"bytes,1,rep,name=logs" json:"logs,omitempty"`
+}
+
+func (m *GetLogsResponse) Reset()                    { *m = GetLogsResponse{} }
+func (m *GetLogsResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetLogsResponse) ProtoMessage()               {}
+func (*GetLogsResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{50} }
+
+func (m *GetLogsResponse) GetLogs() []*EventLog {
+	if m != nil {
+		return m.Logs
+	}
+	return nil
+}
+
+type EventLog struct {
+	Topic string `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Data  string `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// Block height and index of the event in the block.
+	Height uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Index  uint64 `protobuf:"varint,4,opt,name=index,proto3" json:"index,omitempty"`
+	// Hex string of the block hash, the transaction hash and the contract address.
+	BlockHash string `protobuf:"bytes,5,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	TxHash    string `protobuf:"bytes,6,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	Contract  string `protobuf:"bytes,7,opt,name=contract,proto3" json:"contract,omitempty"`
+}
+
+func (m *EventLog) Reset()                    { *m = EventLog{} }
+func (m *EventLog) String() string            { return proto.CompactTextString(m) }
+func (*EventLog) ProtoMessage()               {}
+func (*EventLog) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{51} }
+
+func (m *EventLog) GetTopic() string {
+	if m != nil {
+		return m.Topic
+	}
+	return ""
+}
+
+func (m *EventLog) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+func (m *EventLog) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *EventLog) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *EventLog) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *EventLog) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *EventLog) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+// Request message of GetTransactionsByAddress rpc.
+type GetTransactionsByAddressRequest struct {
+	// Hex string of the account address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Cursor returned by the previous page, empty for the first page.
+	Cursor string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// Max count of transactions returned. If not specified, use 20. At most 100.
+	Limit uint32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *GetTransactionsByAddressRequest) Reset()         { *m = GetTransactionsByAddressRequest{} }
+func (m *GetTransactionsByAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTransactionsByAddressRequest) ProtoMessage()    {}
+func (*GetTransactionsByAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorRpc, []int{52}
+}
+
+func (m *GetTransactionsByAddressRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GetTransactionsByAddressRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+func (m *GetTransactionsByAddressRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+// Response message of GetTransactionsByAddress rpc.
+type GetTransactionsByAddressResponse struct {
+	Transactions []*AddressTransaction `protobuf:"bytes,1,rep,name=transactions" json:"transactions,omitempty"`
+	// Cursor of the last transaction, empty if no transactions returned.
+	Cursor string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (m *GetTransactionsByAddressResponse) Reset()         { *m = GetTransactionsByAddressResponse{} }
+func (m *GetTransactionsByAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTransactionsByAddressResponse) ProtoMessage()    {}
+func (*GetTransactionsByAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorRpc, []int{53}
+}
+
+func (m *GetTransactionsByAddressResponse) GetTransactions() []*AddressTransaction {
+	if m != nil {
+		return m.Transactions
+	}
+	return nil
+}
+
+func (m *GetTransactionsByAddressResponse) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+type AddressTransaction struct {
+	// Block height and index of the transaction in the block.
+	Height      uint64               `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Index       uint32               `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Transaction *TransactionResponse `protobuf:"bytes,3,opt,name=transaction" json:"transaction,omitempty"`
+}
+
+func (m *AddressTransaction) Reset()                    { *m = AddressTransaction{} }
+func (m *AddressTransaction) String() string            { return proto.CompactTextString(m) }
+func (*AddressTransaction) ProtoMessage()               {}
+func (*AddressTransaction) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{54} }
+
+func (m *AddressTransaction) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *AddressTransaction) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *AddressTransaction) GetTransaction() *TransactionResponse {
+	if m != nil {
+		return m.Transaction
+	}
+	return nil
+}
+
+// Request message of GetPendingTransactions rpc.
+type GetPendingTransactionsRequest struct {
+	// Hex string of the sender address. If not specified, return the transactions of all senders.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *GetPendingTransactionsRequest) Reset()         { *m = GetPendingTransactionsRequest{} }
+func (m *GetPendingTransactionsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPendingTransactionsRequest) ProtoMessage()    {}
+func (*GetPendingTransactionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorRpc, []int{55}
+}
+
+func (m *GetPendingTransactionsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// Response message of GetPendingTransactions rpc.
+type GetPendingTransactionsResponse struct {
+	Transactions []*PendingTransaction `protobuf:"bytes,1,rep,name=transactions" json:"transactions,omitempty"`
+}
+
+func (m *GetPendingTransactionsResponse) Reset()         { *m = GetPendingTransactionsResponse{} }
+func (m *GetPendingTransactionsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPendingTransactionsResponse) ProtoMessage()    {}
+func (*GetPendingTransactionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorRpc, []int{56}
+}
+
+func (m *GetPendingTransactionsResponse) GetTransactions() []*PendingTransaction {
+	if m != nil {
+		return m.Transactions
+	}
+	return nil
+}
+
+type PendingTransaction struct {
+	Transaction *TransactionResponse `protobuf:"bytes,1,opt,name=transaction" json:"transaction,omitempty"`
+	// Status in pool, "executable", "queued", "nonce_gap" or "nonce_too_low".
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (m *PendingTransaction) Reset()                    { *m = PendingTransaction{} }
+func (m *PendingTransaction) String() string            { return proto.CompactTextString(m) }
+func (*PendingTransaction) ProtoMessage()               {}
+func (*PendingTransaction) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{57} }
+
+func (m *PendingTransaction) GetTransaction() *TransactionResponse {
+	if m != nil {
+		return m.Transaction
+	}
+	return nil
+}
+
+func (m *PendingTransaction) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+// Response message of GetTxPoolStats rpc.
+type TxPoolStatsResponse struct {
+	// Count of transactions in pool and the max count.
+	Size     uint32 `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`
+	Capacity uint32 `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	// Count of senders and the transactions can be packed next.
+	Buckets    uint32          `protobuf:"varint,3,opt,name=buckets,proto3" json:"buckets,omitempty"`
+	Candidates uint32          `protobuf:"varint,4,opt,name=candidates,proto3" json:"candidates,omitempty"`
+	Senders    []*TxPoolSender `protobuf:"bytes,5,rep,name=senders" json:"senders,omitempty"`
+}
+
+func (m *TxPoolStatsResponse) Reset()                    { *m = TxPoolStatsResponse{} }
+func (m *TxPoolStatsResponse) String() string            { return proto.CompactTextString(m) }
+func (*TxPoolStatsResponse) ProtoMessage()               {}
+func (*TxPoolStatsResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{58} }
+
+func (m *TxPoolStatsResponse) GetSize() uint32 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *TxPoolStatsResponse) GetCapacity() uint32 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
+}
+
+func (m *TxPoolStatsResponse) GetBuckets() uint32 {
+	if m != nil {
+		return m.Buckets
+	}
+	return 0
+}
+
+func (m *TxPoolStatsResponse) GetCandidates() uint32 {
+	if m != nil {
+		return m.Candidates
+	}
+	return 0
+}
+
+func (m *TxPoolStatsResponse) GetSenders() []*TxPoolSender {
+	if m != nil {
+		return m.Senders
+	}
+	return nil
+}
+
+type TxPoolSender struct {
+	// Hex string of the sender address.
+	Address      string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	AccountNonce uint64 `protobuf:"varint,2,opt,name=account_nonce,json=accountNonce,proto3" json:"account_nonce,omitempty"`
+	Count        uint32 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	MinNonce     uint64 `protobuf:"varint,4,opt,name=min_nonce,json=minNonce,proto3" json:"min_nonce,omitempty"`
+	MaxNonce     uint64 `protobuf:"varint,5,opt,name=max_nonce,json=maxNonce,proto3" json:"max_nonce,omitempty"`
+	// Count of missing nonces between the account nonce and the max nonce.
+	Gaps uint64 `protobuf:"varint,6,opt,name=gaps,proto3" json:"gaps,omitempty"`
+}
+
+func (m *TxPoolSender) Reset()                    { *m = TxPoolSender{} }
+func (m *TxPoolSender) String() string            { return proto.CompactTextString(m) }
+func (*TxPoolSender) ProtoMessage()               {}
+func (*TxPoolSender) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{59} }
+
+func (m *TxPoolSender) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *TxPoolSender) GetAccountNonce() uint64 {
+	if m != nil {
+		return m.AccountNonce
+	}
+	return 0
+}
+
+func (m *TxPoolSender) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *TxPoolSender) GetMinNonce() uint64 {
+	if m != nil {
+		return m.MinNonce
+	}
+	return 0
+}
+
+func (m *TxPoolSender) GetMaxNonce() uint64 {
+	if m != nil {
+		return m.MaxNonce
+	}
+	return 0
+}
+
+func (m *TxPoolSender) GetGaps() uint64 {
+	if m != nil {
+		return m.Gaps
+	}
+	return 0
+}
+
+// Request message of SendRawTransactions rpc.
+type SendRawTransactionsRequest struct {
+	// Signed data of transactions.
+	Data [][]byte `protobuf:"bytes,1,rep,name=data" json:"data,omitempty"`
+}
+
+func (m *SendRawTransactionsRequest) Reset()                    { *m = SendRawTransactionsRequest{} }
+func (m *SendRawTransactionsRequest) String() string            { return proto.CompactTextString(m) }
+func (*SendRawTransactionsRequest) ProtoMessage()               {}
+func (*SendRawTransactionsRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{60} }
+
+func (m *SendRawTransactionsRequest) GetData() [][]byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// Response message of SendRawTransactions rpc.
+type SendRawTransactionsResponse struct {
+	// Results in the order of requests.
+	Results []*SendTransactionResult `protobuf:"bytes,1,rep,name=results" json:"results,omitempty"`
+	// Hex string of the tail block hash and its height.
+	Tail   string `protobuf:"bytes,2,opt,name=tail,proto3" json:"tail,omitempty"`
+	Height uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *SendRawTransactionsResponse) Reset()                    { *m = SendRawTransactionsResponse{} }
+func (m *SendRawTransactionsResponse) String() string            { return proto.CompactTextString(m) }
+func (*SendRawTransactionsResponse) ProtoMessage()               {}
+func (*SendRawTransactionsResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{61} }
+
+func (m *SendRawTransactionsResponse) GetResults() []*SendTransactionResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *SendRawTransactionsResponse) GetTail() string {
+	if m != nil {
+		return m.Tail
+	}
+	return ""
+}
+
+func (m *SendRawTransactionsResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type SendTransactionResult struct {
+	Result *SendTransactionResponse `protobuf:"bytes,1,opt,name=result" json:"result,omitempty"`
+	// Error message if failed.
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *SendTransactionResult) Reset()                    { *m = SendTransactionResult{} }
+func (m *SendTransactionResult) String() string            { return proto.CompactTextString(m) }
+func (*SendTransactionResult) ProtoMessage()               {}
+func (*SendTransactionResult) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{62} }
+
+func (m *SendTransactionResult) GetResult() *SendTransactionResponse {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *SendTransactionResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// Request message of GetAccountStates rpc.
+type GetAccountStatesRequest struct {
+	Requests []*GetAccountStateRequest `protobuf:"bytes,1,rep,name=requests" json:"requests,omitempty"`
+}
+
+func (m *GetAccountStatesRequest) Reset()                    { *m = GetAccountStatesRequest{} }
+func (m *GetAccountStatesRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetAccountStatesRequest) ProtoMessage()               {}
+func (*GetAccountStatesRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{63} }
+
+func (m *GetAccountStatesRequest) GetRequests() []*GetAccountStateRequest {
+	if m != nil {
+		return m.Requests
+	}
+	return nil
+}
+
+// Response message of GetAccountStates rpc.
+type GetAccountStatesResponse struct {
+	// Results in the order of requests.
+	Results []*AccountStateResult `protobuf:"bytes,1,rep,name=results" json:"results,omitempty"`
+	// Hex string of the tail block hash and its height.
+	Tail   string `protobuf:"bytes,2,opt,name=tail,proto3" json:"tail,omitempty"`
+	Height uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *GetAccountStatesResponse) Reset()                    { *m = GetAccountStatesResponse{} }
+func (m *GetAccountStatesResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetAccountStatesResponse) ProtoMessage()               {}
+func (*GetAccountStatesResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{64} }
+
+func (m *GetAccountStatesResponse) GetResults() []*AccountStateResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *GetAccountStatesResponse) GetTail() string {
+	if m != nil {
+		return m.Tail
+	}
+	return ""
+}
+
+func (m *GetAccountStatesResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type AccountStateResult struct {
+	Result *GetAccountStateResponse `protobuf:"bytes,1,opt,name=result" json:"result,omitempty"`
+	// Error message if failed.
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *AccountStateResult) Reset()                    { *m = AccountStateResult{} }
+func (m *AccountStateResult) String() string            { return proto.CompactTextString(m) }
+func (*AccountStateResult) ProtoMessage()               {}
+func (*AccountStateResult) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{65} }
+
+func (m *AccountStateResult) GetResult() *GetAccountStateResponse {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *AccountStateResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// Request message of GetTransactionReceipts rpc.
+type GetTransactionReceiptsRequest struct {
+	// Hex string of transaction hashes.
+	Hashes []string `protobuf:"bytes,1,rep,name=hashes" json:"hashes,omitempty"`
+}
+
+func (m *GetTransactionReceiptsRequest) Reset()         { *m = GetTransactionReceiptsRequest{} }
+func (m *GetTransactionReceiptsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTransactionReceiptsRequest) ProtoMessage()    {}
+func (*GetTransactionReceiptsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorRpc, []int{66}
+}
+
+func (m *GetTransactionReceiptsRequest) GetHashes() []string {
+	if m != nil {
+		return m.Hashes
+	}
+	return nil
+}
+
+// Response message of GetTransactionReceipts rpc.
+type GetTransactionReceiptsResponse struct {
+	// Results in the order of requests.
+	Results []*TransactionReceiptResult `protobuf:"bytes,1,rep,name=results" json:"results,omitempty"`
+	// Hex string of the tail block hash and its height.
+	Tail   string `protobuf:"bytes,2,opt,name=tail,proto3" json:"tail,omitempty"`
+	Height uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *GetTransactionReceiptsResponse) Reset()         { *m = GetTransactionReceiptsResponse{} }
+func (m *GetTransactionReceiptsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTransactionReceiptsResponse) ProtoMessage()    {}
+func (*GetTransactionReceiptsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorRpc, []int{67}
+}
+
+func (m *GetTransactionReceiptsResponse) GetResults() []*TransactionReceiptResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *GetTransactionReceiptsResponse) GetTail() string {
+	if m != nil {
+		return m.Tail
+	}
+	return ""
+}
+
+func (m *GetTransactionReceiptsResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type TransactionReceiptResult struct {
+	Result *TransactionResponse `protobuf:"bytes,1,opt,name=result" json:"result,omitempty"`
+	// Error message if failed.
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *TransactionReceiptResult) Reset()                    { *m = TransactionReceiptResult{} }
+func (m *TransactionReceiptResult) String() string            { return proto.CompactTextString(m) }
+func (*TransactionReceiptResult) ProtoMessage()               {}
+func (*TransactionReceiptResult) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{68} }
+
+func (m *TransactionReceiptResult) GetResult() *TransactionResponse {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *TransactionReceiptResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// Response message of TraceTransaction rpc.
+type TraceTransactionResponse struct {
+	// Hex string of transaction hash.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// Hex string of the block packing the transaction.
+	BlockHash   string `protobuf:"bytes,2,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	BlockHeight uint64 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// transaction status recorded on chain, 0 failed, 1 success.
+	Status int32 `protobuf:"varint,4,opt,name=status,proto3" json:"status,omitempty"`
+	// transaction gas used recorded on chain.
+	GasUsed string `protobuf:"bytes,5,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// result of the replayed contract execution.
+	Result string `protobuf:"bytes,6,opt,name=result,proto3" json:"result,omitempty"`
+	// full error of the replayed contract execution.
+	ExecuteErr string `protobuf:"bytes,7,opt,name=execute_err,json=executeErr,proto3" json:"execute_err,omitempty"`
+	// gas used by the replayed contract execution.
+	ExecutionGas uint64       `protobuf:"varint,8,opt,name=execution_gas,json=executionGas,proto3" json:"execution_gas,omitempty"`
+	Steps        []*TraceStep `protobuf:"bytes,9,rep,name=steps" json:"steps,omitempty"`
+}
+
+func (m *TraceTransactionResponse) Reset()                    { *m = TraceTransactionResponse{} }
+func (m *TraceTransactionResponse) String() string            { return proto.CompactTextString(m) }
+func (*TraceTransactionResponse) ProtoMessage()               {}
+func (*TraceTransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{69} }
+
+func (m *TraceTransactionResponse) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *TraceTransactionResponse) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *TraceTransactionResponse) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *TraceTransactionResponse) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *TraceTransactionResponse) GetGasUsed() string {
+	if m != nil {
+		return m.GasUsed
+	}
+	return ""
+}
+
+func (m *TraceTransactionResponse) GetResult() string {
+	if m != nil {
+		return m.Result
+	}
+	return ""
+}
+
+func (m *TraceTransactionResponse) GetExecuteErr() string {
+	if m != nil {
+		return m.ExecuteErr
+	}
+	return ""
+}
+
+func (m *TraceTransactionResponse) GetExecutionGas() uint64 {
+	if m != nil {
+		return m.ExecutionGas
+	}
+	return 0
+}
+
+func (m *TraceTransactionResponse) GetSteps() []*TraceStep {
+	if m != nil {
+		return m.Steps
+	}
+	return nil
+}
+
+type TraceStep struct {
+	// step type, storage_get, storage_put, storage_del, transfer, event or log.
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// storage key, event topic, transfer receiver or log level.
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// storage value, event data, transfer amount or log message.
+	Value string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Err   string `protobuf:"bytes,4,opt,name=err,proto3" json:"err,omitempty"`
+	// count of instructions executed when the step happened.
+	Instructions uint64 `protobuf:"varint,5,opt,name=instructions,proto3" json:"instructions,omitempty"`
+}
+
+func (m *TraceStep) Reset()                    { *m = TraceStep{} }
+func (m *TraceStep) String() string            { return proto.CompactTextString(m) }
+func (*TraceStep) ProtoMessage()               {}
+func (*TraceStep) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{70} }
+
+func (m *TraceStep) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *TraceStep) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *TraceStep) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *TraceStep) GetErr() string {
+	if m != nil {
+		return m.Err
+	}
+	return ""
+}
+
+func (m *TraceStep) GetInstructions() uint64 {
+	if m != nil {
+		return m.Instructions
+	}
+	return 0
+}
+
+// Request message of GetContractVersions rpc.
+type GetContractVersionsRequest struct {
+	// Hex string of the contract address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// block height of the state. If not specified, use 0 as tail height.
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// block hash of the state, takes precedence over height.
+	BlockHash string `protobuf:"bytes,3,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+}
+
+func (m *GetContractVersionsRequest) Reset()                    { *m = GetContractVersionsRequest{} }
+func (m *GetContractVersionsRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetContractVersionsRequest) ProtoMessage()               {}
+func (*GetContractVersionsRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{71} }
+
+func (m *GetContractVersionsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GetContractVersionsRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *GetContractVersionsRequest) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+// Response message of GetContractVersions rpc.
+type GetContractVersionsResponse struct {
+	Versions []*ContractVersion `protobuf:"bytes,1,rep,name=versions" json:"versions,omitempty"`
+}
+
+func (m *GetContractVersionsResponse) Reset()                    { *m = GetContractVersionsResponse{} }
+func (m *GetContractVersionsResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetContractVersionsResponse) ProtoMessage()               {}
+func (*GetContractVersionsResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{72} }
+
+func (m *GetContractVersionsResponse) GetVersions() []*ContractVersion {
+	if m != nil {
+		return m.Versions
+	}
+	return nil
+}
+
+type ContractVersion struct {
+	// version number, 0 is the deployed source.
+	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// hash of the transaction deploying or upgrading the source.
+	Hash string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	// address of the deployer or upgrader.
+	From string `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	// timestamp of the transaction.
+	Timestamp int64 `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (m *ContractVersion) Reset()                    { *m = ContractVersion{} }
+func (m *ContractVersion) String() string            { return proto.CompactTextString(m) }
+func (*ContractVersion) ProtoMessage()               {}
+func (*ContractVersion) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{73} }
+
+func (m *ContractVersion) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *ContractVersion) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *ContractVersion) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *ContractVersion) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+// Request message of GetContractABI rpc.
+type GetContractABIRequest struct {
+	// Hex string of the contract address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// block height of the state. If not specified, use 0 as tail height.
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// block hash of the state, takes precedence over height.
+	BlockHash string `protobuf:"bytes,3,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+}
+
+func (m *GetContractABIRequest) Reset()                    { *m = GetContractABIRequest{} }
+func (m *GetContractABIRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetContractABIRequest) ProtoMessage()               {}
+func (*GetContractABIRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{74} }
+
+func (m *GetContractABIRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GetContractABIRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *GetContractABIRequest) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+// Response message of GetContractABI rpc.
+type GetContractABIResponse struct {
+	Functions []*ContractFunction `protobuf:"bytes,1,rep,name=functions" json:"functions,omitempty"`
+}
+
+func (m *GetContractABIResponse) Reset()                    { *m = GetContractABIResponse{} }
+func (m *GetContractABIResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetContractABIResponse) ProtoMessage()               {}
+func (*GetContractABIResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{75} }
+
+func (m *GetContractABIResponse) GetFunctions() []*ContractFunction {
+	if m != nil {
+		return m.Functions
+	}
+	return nil
+}
+
+type ContractFunction struct {
+	// function name.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// types of the args: string, number, bool, object, array or any.
+	Args []string `protobuf:"bytes,2,rep,name=args" json:"args,omitempty"`
+	// whether the function only reads the contract storage.
+	ReadOnly bool `protobuf:"varint,3,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`
+	// whether the function accepts value.
+	Payable bool `protobuf:"varint,4,opt,name=payable,proto3" json:"payable,omitempty"`
+}
+
+func (m *ContractFunction) Reset()                    { *m = ContractFunction{} }
+func (m *ContractFunction) String() string            { return proto.CompactTextString(m) }
+func (*ContractFunction) ProtoMessage()               {}
+func (*ContractFunction) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{76} }
+
+func (m *ContractFunction) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ContractFunction) GetArgs() []string {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+func (m *ContractFunction) GetReadOnly() bool {
+	if m != nil {
+		return m.ReadOnly
+	}
+	return false
+}
+
+func (m *ContractFunction) GetPayable() bool {
+	if m != nil {
+		return m.Payable
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*SubscribeRequest)(nil), "rpcpb.SubscribeRequest")
+	proto.RegisterType((*SubscribeResponse)(nil), "rpcpb.SubscribeResponse")
+	proto.RegisterType((*NonParamsRequest)(nil), "rpcpb.NonParamsRequest")
+	proto.RegisterType((*NodeInfoResponse)(nil), "rpcpb.NodeInfoResponse")
+	proto.RegisterType((*RouteTable)(nil), "rpcpb.RouteTable")
+	proto.RegisterType((*GetNebStateResponse)(nil), "rpcpb.GetNebStateResponse")
+	proto.RegisterType((*AccountsResponse)(nil), "rpcpb.AccountsResponse")
+	proto.RegisterType((*GetAccountStateRequest)(nil), "rpcpb.GetAccountStateRequest")
+	proto.RegisterType((*GetAccountStateResponse)(nil), "rpcpb.GetAccountStateResponse")
+	proto.RegisterType((*CallResponse)(nil), "rpcpb.CallResponse")
+	proto.RegisterType((*ByBlockHeightRequest)(nil), "rpcpb.ByBlockHeightRequest")
+	proto.RegisterType((*GetDynastyResponse)(nil), "rpcpb.GetDynastyResponse")
+	proto.RegisterType((*TransactionRequest)(nil), "rpcpb.TransactionRequest")
+	proto.RegisterType((*ContractRequest)(nil), "rpcpb.ContractRequest")
+	proto.RegisterType((*SendRawTransactionRequest)(nil), "rpcpb.SendRawTransactionRequest")
+	proto.RegisterType((*SendTransactionResponse)(nil), "rpcpb.SendTransactionResponse")
+	proto.RegisterType((*GetBlockByHashRequest)(nil), "rpcpb.GetBlockByHashRequest")
+	proto.RegisterType((*GetBlockByHeightRequest)(nil), "rpcpb.GetBlockByHeightRequest")
+	proto.RegisterType((*GetTransactionByHashRequest)(nil), "rpcpb.GetTransactionByHashRequest")
+	proto.RegisterType((*BlockResponse)(nil), "rpcpb.BlockResponse")
+	proto.RegisterType((*TransactionResponse)(nil), "rpcpb.TransactionResponse")
+	proto.RegisterType((*NewAccountRequest)(nil), "rpcpb.NewAccountRequest")
+	proto.RegisterType((*NewAccountResponse)(nil), "rpcpb.NewAccountResponse")
+	proto.RegisterType((*UnlockAccountRequest)(nil), "rpcpb.UnlockAccountRequest")
+	proto.RegisterType((*UnlockAccountResponse)(nil), "rpcpb.UnlockAccountResponse")
+	proto.RegisterType((*LockAccountRequest)(nil), "rpcpb.LockAccountRequest")
+	proto.RegisterType((*LockAccountResponse)(nil), "rpcpb.LockAccountResponse")
+	proto.RegisterType((*SignHashRequest)(nil), "rpcpb.SignHashRequest")
+	proto.RegisterType((*SignHashResponse)(nil), "rpcpb.SignHashResponse")
+	proto.RegisterType((*SignTransactionPassphraseRequest)(nil), "rpcpb.SignTransactionPassphraseRequest")
+	proto.RegisterType((*SignTransactionPassphraseResponse)(nil), "rpcpb.SignTransactionPassphraseResponse")
+	proto.RegisterType((*SendTransactionPassphraseRequest)(nil), "rpcpb.SendTransactionPassphraseRequest")
+	proto.RegisterType((*GasPriceResponse)(nil), "rpcpb.GasPriceResponse")
+	proto.RegisterType((*HashRequest)(nil), "rpcpb.HashRequest")
+	proto.RegisterType((*GasResponse)(nil), "rpcpb.GasResponse")
+	proto.RegisterType((*EventsResponse)(nil), "rpcpb.EventsResponse")
+	proto.RegisterType((*Event)(nil), "rpcpb.Event")
+	proto.RegisterType((*PprofRequest)(nil), "rpcpb.PprofRequest")
+	proto.RegisterType((*PprofResponse)(nil), "rpcpb.PprofResponse")
+	proto.RegisterType((*GetConfigResponse)(nil), "rpcpb.GetConfigResponse")
+	proto.RegisterType((*CandidateRequest)(nil), "rpcpb.CandidateRequest")
+	proto.RegisterType((*DelegateRequest)(nil), "rpcpb.DelegateRequest")
+	proto.RegisterType((*GetNebulasRankRequest)(nil), "rpcpb.GetNebulasRankRequest")
+	proto.RegisterType((*GetNebulasRankResponse)(nil), "rpcpb.GetNebulasRankResponse")
+	proto.RegisterType((*NebulasRank)(nil), "rpcpb.NebulasRank")
+	proto.RegisterType((*GetProofRequest)(nil), "rpcpb.GetProofRequest")
+	proto.RegisterType((*GetProofResponse)(nil), "rpcpb.GetProofResponse")
+	proto.RegisterType((*StorageProof)(nil), "rpcpb.StorageProof")
+	proto.RegisterType((*EventFieldFilter)(nil), "rpcpb.EventFieldFilter")
+	proto.RegisterType((*GetLogsRequest)(nil), "rpcpb.GetLogsRequest")
+	proto.RegisterType((*GetLogsResponse)(nil), "rpcpb.GetLogsResponse")
+	proto.RegisterType((*EventLog)(nil), "rpcpb.EventLog")
+	proto.RegisterType((*GetTransactionsByAddressRequest)(nil), "rpcpb.GetTransactionsByAddressRequest")
+	proto.RegisterType((*GetTransactionsByAddressResponse)(nil), "rpcpb.GetTransactionsByAddressResponse")
+	proto.RegisterType((*AddressTransaction)(nil), "rpcpb.AddressTransaction")
+	proto.RegisterType((*GetPendingTransactionsRequest)(nil), "rpcpb.GetPendingTransactionsRequest")
+	proto.RegisterType((*GetPendingTransactionsResponse)(nil), "rpcpb.GetPendingTransactionsResponse")
+	proto.RegisterType((*PendingTransaction)(nil), "rpcpb.PendingTransaction")
+	proto.RegisterType((*TxPoolStatsResponse)(nil), "rpcpb.TxPoolStatsResponse")
+	proto.RegisterType((*TxPoolSender)(nil), "rpcpb.TxPoolSender")
+	proto.RegisterType((*SendRawTransactionsRequest)(nil), "rpcpb.SendRawTransactionsRequest")
+	proto.RegisterType((*SendRawTransactionsResponse)(nil), "rpcpb.SendRawTransactionsResponse")
+	proto.RegisterType((*SendTransactionResult)(nil), "rpcpb.SendTransactionResult")
+	proto.RegisterType((*GetAccountStatesRequest)(nil), "rpcpb.GetAccountStatesRequest")
+	proto.RegisterType((*GetAccountStatesResponse)(nil), "rpcpb.GetAccountStatesResponse")
+	proto.RegisterType((*AccountStateResult)(nil), "rpcpb.AccountStateResult")
+	proto.RegisterType((*GetTransactionReceiptsRequest)(nil), "rpcpb.GetTransactionReceiptsRequest")
+	proto.RegisterType((*GetTransactionReceiptsResponse)(nil), "rpcpb.GetTransactionReceiptsResponse")
+	proto.RegisterType((*TransactionReceiptResult)(nil), "rpcpb.TransactionReceiptResult")
+	proto.RegisterType((*TraceTransactionResponse)(nil), "rpcpb.TraceTransactionResponse")
+	proto.RegisterType((*TraceStep)(nil), "rpcpb.TraceStep")
+	proto.RegisterType((*GetContractVersionsRequest)(nil), "rpcpb.GetContractVersionsRequest")
+	proto.RegisterType((*GetContractVersionsResponse)(nil), "rpcpb.GetContractVersionsResponse")
+	proto.RegisterType((*ContractVersion)(nil), "rpcpb.ContractVersion")
+	proto.RegisterType((*GetContractABIRequest)(nil), "rpcpb.GetContractABIRequest")
+	proto.RegisterType((*GetContractABIResponse)(nil), "rpcpb.GetContractABIResponse")
+	proto.RegisterType((*ContractFunction)(nil), "rpcpb.ContractFunction")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for ApiService service
+
+type ApiServiceClient interface {
+	// Return the state of the neb.
+	GetNebState(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*GetNebStateResponse, error)
+	// Return the latest irreversible block.
+	LatestIrreversibleBlock(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*BlockResponse, error)
+	// Return the state of the account.
+	GetAccountState(ctx context.Context, in *GetAccountStateRequest, opts ...grpc.CallOption) (*GetAccountStateResponse, error)
+	// Call transaction
+	Call(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*CallResponse, error)
+	// Submit the signed transaction.
+	SendRawTransaction(ctx context.Context, in *SendRawTransactionRequest, opts ...grpc.CallOption) (*SendTransactionResponse, error)
+	// Get block info by the block hash.
+	GetBlockByHash(ctx context.Context, in *GetBlockByHashRequest, opts ...grpc.CallOption) (*BlockResponse, error)
+	// Get block info by the block height.
+	GetBlockByHeight(ctx context.Context, in *GetBlockByHeightRequest, opts ...grpc.CallOption) (*BlockResponse, error)
+	// Get transactionReceipt info by tansaction hash.
+	GetTransactionReceipt(ctx context.Context, in *GetTransactionByHashRequest, opts ...grpc.CallOption) (*TransactionResponse, error)
+	// Subscribe message
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (ApiService_SubscribeClient, error)
+	// Get GasPrice
+	GetGasPrice(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*GasPriceResponse, error)
+	// EstimateGas
+	EstimateGas(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*GasResponse, error)
+	GetEventsByHash(ctx context.Context, in *HashRequest, opts ...grpc.CallOption) (*EventsResponse, error)
+	GetDynasty(ctx context.Context, in *ByBlockHeightRequest, opts ...grpc.CallOption) (*GetDynastyResponse, error)
+	// Return the nebulas rank of addresses in a period.
+	GetNebulasRank(ctx context.Context, in *GetNebulasRankRequest, opts ...grpc.CallOption) (*GetNebulasRankResponse, error)
+	// Return the merkle proofs of the account and its contract storage keys.
+	GetProof(ctx context.Context, in *GetProofRequest, opts ...grpc.CallOption) (*GetProofResponse, error)
+	// Return the events of contracts and topics in a block range.
+	GetLogs(ctx context.Context, in *GetLogsRequest, opts ...grpc.CallOption) (*GetLogsResponse, error)
+	// Return the transactions sent from or to an address, in ascending order of block height.
+	GetTransactionsByAddress(ctx context.Context, in *GetTransactionsByAddressRequest, opts ...grpc.CallOption) (*GetTransactionsByAddressResponse, error)
+	// Return the pending transactions in pool, optionally of a sender.
+	GetPendingTransactions(ctx context.Context, in *GetPendingTransactionsRequest, opts ...grpc.CallOption) (*GetPendingTransactionsResponse, error)
+	// Return a pending transaction in pool with its status.
+	GetPendingTransaction(ctx context.Context, in *GetTransactionByHashRequest, opts ...grpc.CallOption) (*PendingTransaction, error)
+	// Return the statistics of transaction pool.
+	GetTxPoolStats(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*TxPoolStatsResponse, error)
+	// Submit the signed transactions, verified against the same tail block.
+	SendRawTransactions(ctx context.Context, in *SendRawTransactionsRequest, opts ...grpc.CallOption) (*SendRawTransactionsResponse, error)
+	// Return the states of the accounts at the same tail block.
+	GetAccountStates(ctx context.Context, in *GetAccountStatesRequest, opts ...grpc.CallOption) (*GetAccountStatesResponse, error)
+	// Return the transactionReceipts at the same tail block.
+	GetTransactionReceipts(ctx context.Context, in *GetTransactionReceiptsRequest, opts ...grpc.CallOption) (*GetTransactionReceiptsResponse, error)
+	// Return the source versions of a contract, the deployed one followed by its upgrades.
+	GetContractVersions(ctx context.Context, in *GetContractVersionsRequest, opts ...grpc.CallOption) (*GetContractVersionsResponse, error)
+	// Return the abi declared by a contract.
+	GetContractABI(ctx context.Context, in *GetContractABIRequest, opts ...grpc.CallOption) (*GetContractABIResponse, error)
+}
+
+type apiServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewApiServiceClient(cc *grpc.ClientConn) ApiServiceClient {
+	return &apiServiceClient{cc}
+}
+
+func (c *apiServiceClient) GetNebState(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*GetNebStateResponse, error) {
+	out := new(GetNebStateResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetNebState", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) LatestIrreversibleBlock(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*BlockResponse, error) {
+	out := new(BlockResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/LatestIrreversibleBlock", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetAccountState(ctx context.Context, in *GetAccountStateRequest, opts ...grpc.CallOption) (*GetAccountStateResponse, error) {
+	out := new(GetAccountStateResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetAccountState", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
 func (c *apiServiceClient) Call(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*CallResponse, error) {
@@ -1464,104 +3071,212 @@ func (c *apiServiceClient) Call(ctx context.Context, in *TransactionRequest, opt
 	return out, nil
 }
 
-func (c *apiServiceClient) SendRawTransaction(ctx context.Context, in *SendRawTransactionRequest, opts ...grpc.CallOption) (*SendTransactionResponse, error) {
-	out := new(SendTransactionResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.ApiService/SendRawTransaction", in, out, c.cc, opts...)
+func (c *apiServiceClient) SendRawTransaction(ctx context.Context, in *SendRawTransactionRequest, opts ...grpc.CallOption) (*SendTransactionResponse, error) {
+	out := new(SendTransactionResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/SendRawTransaction", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetBlockByHash(ctx context.Context, in *GetBlockByHashRequest, opts ...grpc.CallOption) (*BlockResponse, error) {
+	out := new(BlockResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetBlockByHash", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetBlockByHeight(ctx context.Context, in *GetBlockByHeightRequest, opts ...grpc.CallOption) (*BlockResponse, error) {
+	out := new(BlockResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetBlockByHeight", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetTransactionReceipt(ctx context.Context, in *GetTransactionByHashRequest, opts ...grpc.CallOption) (*TransactionResponse, error) {
+	out := new(TransactionResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetTransactionReceipt", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (ApiService_SubscribeClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_ApiService_serviceDesc.Streams[0], c.cc, "/rpcpb.ApiService/Subscribe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &apiServiceSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ApiService_SubscribeClient interface {
+	Recv() (*SubscribeResponse, error)
+	grpc.ClientStream
+}
+
+type apiServiceSubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *apiServiceSubscribeClient) Recv() (*SubscribeResponse, error) {
+	m := new(SubscribeResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *apiServiceClient) GetGasPrice(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*GasPriceResponse, error) {
+	out := new(GasPriceResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetGasPrice", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) EstimateGas(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*GasResponse, error) {
+	out := new(GasResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/EstimateGas", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetEventsByHash(ctx context.Context, in *HashRequest, opts ...grpc.CallOption) (*EventsResponse, error) {
+	out := new(EventsResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetEventsByHash", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetDynasty(ctx context.Context, in *ByBlockHeightRequest, opts ...grpc.CallOption) (*GetDynastyResponse, error) {
+	out := new(GetDynastyResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetDynasty", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetNebulasRank(ctx context.Context, in *GetNebulasRankRequest, opts ...grpc.CallOption) (*GetNebulasRankResponse, error) {
+	out := new(GetNebulasRankResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetNebulasRank", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetProof(ctx context.Context, in *GetProofRequest, opts ...grpc.CallOption) (*GetProofResponse, error) {
+	out := new(GetProofResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetProof", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *apiServiceClient) GetBlockByHash(ctx context.Context, in *GetBlockByHashRequest, opts ...grpc.CallOption) (*BlockResponse, error) {
-	out := new(BlockResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetBlockByHash", in, out, c.cc, opts...)
+func (c *apiServiceClient) GetLogs(ctx context.Context, in *GetLogsRequest, opts ...grpc.CallOption) (*GetLogsResponse, error) {
+	out := new(GetLogsResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetLogs", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *apiServiceClient) GetBlockByHeight(ctx context.Context, in *GetBlockByHeightRequest, opts ...grpc.CallOption) (*BlockResponse, error) {
-	out := new(BlockResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetBlockByHeight", in, out, c.cc, opts...)
+func (c *apiServiceClient) GetTransactionsByAddress(ctx context.Context, in *GetTransactionsByAddressRequest, opts ...grpc.CallOption) (*GetTransactionsByAddressResponse, error) {
+	out := new(GetTransactionsByAddressResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetTransactionsByAddress", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *apiServiceClient) GetTransactionReceipt(ctx context.Context, in *GetTransactionByHashRequest, opts ...grpc.CallOption) (*TransactionResponse, error) {
-	out := new(TransactionResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetTransactionReceipt", in, out, c.cc, opts...)
+func (c *apiServiceClient) GetPendingTransactions(ctx context.Context, in *GetPendingTransactionsRequest, opts ...grpc.CallOption) (*GetPendingTransactionsResponse, error) {
+	out := new(GetPendingTransactionsResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetPendingTransactions", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *apiServiceClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (ApiService_SubscribeClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_ApiService_serviceDesc.Streams[0], c.cc, "/rpcpb.ApiService/Subscribe", opts...)
+func (c *apiServiceClient) GetPendingTransaction(ctx context.Context, in *GetTransactionByHashRequest, opts ...grpc.CallOption) (*PendingTransaction, error) {
+	out := new(PendingTransaction)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetPendingTransaction", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &apiServiceSubscribeClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
-}
-
-type ApiService_SubscribeClient interface {
-	Recv() (*SubscribeResponse, error)
-	grpc.ClientStream
+	return out, nil
 }
 
-type apiServiceSubscribeClient struct {
-	grpc.ClientStream
+func (c *apiServiceClient) GetTxPoolStats(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*TxPoolStatsResponse, error) {
+	out := new(TxPoolStatsResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetTxPoolStats", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (x *apiServiceSubscribeClient) Recv() (*SubscribeResponse, error) {
-	m := new(SubscribeResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+func (c *apiServiceClient) SendRawTransactions(ctx context.Context, in *SendRawTransactionsRequest, opts ...grpc.CallOption) (*SendRawTransactionsResponse, error) {
+	out := new(SendRawTransactionsResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/SendRawTransactions", in, out, c.cc, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return m, nil
+	return out, nil
 }
 
-func (c *apiServiceClient) GetGasPrice(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*GasPriceResponse, error) {
-	out := new(GasPriceResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetGasPrice", in, out, c.cc, opts...)
+func (c *apiServiceClient) GetAccountStates(ctx context.Context, in *GetAccountStatesRequest, opts ...grpc.CallOption) (*GetAccountStatesResponse, error) {
+	out := new(GetAccountStatesResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetAccountStates", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *apiServiceClient) EstimateGas(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*GasResponse, error) {
-	out := new(GasResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.ApiService/EstimateGas", in, out, c.cc, opts...)
+func (c *apiServiceClient) GetTransactionReceipts(ctx context.Context, in *GetTransactionReceiptsRequest, opts ...grpc.CallOption) (*GetTransactionReceiptsResponse, error) {
+	out := new(GetTransactionReceiptsResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetTransactionReceipts", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *apiServiceClient) GetEventsByHash(ctx context.Context, in *HashRequest, opts ...grpc.CallOption) (*EventsResponse, error) {
-	out := new(EventsResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetEventsByHash", in, out, c.cc, opts...)
+func (c *apiServiceClient) GetContractVersions(ctx context.Context, in *GetContractVersionsRequest, opts ...grpc.CallOption) (*GetContractVersionsResponse, error) {
+	out := new(GetContractVersionsResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetContractVersions", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *apiServiceClient) GetDynasty(ctx context.Context, in *ByBlockHeightRequest, opts ...grpc.CallOption) (*GetDynastyResponse, error) {
-	out := new(GetDynastyResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetDynasty", in, out, c.cc, opts...)
+func (c *apiServiceClient) GetContractABI(ctx context.Context, in *GetContractABIRequest, opts ...grpc.CallOption) (*GetContractABIResponse, error) {
+	out := new(GetContractABIResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetContractABI", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1595,6 +3310,30 @@ type ApiServiceServer interface {
 	EstimateGas(context.Context, *TransactionRequest) (*GasResponse, error)
 	GetEventsByHash(context.Context, *HashRequest) (*EventsResponse, error)
 	GetDynasty(context.Context, *ByBlockHeightRequest) (*GetDynastyResponse, error)
+	// Return the nebulas rank of addresses in a period.
+	GetNebulasRank(context.Context, *GetNebulasRankRequest) (*GetNebulasRankResponse, error)
+	// Return the merkle proofs of the account and its contract storage keys.
+	GetProof(context.Context, *GetProofRequest) (*GetProofResponse, error)
+	// Return the events of contracts and topics in a block range.
+	GetLogs(context.Context, *GetLogsRequest) (*GetLogsResponse, error)
+	// Return the transactions sent from or to an address, in ascending order of block height.
+	GetTransactionsByAddress(context.Context, *GetTransactionsByAddressRequest) (*GetTransactionsByAddressResponse, error)
+	// Return the pending transactions in pool, optionally of a sender.
+	GetPendingTransactions(context.Context, *GetPendingTransactionsRequest) (*GetPendingTransactionsResponse, error)
+	// Return a pending transaction in pool with its status.
+	GetPendingTransaction(context.Context, *GetTransactionByHashRequest) (*PendingTransaction, error)
+	// Return the statistics of transaction pool.
+	GetTxPoolStats(context.Context, *NonParamsRequest) (*TxPoolStatsResponse, error)
+	// Submit the signed transactions, verified against the same tail block.
+	SendRawTransactions(context.Context, *SendRawTransactionsRequest) (*SendRawTransactionsResponse, error)
+	// Return the states of the accounts at the same tail block.
+	GetAccountStates(context.Context, *GetAccountStatesRequest) (*GetAccountStatesResponse, error)
+	// Return the transactionReceipts at the same tail block.
+	GetTransactionReceipts(context.Context, *GetTransactionReceiptsRequest) (*GetTransactionReceiptsResponse, error)
+	// Return the source versions of a contract, the deployed one followed by its upgrades.
+	GetContractVersions(context.Context, *GetContractVersionsRequest) (*GetContractVersionsResponse, error)
+	// Return the abi declared by a contract.
+	GetContractABI(context.Context, *GetContractABIRequest) (*GetContractABIResponse, error)
 }
 
 func RegisterApiServiceServer(s *grpc.Server, srv ApiServiceServer) {
@@ -1838,6 +3577,222 @@ func _ApiService_GetDynasty_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiService_GetNebulasRank_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNebulasRankRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetNebulasRank(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetNebulasRank",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetNebulasRank(ctx, req.(*GetNebulasRankRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetProof(ctx, req.(*GetProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetLogs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetLogs(ctx, req.(*GetLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetTransactionsByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionsByAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetTransactionsByAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetTransactionsByAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetTransactionsByAddress(ctx, req.(*GetTransactionsByAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetPendingTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPendingTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetPendingTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetPendingTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetPendingTransactions(ctx, req.(*GetPendingTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetPendingTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionByHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetPendingTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetPendingTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetPendingTransaction(ctx, req.(*GetTransactionByHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetTxPoolStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NonParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetTxPoolStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetTxPoolStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetTxPoolStats(ctx, req.(*NonParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_SendRawTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendRawTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).SendRawTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/SendRawTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).SendRawTransactions(ctx, req.(*SendRawTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetAccountStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountStatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetAccountStates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetAccountStates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetAccountStates(ctx, req.(*GetAccountStatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetTransactionReceipts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionReceiptsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetTransactionReceipts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetTransactionReceipts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetTransactionReceipts(ctx, req.(*GetTransactionReceiptsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetContractVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetContractVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetContractVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetContractVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetContractVersions(ctx, req.(*GetContractVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetContractABI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetContractABIRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetContractABI(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetContractABI",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetContractABI(ctx, req.(*GetContractABIRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ApiService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "rpcpb.ApiService",
 	HandlerType: (*ApiServiceServer)(nil),
@@ -1890,6 +3845,54 @@ var _ApiService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetDynasty",
 			Handler:    _ApiService_GetDynasty_Handler,
 		},
+		{
+			MethodName: "GetNebulasRank",
+			Handler:    _ApiService_GetNebulasRank_Handler,
+		},
+		{
+			MethodName: "GetProof",
+			Handler:    _ApiService_GetProof_Handler,
+		},
+		{
+			MethodName: "GetLogs",
+			Handler:    _ApiService_GetLogs_Handler,
+		},
+		{
+			MethodName: "GetTransactionsByAddress",
+			Handler:    _ApiService_GetTransactionsByAddress_Handler,
+		},
+		{
+			MethodName: "GetPendingTransactions",
+			Handler:    _ApiService_GetPendingTransactions_Handler,
+		},
+		{
+			MethodName: "GetPendingTransaction",
+			Handler:    _ApiService_GetPendingTransaction_Handler,
+		},
+		{
+			MethodName: "GetTxPoolStats",
+			Handler:    _ApiService_GetTxPoolStats_Handler,
+		},
+		{
+			MethodName: "SendRawTransactions",
+			Handler:    _ApiService_SendRawTransactions_Handler,
+		},
+		{
+			MethodName: "GetAccountStates",
+			Handler:    _ApiService_GetAccountStates_Handler,
+		},
+		{
+			MethodName: "GetTransactionReceipts",
+			Handler:    _ApiService_GetTransactionReceipts_Handler,
+		},
+		{
+			MethodName: "GetContractVersions",
+			Handler:    _ApiService_GetContractVersions_Handler,
+		},
+		{
+			MethodName: "GetContractABI",
+			Handler:    _ApiService_GetContractABI_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1925,6 +3928,8 @@ type AdminServiceClient interface {
 	GetConfig(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*GetConfigResponse, error)
 	// Return the p2p node info.
 	NodeInfo(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*NodeInfoResponse, error)
+	// Re-execute a transaction on chain and return the host calls made by the contract.
+	TraceTransaction(ctx context.Context, in *GetTransactionByHashRequest, opts ...grpc.CallOption) (*TraceTransactionResponse, error)
 }
 
 type adminServiceClient struct {
@@ -2034,6 +4039,15 @@ func (c *adminServiceClient) NodeInfo(ctx context.Context, in *NonParamsRequest,
 	return out, nil
 }
 
+func (c *adminServiceClient) TraceTransaction(ctx context.Context, in *GetTransactionByHashRequest, opts ...grpc.CallOption) (*TraceTransactionResponse, error) {
+	out := new(TraceTransactionResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/TraceTransaction", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for AdminService service
 
 type AdminServiceServer interface {
@@ -2058,6 +4072,8 @@ type AdminServiceServer interface {
 	GetConfig(context.Context, *NonParamsRequest) (*GetConfigResponse, error)
 	// Return the p2p node info.
 	NodeInfo(context.Context, *NonParamsRequest) (*NodeInfoResponse, error)
+	// Re-execute a transaction on chain and return the host calls made by the contract.
+	TraceTransaction(context.Context, *GetTransactionByHashRequest) (*TraceTransactionResponse, error)
 }
 
 func RegisterAdminServiceServer(s *grpc.Server, srv AdminServiceServer) {
@@ -2262,6 +4278,24 @@ func _AdminService_NodeInfo_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_TraceTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionByHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).TraceTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.AdminService/TraceTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).TraceTransaction(ctx, req.(*GetTransactionByHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _AdminService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "rpcpb.AdminService",
 	HandlerType: (*AdminServiceServer)(nil),
@@ -2310,6 +4344,10 @@ var _AdminService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "NodeInfo",
 			Handler:    _AdminService_NodeInfo_Handler,
 		},
+		{
+			MethodName: "TraceTransaction",
+			Handler:    _AdminService_TraceTransaction_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "rpc.proto",
@@ -2318,142 +4356,248 @@ var _AdminService_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("rpc.proto", fileDescriptorRpc) }
 
 var fileDescriptorRpc = []byte{
-	// 2190 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0x4b, 0x6f, 0x1b, 0xc9,
-	0x11, 0x06, 0x49, 0x3d, 0xc8, 0x22, 0x25, 0xd1, 0xad, 0xd7, 0x88, 0x96, 0x64, 0xb9, 0xbd, 0xd8,
-	0xd5, 0x1a, 0x59, 0x71, 0xad, 0x05, 0x9c, 0xc0, 0xc1, 0x06, 0xb0, 0x1d, 0xaf, 0xd6, 0x81, 0x61,
-	0x38, 0x23, 0x6f, 0xb2, 0x40, 0xe2, 0x10, 0xcd, 0x61, 0x8b, 0xec, 0xec, 0x68, 0x66, 0x32, 0xdd,
-	0xb4, 0x2d, 0x5d, 0x02, 0xec, 0x3d, 0xa7, 0x5c, 0x72, 0xc8, 0x3f, 0xc8, 0xaf, 0x09, 0x72, 0xc8,
-	0x25, 0xc7, 0x9c, 0x72, 0xcb, 0x3f, 0x08, 0xba, 0xa6, 0x7b, 0x5e, 0x1c, 0x8a, 0x71, 0x0e, 0xb9,
-	0x4d, 0x55, 0x77, 0x57, 0x55, 0xd7, 0xe3, 0xab, 0x6a, 0x12, 0x5a, 0x71, 0xe4, 0x9d, 0x44, 0x71,
-	0xa8, 0x42, 0xb2, 0x1c, 0x47, 0x5e, 0x34, 0xec, 0xed, 0x8f, 0xc3, 0x70, 0xec, 0xf3, 0x3e, 0x8b,
-	0x44, 0x9f, 0x05, 0x41, 0xa8, 0x98, 0x12, 0x61, 0x20, 0x93, 0x4d, 0xbd, 0x1f, 0x8d, 0x85, 0x9a,
-	0x4c, 0x87, 0x27, 0x5e, 0x78, 0xd9, 0x0f, 0xf8, 0x70, 0xea, 0x33, 0x29, 0xc2, 0xfe, 0x38, 0xfc,
-	0xcc, 0x10, 0x7d, 0x2f, 0x0c, 0x24, 0x0f, 0xe4, 0x54, 0xf6, 0xa3, 0x61, 0x5f, 0x2a, 0xa6, 0xb8,
-	0x39, 0xf9, 0x70, 0xd1, 0xc9, 0x80, 0x0f, 0x7d, 0xae, 0xf4, 0x31, 0x2f, 0x0c, 0x2e, 0xc4, 0x38,
-	0x39, 0x47, 0xef, 0x43, 0xf7, 0x7c, 0x3a, 0x94, 0x5e, 0x2c, 0x86, 0xdc, 0xe5, 0xbf, 0x9b, 0x72,
-	0xa9, 0xc8, 0x0e, 0xac, 0xa8, 0x30, 0x12, 0x9e, 0x74, 0x6a, 0x47, 0x8d, 0xe3, 0x96, 0x6b, 0x28,
-	0xfa, 0x25, 0xdc, 0xca, 0xed, 0x95, 0x91, 0xb6, 0x85, 0x6c, 0xc1, 0x32, 0x2e, 0x3b, 0xb5, 0xa3,
-	0xda, 0x71, 0xcb, 0x4d, 0x08, 0x42, 0x60, 0x69, 0xc4, 0x14, 0x73, 0xea, 0xc8, 0xc4, 0x6f, 0x4a,
-	0xa0, 0xfb, 0x32, 0x0c, 0x5e, 0xb1, 0x98, 0x5d, 0x4a, 0xa3, 0x8a, 0xfe, 0xb9, 0xae, 0x99, 0x23,
-	0xfe, 0x3c, 0xb8, 0x08, 0x53, 0x91, 0xeb, 0x50, 0x17, 0x23, 0x23, 0xaf, 0x2e, 0x46, 0x64, 0x0f,
-	0x9a, 0xde, 0x84, 0x89, 0x60, 0x20, 0x46, 0x28, 0x70, 0xcd, 0x5d, 0x45, 0xfa, 0xf9, 0x88, 0xf4,
-	0xa0, 0xe9, 0x85, 0x22, 0x18, 0x32, 0xc9, 0x9d, 0x06, 0x1e, 0x48, 0x69, 0x72, 0x00, 0x10, 0x71,
-	0x1e, 0x0f, 0xbc, 0x70, 0x1a, 0x28, 0x67, 0x09, 0x0f, 0xb6, 0x34, 0xe7, 0xa9, 0x66, 0x10, 0x0a,
-	0x1d, 0x79, 0x15, 0x78, 0x93, 0x38, 0x0c, 0xc4, 0x35, 0x1f, 0x39, 0xcb, 0x47, 0xb5, 0xe3, 0xa6,
-	0x5b, 0xe0, 0x91, 0x3b, 0xd0, 0x1e, 0x4e, 0xbd, 0xef, 0xb8, 0x1a, 0x48, 0x71, 0xcd, 0x9d, 0x95,
-	0xa3, 0xda, 0xf1, 0xb2, 0x0b, 0x09, 0xeb, 0x5c, 0x5c, 0x73, 0xf2, 0x29, 0x74, 0xd1, 0x8f, 0x5e,
-	0xe8, 0x0f, 0xde, 0xf2, 0x58, 0x8a, 0x30, 0x70, 0x00, 0xed, 0xd8, 0xb0, 0xfc, 0x5f, 0x24, 0x6c,
-	0x72, 0x0a, 0xed, 0x38, 0x9c, 0x2a, 0x3e, 0x50, 0x6c, 0xe8, 0x73, 0xa7, 0x7d, 0xd4, 0x38, 0x6e,
-	0x9f, 0xde, 0x3a, 0xc1, 0xb4, 0x38, 0x71, 0xf5, 0xca, 0x6b, 0xbd, 0xe0, 0x42, 0x9c, 0x7e, 0xd3,
-	0x87, 0x00, 0xd9, 0xca, 0x8c, 0x5f, 0x1c, 0x58, 0x65, 0xa3, 0x51, 0xcc, 0xa5, 0x74, 0xea, 0x18,
-	0x28, 0x4b, 0xd2, 0xbf, 0xd7, 0x60, 0xf3, 0x8c, 0xab, 0x97, 0x7c, 0x78, 0xae, 0x73, 0x24, 0xf5,
-	0x6c, 0xde, 0x93, 0xb5, 0xa2, 0x27, 0x09, 0x2c, 0x29, 0x26, 0x7c, 0x1b, 0x31, 0xfd, 0x4d, 0xba,
-	0xd0, 0xf0, 0xc5, 0xd0, 0x38, 0x56, 0x7f, 0xea, 0xd4, 0x98, 0x70, 0x31, 0x9e, 0x24, 0xfe, 0x5c,
-	0x72, 0x0d, 0x55, 0xe9, 0x87, 0x95, 0x6a, 0x3f, 0x94, 0xfd, 0xbe, 0x5a, 0xe1, 0x77, 0x07, 0x56,
-	0xad, 0x94, 0x26, 0x4a, 0xb1, 0x24, 0xfd, 0x1c, 0xba, 0x8f, 0x3d, 0x8c, 0xa8, 0x4c, 0x6f, 0xb5,
-	0x0f, 0x2d, 0x73, 0x71, 0x6e, 0x53, 0x36, 0x63, 0xd0, 0x9f, 0xc1, 0xce, 0x19, 0x57, 0xe6, 0x90,
-	0x71, 0x47, 0x92, 0xe7, 0x39, 0xff, 0x25, 0x4e, 0xb5, 0x64, 0xee, 0x9a, 0xf5, 0xfc, 0x35, 0xe9,
-	0x1b, 0xd8, 0x9d, 0x91, 0x65, 0x8c, 0x70, 0x60, 0x75, 0xc8, 0x7c, 0x16, 0x78, 0xdc, 0x0a, 0x33,
-	0xa4, 0xae, 0x90, 0x20, 0xd4, 0xfc, 0x44, 0x56, 0x42, 0xa0, 0xbf, 0xaf, 0xa2, 0x24, 0x6b, 0xd7,
-	0x5c, 0xfc, 0xa6, 0xbf, 0x85, 0xce, 0x53, 0xe6, 0xfb, 0xa9, 0xcc, 0x1d, 0x58, 0x89, 0xb9, 0x9c,
-	0xfa, 0xca, 0x88, 0x34, 0x94, 0x4e, 0x4b, 0xfe, 0x9e, 0x7b, 0x3a, 0x99, 0x78, 0x1c, 0x9b, 0x90,
-	0x81, 0x61, 0x3d, 0x8b, 0x63, 0x72, 0x17, 0x3a, 0x5c, 0x2a, 0x71, 0xc9, 0x14, 0x1f, 0x8c, 0x99,
-	0x34, 0x11, 0x6c, 0x5b, 0xde, 0x19, 0x93, 0xf4, 0x04, 0xb6, 0x9e, 0x5c, 0x3d, 0xf1, 0x43, 0xef,
-	0xbb, 0xaf, 0xf1, 0x6e, 0xb9, 0xe2, 0x37, 0x57, 0xaf, 0x15, 0xae, 0xfe, 0x03, 0x20, 0x67, 0x5c,
-	0xfd, 0xf4, 0x2a, 0x60, 0x52, 0x5d, 0xe5, 0x2d, 0xbc, 0x14, 0x01, 0x8f, 0x53, 0xa8, 0x48, 0x28,
-	0xfa, 0xaf, 0x1a, 0x90, 0xd7, 0x31, 0x0b, 0x24, 0xf3, 0x34, 0xbe, 0x59, 0xe1, 0x04, 0x96, 0x2e,
-	0xe2, 0xf0, 0xd2, 0x5c, 0x07, 0xbf, 0x75, 0x56, 0xab, 0xd0, 0xdc, 0xa1, 0xae, 0x42, 0xed, 0xae,
-	0xb7, 0xcc, 0x9f, 0xda, 0x7a, 0x4e, 0x88, 0xcc, 0x89, 0x4b, 0x79, 0x27, 0xde, 0x86, 0xd6, 0x98,
-	0xc9, 0x41, 0x14, 0x0b, 0x8f, 0x63, 0x01, 0xb7, 0xdc, 0xe6, 0x98, 0xc9, 0x57, 0x9a, 0xb6, 0x8b,
-	0xbe, 0xb8, 0x14, 0xca, 0x24, 0xa3, 0x5e, 0x7c, 0xa1, 0x69, 0x72, 0xaa, 0x81, 0x23, 0x50, 0x31,
-	0xf3, 0x14, 0x66, 0x60, 0xfb, 0x74, 0xc7, 0x94, 0xe2, 0x53, 0xc3, 0x36, 0x36, 0xbb, 0xe9, 0x3e,
-	0x7d, 0xd9, 0xa1, 0x08, 0x58, 0x7c, 0x85, 0x25, 0xde, 0x71, 0x0d, 0x45, 0xaf, 0x61, 0xa3, 0x74,
-	0x48, 0x6f, 0x95, 0xe1, 0x34, 0x4e, 0x93, 0xc1, 0x50, 0x3a, 0x72, 0xc9, 0xd7, 0x00, 0x83, 0x6f,
-	0x22, 0x97, 0xb0, 0x5e, 0x5f, 0x45, 0x5c, 0x03, 0xda, 0xc5, 0x34, 0x40, 0xa7, 0x59, 0x40, 0xb3,
-	0xb4, 0xf6, 0x1e, 0x8b, 0xc7, 0x12, 0x5d, 0xd0, 0x72, 0xf1, 0x9b, 0xf6, 0x61, 0xef, 0x9c, 0x07,
-	0x23, 0x97, 0xbd, 0xab, 0x76, 0x37, 0xa2, 0x70, 0x0d, 0xcd, 0x4d, 0x50, 0xf8, 0xd7, 0xb0, 0xab,
-	0x0f, 0x14, 0x76, 0x67, 0xc1, 0x54, 0xef, 0x27, 0x4c, 0x4e, 0xac, 0xd1, 0x09, 0xa5, 0x8b, 0xdb,
-	0xfa, 0x60, 0x90, 0x01, 0x0e, 0x16, 0xb7, 0xe5, 0x3f, 0x36, 0xc0, 0x33, 0x80, 0xed, 0x33, 0xae,
-	0x30, 0xad, 0x9e, 0x5c, 0x7d, 0xcd, 0xe4, 0x24, 0x67, 0x4a, 0x4e, 0x32, 0x7e, 0x93, 0x53, 0xd8,
-	0xbe, 0x98, 0xfa, 0xfe, 0xe0, 0x42, 0xf8, 0xfe, 0x40, 0x65, 0x06, 0xa1, 0xf0, 0xa6, 0xbb, 0xa9,
-	0x17, 0xbf, 0x12, 0xbe, 0x9f, 0xb3, 0x95, 0x72, 0xac, 0x40, 0xab, 0xe0, 0xbf, 0xc9, 0xdc, 0xff,
-	0x49, 0xcd, 0x03, 0xb8, 0x7d, 0xc6, 0x55, 0x8e, 0xb3, 0xf0, 0x36, 0xf4, 0x1f, 0x0d, 0x58, 0x43,
-	0xbb, 0x52, 0x7f, 0x56, 0xdd, 0xf9, 0x0e, 0xb4, 0x23, 0x16, 0xf3, 0x40, 0x0d, 0x70, 0xc9, 0x24,
-	0x40, 0xc2, 0xd2, 0x1a, 0x72, 0xb7, 0x68, 0x14, 0x6e, 0x51, 0x5d, 0x00, 0xf9, 0xfe, 0xb7, 0x5c,
-	0xea, 0x7f, 0xfb, 0xd0, 0x52, 0xe2, 0x92, 0x4b, 0xc5, 0x2e, 0x23, 0xcc, 0xff, 0x86, 0x9b, 0x31,
-	0x0a, 0xad, 0x60, 0xb5, 0xd8, 0x0a, 0x0e, 0x00, 0x70, 0xb4, 0x18, 0xc4, 0x61, 0xa8, 0x0c, 0x00,
-	0xb7, 0x90, 0xe3, 0x86, 0xa1, 0xd2, 0x27, 0xd5, 0x7b, 0x99, 0x2c, 0xb6, 0x12, 0xa8, 0x53, 0xef,
-	0x25, 0x2e, 0x69, 0x60, 0x7a, 0xcb, 0x03, 0x65, 0x56, 0xc1, 0x00, 0x13, 0xb2, 0x70, 0xc3, 0x63,
-	0x58, 0x4f, 0x47, 0x98, 0x64, 0x4f, 0x1b, 0x8b, 0xaf, 0x77, 0x92, 0xb2, 0x93, 0x12, 0x4c, 0xbe,
-	0xf5, 0x19, 0x77, 0xcd, 0xcb, 0x93, 0xda, 0x11, 0x08, 0x32, 0x4e, 0x27, 0xc1, 0x07, 0x24, 0xb4,
-	0x66, 0x21, 0x07, 0x17, 0x22, 0x60, 0xbe, 0x50, 0x57, 0xce, 0x1a, 0x86, 0x16, 0x84, 0xfc, 0xca,
-	0x70, 0xc8, 0x4f, 0xa0, 0x93, 0x8b, 0xbd, 0x74, 0x46, 0xd8, 0x7f, 0x7b, 0xa6, 0xe8, 0x2b, 0xca,
-	0xc1, 0x2d, 0xec, 0xa7, 0xff, 0xae, 0xc3, 0x66, 0x55, 0xd1, 0x54, 0x05, 0xd9, 0x01, 0xeb, 0xcb,
-	0xf2, 0xbc, 0x62, 0x01, 0xb0, 0x31, 0x03, 0x80, 0x4b, 0xb3, 0x00, 0xb8, 0x5c, 0x09, 0x80, 0x2b,
-	0xf9, 0xf8, 0x17, 0x62, 0xbc, 0x5a, 0x8e, 0xb1, 0xed, 0x31, 0x4d, 0xd3, 0xd3, 0x35, 0xc0, 0x58,
-	0x4c, 0x68, 0x65, 0x98, 0x50, 0x84, 0x51, 0xb8, 0x09, 0x46, 0xdb, 0x25, 0x18, 0xad, 0x82, 0x86,
-	0x4e, 0x25, 0x34, 0x20, 0x24, 0x2a, 0xa6, 0xa6, 0x12, 0x83, 0xb3, 0xec, 0x1a, 0x4a, 0xa7, 0x93,
-	0x96, 0x3f, 0x95, 0x7c, 0xe4, 0xac, 0x27, 0xe9, 0x34, 0x66, 0xf2, 0x1b, 0xc9, 0x47, 0xf4, 0x0b,
-	0xb8, 0xf5, 0x92, 0xbf, 0x33, 0xed, 0xd6, 0xd6, 0xde, 0x21, 0x40, 0xc4, 0xa4, 0x8c, 0x26, 0xb1,
-	0x4e, 0xfa, 0x9a, 0x2d, 0x20, 0xcb, 0xa1, 0x27, 0x40, 0xf2, 0x87, 0xb2, 0xf6, 0x5c, 0xdd, 0xeb,
-	0xa9, 0x0f, 0x5b, 0xdf, 0x04, 0xba, 0x6e, 0x4b, 0x7a, 0xe6, 0x4f, 0x07, 0x45, 0x0b, 0xea, 0x65,
-	0x0b, 0x74, 0x51, 0x8e, 0xa6, 0x31, 0x4b, 0x31, 0x7c, 0xc9, 0x4d, 0x69, 0xda, 0x87, 0xed, 0x92,
-	0xb6, 0xca, 0x5e, 0xdf, 0xb4, 0xbd, 0x5e, 0x5f, 0xe7, 0xc5, 0x07, 0x18, 0x47, 0x3f, 0x83, 0xcd,
-	0x17, 0x1f, 0x20, 0xfe, 0xe7, 0xb0, 0x71, 0x2e, 0xc6, 0x41, 0x1e, 0xdc, 0xe6, 0x5f, 0xdc, 0xe6,
-	0x7a, 0x3d, 0xc9, 0x1d, 0xcc, 0xf5, 0x2e, 0x34, 0x98, 0x3f, 0x36, 0x63, 0x8c, 0xfe, 0xa4, 0x1f,
-	0x43, 0x37, 0x13, 0x99, 0x55, 0xc9, 0x4c, 0x27, 0xfa, 0x3d, 0x1c, 0xe9, 0x7d, 0xb9, 0xa2, 0x7a,
-	0x95, 0xfa, 0xd0, 0xda, 0xf2, 0x63, 0x68, 0xe7, 0x11, 0xbb, 0x86, 0x60, 0xb1, 0x57, 0x55, 0xb4,
-	0x49, 0xb3, 0xce, 0xef, 0x5e, 0x14, 0x27, 0xfa, 0x43, 0xb8, 0x7b, 0x83, 0x01, 0x0b, 0x2c, 0x2f,
-	0xf6, 0xd0, 0xff, 0xb3, 0xe5, 0x7d, 0xe8, 0x9e, 0x99, 0xfa, 0x4c, 0x0d, 0x2d, 0x14, 0x71, 0xad,
-	0x58, 0xc4, 0xf4, 0x2e, 0xb4, 0x17, 0xf5, 0xaf, 0x07, 0xd0, 0x3e, 0x63, 0xd9, 0x50, 0xdd, 0x85,
-	0x86, 0x9e, 0x1c, 0x93, 0x1d, 0xfa, 0x53, 0x73, 0xb2, 0x69, 0x53, 0x7f, 0xd2, 0x87, 0xb0, 0xfe,
-	0x2c, 0xc1, 0x76, 0x7b, 0xea, 0x23, 0x58, 0x49, 0xd0, 0x1e, 0xe7, 0xc1, 0xf6, 0x69, 0xc7, 0x5c,
-	0x18, 0xb7, 0xb9, 0x66, 0x8d, 0x3e, 0x80, 0x65, 0x64, 0x7c, 0xc0, 0xe3, 0xf1, 0x63, 0xe8, 0xbc,
-	0x8a, 0xe2, 0xf0, 0x22, 0xd7, 0xec, 0x7d, 0x21, 0x15, 0x0f, 0xec, 0xac, 0x92, 0x50, 0xf4, 0x13,
-	0x58, 0x33, 0xfb, 0x16, 0x24, 0xfe, 0x97, 0x70, 0xeb, 0x8c, 0xab, 0xa7, 0xf8, 0x16, 0x4e, 0x37,
-	0x1f, 0xc3, 0x4a, 0xf2, 0x3a, 0x36, 0xf1, 0xea, 0x9e, 0x24, 0xcf, 0xe6, 0xa4, 0x27, 0xe9, 0x9d,
-	0x66, 0xfd, 0xf4, 0xaf, 0x00, 0xf0, 0x38, 0x12, 0xe7, 0x3c, 0x7e, 0xab, 0x41, 0xf2, 0x0d, 0xb4,
-	0x73, 0xef, 0x2d, 0xb2, 0x6b, 0xae, 0x5d, 0x7e, 0xef, 0xf6, 0x6c, 0xbf, 0xa9, 0x78, 0x9c, 0xd1,
-	0xbd, 0xef, 0xff, 0xf6, 0xcf, 0x3f, 0xd6, 0x37, 0xc9, 0xad, 0xfe, 0xdb, 0x07, 0xfd, 0xa9, 0xe4,
-	0xb1, 0x7e, 0xb3, 0x63, 0xdb, 0x25, 0xbf, 0x81, 0xdd, 0x17, 0x4c, 0x71, 0xa9, 0x9e, 0xc7, 0x31,
-	0xc7, 0xa7, 0xd0, 0xd0, 0xe7, 0x38, 0x6c, 0xcc, 0x57, 0xb5, 0x65, 0x16, 0x0a, 0x33, 0x09, 0xdd,
-	0x42, 0x25, 0xeb, 0xa4, 0x93, 0x2a, 0xd1, 0xcf, 0xba, 0x18, 0x36, 0x4a, 0xef, 0x1a, 0x72, 0x90,
-	0x59, 0x5a, 0xf1, 0x76, 0xea, 0x1d, 0xce, 0x5b, 0x36, 0x7a, 0x8e, 0x50, 0x4f, 0x8f, 0x6e, 0xa7,
-	0x7a, 0x98, 0x79, 0xb6, 0xe9, 0x6d, 0x8f, 0x6a, 0xf7, 0xc9, 0x2b, 0x58, 0xd2, 0x8f, 0x1d, 0x32,
-	0xbf, 0x26, 0x7a, 0x9b, 0x76, 0x24, 0xcf, 0x3d, 0x8a, 0xa8, 0x83, 0x92, 0x09, 0x5d, 0x4b, 0x25,
-	0x7b, 0xcc, 0xf7, 0xb5, 0xc4, 0x6b, 0x20, 0xb3, 0xb3, 0x30, 0x39, 0x32, 0x42, 0xe6, 0x8e, 0xc9,
-	0xe9, 0x5d, 0xe6, 0xcc, 0xc5, 0x94, 0xa2, 0xc6, 0x7d, 0xba, 0x9b, 0x6a, 0x8c, 0xd9, 0xbb, 0x5c,
-	0xb9, 0x6a, 0xdd, 0x13, 0x58, 0x2f, 0x0e, 0xbe, 0x64, 0x3f, 0xf3, 0xd0, 0xec, 0x3c, 0x3c, 0x27,
-	0x3a, 0xb3, 0x9a, 0xc6, 0x85, 0xd3, 0x5a, 0x53, 0x00, 0xdd, 0xf2, 0x04, 0x4c, 0x0e, 0x67, 0x75,
-	0xe5, 0x47, 0xe3, 0x39, 0xda, 0x3e, 0x42, 0x6d, 0x87, 0x74, 0xaf, 0x4a, 0x1b, 0x9e, 0xd7, 0xfa,
-	0xbe, 0xaf, 0xe1, 0x4c, 0x5f, 0x70, 0x8c, 0xc7, 0x45, 0xa4, 0x08, 0xcd, 0xb4, 0xce, 0x9b, 0x94,
-	0x7b, 0x37, 0x0c, 0x58, 0xf4, 0x53, 0xd4, 0x7f, 0x8f, 0x1e, 0xe6, 0xf5, 0xcf, 0xea, 0xd1, 0x46,
-	0x0c, 0xa0, 0x95, 0xfe, 0xf4, 0x94, 0xa6, 0x7c, 0xf9, 0x87, 0xab, 0x9e, 0x33, 0xbb, 0x60, 0x54,
-	0x1d, 0xa0, 0xaa, 0x5d, 0x4a, 0x52, 0x55, 0xd2, 0xee, 0x79, 0x54, 0xbb, 0xff, 0x79, 0xcd, 0x14,
-	0xb0, 0x05, 0xd5, 0xf9, 0x55, 0x65, 0x17, 0xca, 0xf0, 0x4b, 0xf7, 0x51, 0xc3, 0x0e, 0xd9, 0xca,
-	0x5f, 0x26, 0x95, 0xf7, 0x06, 0xda, 0xcf, 0xb2, 0xc7, 0xf7, 0x4d, 0x39, 0x4f, 0x32, 0x05, 0xa9,
-	0xec, 0x3b, 0x28, 0x7b, 0x8f, 0x66, 0xb2, 0x73, 0x2f, 0x79, 0xed, 0x1e, 0x86, 0xf5, 0x9b, 0x60,
-	0xb1, 0x49, 0x3f, 0x2b, 0x27, 0x1f, 0x8c, 0xed, 0x3c, 0x1a, 0x67, 0xe2, 0xef, 0xa1, 0xf8, 0x03,
-	0xea, 0xe4, 0x4d, 0xcf, 0x0b, 0x4b, 0x54, 0x40, 0xf6, 0xfe, 0x27, 0xb7, 0x6d, 0x42, 0x55, 0xfc,
-	0x84, 0xd0, 0xdb, 0xcb, 0xf2, 0xa2, 0xf4, 0x7b, 0x01, 0xbd, 0x8d, 0xaa, 0xb6, 0x69, 0x37, 0x55,
-	0x35, 0x4a, 0x76, 0x3c, 0xaa, 0xdd, 0x3f, 0xfd, 0x4b, 0x0b, 0x3a, 0x8f, 0x47, 0x97, 0x22, 0xb0,
-	0xa8, 0xfa, 0x2d, 0x34, 0xed, 0x8f, 0x3d, 0x8b, 0x23, 0x52, 0xfe, 0x59, 0x88, 0xf6, 0x50, 0xd7,
-	0x16, 0xc1, 0x98, 0x33, 0x2d, 0x37, 0xc5, 0x20, 0xe2, 0x01, 0x64, 0x43, 0x22, 0xb1, 0x79, 0x33,
-	0x33, 0x6c, 0xa6, 0x57, 0x99, 0x9d, 0x28, 0x8b, 0x08, 0x57, 0x10, 0xdf, 0x0f, 0xf8, 0x3b, 0xed,
-	0xb2, 0x10, 0xd6, 0x0a, 0xb3, 0x5e, 0xea, 0xb5, 0xaa, 0x79, 0xb3, 0xb7, 0x5f, 0xbd, 0x58, 0x15,
-	0xa3, 0xa2, 0xb6, 0x29, 0x1e, 0xd0, 0x0a, 0xc7, 0xd0, 0xce, 0xcd, 0x7e, 0x69, 0x96, 0xcd, 0xce,
-	0x8f, 0x69, 0x59, 0x56, 0x8c, 0x8a, 0xf4, 0x2e, 0xaa, 0xba, 0x4d, 0x77, 0x66, 0x55, 0x59, 0x45,
-	0x01, 0x6c, 0x94, 0xc0, 0xf2, 0xa6, 0x94, 0x5e, 0x84, 0xaf, 0x15, 0x9e, 0x2c, 0xa1, 0xeb, 0xaf,
-	0xa0, 0x69, 0x47, 0x4a, 0x62, 0x7f, 0xa7, 0x29, 0x8d, 0xad, 0x69, 0x1e, 0x94, 0x67, 0x4f, 0x7a,
-	0x88, 0xe2, 0x1d, 0xba, 0x99, 0x89, 0x97, 0x62, 0x1c, 0xf4, 0x27, 0x26, 0xb3, 0xff, 0x50, 0x83,
-	0x83, 0xd2, 0x1c, 0xf8, 0x4b, 0xa1, 0x26, 0xd9, 0x48, 0x47, 0x3e, 0xc9, 0x89, 0xbe, 0x69, 0xe8,
-	0xeb, 0x1d, 0x2f, 0xde, 0x58, 0x6c, 0xf6, 0x74, 0xbd, 0x68, 0x94, 0xb6, 0xe7, 0x4f, 0xda, 0x9e,
-	0xa2, 0xab, 0xe6, 0xd9, 0xb3, 0x60, 0x08, 0x5d, 0xe8, 0xf9, 0x13, 0xb4, 0xe2, 0x98, 0xde, 0xab,
-	0xf4, 0x7c, 0x51, 0xab, 0x36, 0xed, 0x1c, 0xe0, 0x5c, 0xb1, 0x58, 0xe1, 0x88, 0x45, 0x6c, 0x7b,
-	0xce, 0x0f, 0x66, 0x69, 0xab, 0x29, 0x4c, 0x61, 0xb6, 0x16, 0xe9, 0x46, 0xa6, 0x28, 0xd2, 0x1b,
-	0x92, 0xe0, 0xb6, 0xd2, 0x49, 0x6c, 0x7e, 0x99, 0x3b, 0x19, 0xa8, 0x14, 0x87, 0x36, 0x8b, 0x29,
-	0x24, 0x17, 0xdf, 0x71, 0x2a, 0xef, 0x5b, 0x68, 0xda, 0xff, 0x17, 0x16, 0x43, 0x48, 0xf9, 0x9f,
-	0x88, 0x2a, 0x08, 0x09, 0xc2, 0x11, 0x17, 0xc1, 0x45, 0x38, 0x5c, 0xc1, 0x1f, 0xb6, 0xbf, 0xf8,
-	0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x97, 0x9c, 0x43, 0x6f, 0xe4, 0x19, 0x00, 0x00,
+	// 3885 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x3b, 0x5d, 0x8f, 0x1c, 0x49,
+	0x52, 0xaa, 0xf9, 0xec, 0x8e, 0xee, 0xf9, 0x70, 0xce, 0x78, 0xa6, 0x5d, 0x9e, 0x0f, 0x4f, 0x7a,
+	0xd7, 0xeb, 0xb3, 0x6e, 0x67, 0x76, 0x67, 0x75, 0x3e, 0xd6, 0x70, 0x27, 0xd9, 0xbe, 0xf5, 0x9c,
+	0x25, 0xcb, 0xe7, 0xab, 0xf1, 0xde, 0x1d, 0x82, 0xa5, 0x95, 0xdd, 0x9d, 0xd3, 0x53, 0xe7, 0x9a,
+	0xaa, 0xde, 0xca, 0x6c, 0x7b, 0x66, 0x11, 0x1f, 0x7b, 0x02, 0x21, 0x24, 0x78, 0x40, 0xbc, 0x00,
+	0xba, 0x3f, 0x80, 0x90, 0x90, 0x78, 0xe1, 0x95, 0x37, 0x24, 0x9e, 0x79, 0xe0, 0x01, 0x1e, 0x79,
+	0x46, 0xe2, 0x1f, 0xa0, 0x8c, 0xcc, 0xac, 0xca, 0xaa, 0xae, 0xea, 0xb6, 0x0f, 0x2d, 0xe2, 0x2d,
+	0x23, 0x32, 0x33, 0x22, 0x32, 0x32, 0x32, 0x22, 0x32, 0xb2, 0x0a, 0x9a, 0xe9, 0xa8, 0x7f, 0x38,
+	0x4a, 0x13, 0x99, 0x90, 0xc5, 0x74, 0xd4, 0x1f, 0xf5, 0xfc, 0x9d, 0x61, 0x92, 0x0c, 0x23, 0x7e,
+	0xc4, 0x46, 0xe1, 0x11, 0x8b, 0xe3, 0x44, 0x32, 0x19, 0x26, 0xb1, 0xd0, 0x83, 0xfc, 0x5f, 0x1b,
+	0x86, 0xf2, 0x7c, 0xdc, 0x3b, 0xec, 0x27, 0x17, 0x47, 0x31, 0xef, 0x8d, 0x23, 0x26, 0xc2, 0xe4,
+	0x68, 0x98, 0x7c, 0x68, 0x80, 0xa3, 0x7e, 0x12, 0x0b, 0x1e, 0x8b, 0xb1, 0x38, 0x1a, 0xf5, 0x8e,
+	0x84, 0x64, 0x92, 0x9b, 0x99, 0xf7, 0x67, 0xcd, 0x8c, 0x79, 0x2f, 0xe2, 0x52, 0x4d, 0xeb, 0x27,
+	0xf1, 0x59, 0x38, 0xd4, 0xf3, 0xe8, 0xbf, 0x7b, 0xb0, 0x7e, 0x3a, 0xee, 0x89, 0x7e, 0x1a, 0xf6,
+	0x78, 0xc0, 0xbf, 0x1c, 0x73, 0x21, 0xc9, 0x16, 0x2c, 0xc9, 0x64, 0x14, 0xf6, 0x45, 0xc7, 0xbb,
+	0x35, 0x7f, 0xb7, 0x19, 0x18, 0x88, 0xf8, 0xd0, 0xe8, 0x27, 0xb1, 0x4c, 0x59, 0x5f, 0x76, 0xe6,
+	0x6e, 0x79, 0x77, 0x9b, 0x41, 0x06, 0x13, 0x02, 0x0b, 0x67, 0x69, 0x72, 0xd1, 0x99, 0x47, 0x3c,
+	0xb6, 0xc9, 0x2a, 0xcc, 0xc9, 0xa4, 0xb3, 0x80, 0x98, 0x39, 0x99, 0x90, 0x23, 0x58, 0x3a, 0x0b,
+	0x79, 0x34, 0x10, 0x9d, 0xc5, 0x5b, 0xf3, 0x77, 0x5b, 0xc7, 0xdb, 0x87, 0xa8, 0x94, 0xc3, 0xcf,
+	0x5e, 0xf3, 0x58, 0x3e, 0x51, 0x3d, 0x4f, 0xc2, 0x48, 0xf2, 0x34, 0x30, 0xc3, 0xc8, 0x3e, 0xb4,
+	0x14, 0xa1, 0xee, 0x39, 0x0f, 0x87, 0xe7, 0xb2, 0xb3, 0x74, 0xcb, 0xbb, 0xbb, 0x10, 0x80, 0x42,
+	0xfd, 0x10, 0x31, 0x64, 0x17, 0x10, 0xea, 0x86, 0xf1, 0x80, 0x5f, 0x76, 0x96, 0xb1, 0xbf, 0xa9,
+	0x30, 0x4f, 0x15, 0x82, 0xfe, 0x93, 0x07, 0xd7, 0x9c, 0xd5, 0x89, 0x91, 0x52, 0x1f, 0xd9, 0x84,
+	0x45, 0x5c, 0x50, 0xc7, 0x43, 0xc9, 0x34, 0xa0, 0x16, 0x30, 0x60, 0x92, 0x99, 0x85, 0x61, 0x5b,
+	0x29, 0xc2, 0xb0, 0x9e, 0x47, 0xd2, 0x06, 0x52, 0x14, 0x34, 0xc7, 0x05, 0x44, 0x6b, 0x40, 0x09,
+	0xd3, 0x8b, 0x92, 0xfe, 0xab, 0xee, 0x39, 0x13, 0xe7, 0x9d, 0x45, 0xa4, 0xd3, 0x44, 0xcc, 0x0f,
+	0x99, 0x38, 0x27, 0xdb, 0xb0, 0x2c, 0x2f, 0x75, 0xdf, 0x12, 0xf6, 0x2d, 0xc9, 0x4b, 0xec, 0xf0,
+	0xa1, 0x91, 0xbc, 0xe6, 0xe9, 0x59, 0x94, 0xbc, 0xc1, 0x25, 0x34, 0x82, 0x0c, 0xa6, 0x04, 0xd6,
+	0x9f, 0x27, 0xf1, 0x0b, 0x96, 0xb2, 0x0b, 0x61, 0xb6, 0x87, 0xfe, 0x72, 0x4e, 0x21, 0x07, 0xfc,
+	0x69, 0x7c, 0x96, 0x64, 0x8b, 0x5a, 0x85, 0xb9, 0x70, 0x60, 0x56, 0x34, 0x17, 0x0e, 0xc8, 0x0d,
+	0x68, 0xf4, 0xcf, 0x59, 0x18, 0x77, 0xc3, 0x01, 0x2e, 0x69, 0x25, 0x58, 0x46, 0xf8, 0xe9, 0x40,
+	0x6f, 0x63, 0x18, 0xf7, 0x98, 0xe0, 0x66, 0xbb, 0x32, 0x58, 0xad, 0x61, 0xc4, 0x79, 0xda, 0xed,
+	0x27, 0xe3, 0x58, 0xe2, 0xf2, 0x56, 0x82, 0xa6, 0xc2, 0x3c, 0x56, 0x08, 0x42, 0xa1, 0x2d, 0xae,
+	0xe2, 0xfe, 0x79, 0x9a, 0xc4, 0xe1, 0x57, 0x7c, 0x80, 0x8b, 0x6c, 0x04, 0x05, 0x9c, 0xda, 0xb4,
+	0xde, 0xb8, 0xff, 0x8a, 0xcb, 0xae, 0x08, 0xbf, 0xe2, 0xb8, 0xd6, 0xc5, 0x00, 0x34, 0xea, 0x34,
+	0xfc, 0x8a, 0x93, 0x6f, 0xc1, 0x3a, 0x1a, 0x5f, 0x3f, 0x89, 0xba, 0xaf, 0x79, 0x2a, 0xc2, 0x24,
+	0xee, 0x00, 0xca, 0xb1, 0x66, 0xf1, 0x3f, 0xd1, 0x68, 0x72, 0x0c, 0xad, 0x34, 0x19, 0x4b, 0xde,
+	0x95, 0xac, 0x17, 0xf1, 0x4e, 0x0b, 0xcd, 0xe6, 0x9a, 0x31, 0x9b, 0x40, 0xf5, 0xbc, 0x54, 0x1d,
+	0x01, 0xa4, 0x59, 0x9b, 0xde, 0x07, 0xc8, 0x7b, 0x26, 0xf4, 0xd2, 0x81, 0x65, 0x36, 0x18, 0xa4,
+	0x5c, 0x88, 0xce, 0x1c, 0x1a, 0xb7, 0x05, 0xe9, 0xbf, 0x79, 0xb0, 0x71, 0xc2, 0xe5, 0x73, 0xde,
+	0x3b, 0x55, 0x07, 0x2b, 0xd3, 0xac, 0xab, 0x49, 0xaf, 0xa8, 0x49, 0x02, 0x0b, 0x92, 0x85, 0x91,
+	0xb5, 0x19, 0xd5, 0x26, 0xeb, 0x30, 0x1f, 0x85, 0x3d, 0xa3, 0x58, 0xd5, 0x74, 0xac, 0x68, 0xa1,
+	0x60, 0x45, 0x55, 0x7a, 0x58, 0xaa, 0xd6, 0x43, 0x59, 0xef, 0xcb, 0x15, 0x7a, 0xef, 0xc0, 0xb2,
+	0xa5, 0xd2, 0x40, 0x2a, 0x16, 0xa4, 0x1f, 0xc1, 0xfa, 0xc3, 0x3e, 0xee, 0xa8, 0xc8, 0x56, 0xb5,
+	0x03, 0x4d, 0xb3, 0x70, 0x6e, 0x8f, 0x79, 0x8e, 0xa0, 0x21, 0x6c, 0x9d, 0x70, 0x69, 0x26, 0x19,
+	0x75, 0x68, 0xdf, 0xe0, 0xe8, 0x4f, 0x2b, 0xd5, 0x82, 0xce, 0x32, 0xe7, 0x0a, 0xcb, 0x2c, 0x1e,
+	0x8b, 0xf9, 0xd2, 0xb1, 0xa0, 0x5f, 0xc0, 0xf6, 0x04, 0x2b, 0x23, 0x63, 0x07, 0x96, 0x7b, 0x2c,
+	0x62, 0x71, 0x9f, 0x5b, 0x5e, 0x06, 0x54, 0x07, 0x30, 0x4e, 0x14, 0x5e, 0xb3, 0xd2, 0x00, 0x6e,
+	0xc7, 0xd5, 0x48, 0x1b, 0xf5, 0x4a, 0x80, 0x6d, 0xfa, 0x73, 0x68, 0x3f, 0x66, 0x51, 0x94, 0xd1,
+	0xdc, 0x82, 0xa5, 0x94, 0x8b, 0x71, 0x24, 0x0d, 0x49, 0x03, 0x29, 0xab, 0xe5, 0x97, 0xbc, 0xaf,
+	0x6c, 0x8d, 0xa7, 0xa9, 0xd9, 0x51, 0x30, 0xa8, 0xcf, 0xd2, 0x94, 0x1c, 0x40, 0x9b, 0x0b, 0x19,
+	0x5e, 0x30, 0xc9, 0xbb, 0x43, 0x26, 0xcc, 0x42, 0x5a, 0x16, 0x77, 0xc2, 0x04, 0x3d, 0x84, 0xcd,
+	0x47, 0x57, 0x8f, 0x70, 0x65, 0xb8, 0x74, 0xc7, 0x9f, 0x1a, 0xcd, 0x78, 0xae, 0x66, 0xe8, 0xb7,
+	0x81, 0x9c, 0x70, 0xf9, 0x83, 0xab, 0x98, 0x09, 0x79, 0xe5, 0x4a, 0x78, 0x11, 0xc6, 0x3c, 0xcd,
+	0xbc, 0xaf, 0x86, 0xe8, 0x9f, 0xcd, 0x03, 0x79, 0x99, 0xb2, 0x58, 0xb0, 0xbe, 0x8a, 0x19, 0x96,
+	0xb8, 0x75, 0xbc, 0xde, 0x84, 0xe3, 0x9d, 0xcb, 0x1c, 0xef, 0x26, 0x2c, 0xbe, 0x66, 0xd1, 0xd8,
+	0x1e, 0x77, 0x0d, 0xe4, 0x4a, 0x5c, 0x70, 0x95, 0x78, 0x13, 0x9a, 0x43, 0x26, 0xba, 0xa3, 0x34,
+	0xec, 0x73, 0xe3, 0xc4, 0x1a, 0x43, 0x26, 0x5e, 0xa4, 0x61, 0xde, 0x19, 0x85, 0x17, 0xa1, 0xec,
+	0x2c, 0x65, 0x9d, 0xcf, 0x14, 0x4c, 0x8e, 0x9d, 0xf0, 0xa0, 0x0c, 0xb4, 0x75, 0xbc, 0x65, 0x4e,
+	0xea, 0x63, 0x83, 0x36, 0x32, 0x3b, 0x61, 0xe3, 0x3b, 0xd0, 0xec, 0xb3, 0x78, 0x10, 0x0e, 0x98,
+	0xe4, 0x68, 0xb6, 0x79, 0x54, 0x78, 0x6c, 0xf1, 0x76, 0x56, 0x3e, 0x52, 0xb1, 0x1a, 0xf0, 0x88,
+	0x0f, 0xd5, 0xac, 0x66, 0x81, 0xd5, 0x0f, 0x0c, 0x3a, 0x63, 0x65, 0xc7, 0x29, 0xbd, 0xf6, 0xc2,
+	0x98, 0xa5, 0x57, 0xe8, 0x6c, 0xda, 0x81, 0x81, 0x9c, 0xdd, 0x69, 0x4d, 0xb1, 0xdb, 0x76, 0xd9,
+	0x6e, 0xff, 0xd9, 0x83, 0xb5, 0xd2, 0xba, 0x14, 0x29, 0x91, 0x8c, 0xd3, 0xcc, 0x5e, 0x0d, 0xa4,
+	0x8c, 0x4b, 0xb7, 0xba, 0x68, 0x9f, 0xc6, 0xb8, 0x34, 0xea, 0xe5, 0xd5, 0x88, 0x2b, 0x97, 0x7c,
+	0x36, 0x8e, 0x71, 0x5f, 0xad, 0x4b, 0xb6, 0xb0, 0xda, 0x60, 0x96, 0x0e, 0x85, 0x89, 0xa3, 0xd8,
+	0x56, 0x27, 0x63, 0x3c, 0x1a, 0xa6, 0x6c, 0xc0, 0x8d, 0x0b, 0xb6, 0xa0, 0x72, 0x3f, 0xac, 0x17,
+	0x9a, 0xbd, 0x51, 0x4d, 0xb2, 0x07, 0xa0, 0x3b, 0xd1, 0x85, 0x6a, 0xcf, 0xe1, 0x60, 0xe8, 0x11,
+	0xdc, 0x38, 0xe5, 0xf1, 0x20, 0x60, 0x6f, 0xaa, 0xad, 0x0b, 0xa3, 0xa2, 0x87, 0x2a, 0xc3, 0x36,
+	0xfd, 0x6d, 0xd8, 0x56, 0x13, 0x0a, 0xa3, 0x73, 0xdb, 0x95, 0x97, 0xa8, 0x2f, 0xcf, 0x86, 0x38,
+	0x05, 0x29, 0x57, 0x67, 0xb7, 0xbc, 0x9b, 0xbb, 0x5f, 0x74, 0x75, 0x16, 0xff, 0x50, 0xa3, 0x69,
+	0x17, 0xae, 0x9f, 0x70, 0x89, 0xa7, 0xe8, 0xd1, 0x95, 0xd2, 0xb4, 0x23, 0x8a, 0x43, 0x19, 0xdb,
+	0xe4, 0x18, 0xae, 0x9f, 0x8d, 0xa3, 0xa8, 0x7b, 0x16, 0x46, 0x51, 0x57, 0xe6, 0x02, 0x21, 0xf1,
+	0x46, 0xb0, 0xa1, 0x3a, 0x9f, 0x84, 0x51, 0xe4, 0xc8, 0x4a, 0x39, 0x6c, 0x3b, 0x0c, 0xde, 0xe6,
+	0xa0, 0xfe, 0x4a, 0x6c, 0x3e, 0x86, 0x9b, 0x27, 0x5c, 0x3a, 0x98, 0x99, 0xab, 0xa1, 0xff, 0x31,
+	0x0f, 0x2b, 0x28, 0x57, 0xa6, 0xcf, 0xaa, 0x35, 0xef, 0x43, 0x6b, 0xc4, 0x52, 0x1e, 0x4b, 0x6d,
+	0x98, 0xc6, 0x98, 0x34, 0x4a, 0x71, 0x98, 0x96, 0xb5, 0x54, 0x9c, 0x77, 0x37, 0x1b, 0x58, 0x2c,
+	0x65, 0x03, 0x3b, 0xd0, 0x94, 0xe1, 0x05, 0x17, 0x92, 0x5d, 0x8c, 0xd0, 0xa4, 0xe6, 0x83, 0x1c,
+	0x51, 0x08, 0x8c, 0xcb, 0xc5, 0xc0, 0xb8, 0x0b, 0x80, 0xd9, 0x69, 0x37, 0x4d, 0x12, 0x69, 0xc2,
+	0x51, 0x13, 0x31, 0x41, 0x92, 0x48, 0x35, 0x53, 0x5e, 0x0a, 0xdd, 0xd9, 0xd4, 0x9e, 0x5d, 0x5e,
+	0x0a, 0xec, 0x52, 0x7e, 0x58, 0xa5, 0x83, 0xa6, 0x17, 0x8c, 0x1f, 0x46, 0x14, 0x0e, 0x78, 0x08,
+	0xab, 0x59, 0x16, 0xac, 0xc7, 0xb4, 0xd0, 0x01, 0xf8, 0x87, 0x19, 0x5a, 0x7b, 0x1c, 0xdd, 0x56,
+	0x73, 0x82, 0x95, 0xbe, 0x0b, 0x2a, 0x45, 0xa0, 0x4f, 0x35, 0x87, 0x5a, 0x03, 0x8a, 0x73, 0x28,
+	0xba, 0x67, 0x61, 0xcc, 0xa2, 0x50, 0x5e, 0x75, 0x56, 0xf4, 0x41, 0x09, 0xc5, 0x13, 0x83, 0x21,
+	0xdf, 0x87, 0xb6, 0xb3, 0xf7, 0xa2, 0x33, 0xc0, 0x6c, 0xc4, 0x37, 0x8e, 0xa7, 0xe2, 0x38, 0x04,
+	0x85, 0xf1, 0xf4, 0xbf, 0xe7, 0x60, 0xa3, 0xea, 0xd0, 0x54, 0x6d, 0x72, 0x07, 0xac, 0x2e, 0xcb,
+	0xd9, 0xdb, 0xdb, 0x24, 0xda, 0x99, 0xbf, 0x5f, 0xac, 0xf4, 0xf7, 0x4b, 0xee, 0xfe, 0x17, 0xf6,
+	0x78, 0xb9, 0xbc, 0xc7, 0x36, 0xa4, 0xea, 0x2d, 0xc4, 0x76, 0xe6, 0x13, 0x9a, 0xb9, 0x4f, 0x28,
+	0x46, 0x0d, 0x98, 0x16, 0x35, 0x5a, 0xa5, 0xa8, 0x51, 0xe5, 0x1a, 0xda, 0x95, 0xae, 0x01, 0xdd,
+	0xab, 0x64, 0x72, 0x2c, 0x70, 0x73, 0x16, 0x03, 0x03, 0x29, 0x73, 0x52, 0xf4, 0xc7, 0x82, 0x0f,
+	0x3a, 0xab, 0xda, 0x9c, 0x86, 0x4c, 0x7c, 0x2e, 0xf8, 0x80, 0x7e, 0x02, 0xd7, 0x9e, 0xf3, 0x37,
+	0x26, 0xbb, 0xb0, 0x67, 0x6f, 0x0f, 0x60, 0xc4, 0x84, 0x18, 0x9d, 0xa7, 0xca, 0xe8, 0x3d, 0x7b,
+	0x80, 0x2c, 0x86, 0x1e, 0x02, 0x71, 0x27, 0xe5, 0xd9, 0x48, 0x75, 0xe6, 0x43, 0x23, 0xd8, 0xfc,
+	0x3c, 0x56, 0xe7, 0xb6, 0xc4, 0xa7, 0x76, 0x46, 0x49, 0x82, 0xb9, 0xb2, 0x04, 0xea, 0x50, 0x0e,
+	0xc6, 0x29, 0xcb, 0xe2, 0xc1, 0x42, 0x90, 0xc1, 0xf4, 0x08, 0xae, 0x97, 0xb8, 0x55, 0xa6, 0x36,
+	0x0d, 0x9b, 0xda, 0xa8, 0xe5, 0x3c, 0x7b, 0x07, 0xe1, 0xe8, 0x87, 0xb0, 0xf1, 0xec, 0x1d, 0xc8,
+	0xff, 0x18, 0xd6, 0x4e, 0xc3, 0x61, 0xec, 0x3a, 0xb7, 0xfa, 0x85, 0x5b, 0x5b, 0x9f, 0xd3, 0xb6,
+	0xa3, 0xda, 0x18, 0xb2, 0xa2, 0xa1, 0xc9, 0xda, 0x54, 0x93, 0xde, 0x81, 0xf5, 0x9c, 0x64, 0x7e,
+	0x4a, 0x26, 0x22, 0xd1, 0x1f, 0xc0, 0x2d, 0x35, 0xce, 0x39, 0x54, 0x2f, 0x32, 0x1d, 0x5a, 0x59,
+	0x7e, 0x1d, 0x5a, 0xae, 0xc7, 0xf6, 0xd0, 0x59, 0xdc, 0xa8, 0x3a, 0xb4, 0x38, 0x3e, 0x70, 0x47,
+	0xcf, 0xda, 0x27, 0xfa, 0x5d, 0x38, 0x98, 0x22, 0xc0, 0x0c, 0xc9, 0x8b, 0x31, 0xf4, 0xff, 0x58,
+	0xf2, 0xff, 0xf2, 0x60, 0xfd, 0xc4, 0x1c, 0xd0, 0x4c, 0xd2, 0xc2, 0x29, 0xf6, 0x4a, 0xa7, 0x98,
+	0xc0, 0x82, 0x50, 0x57, 0x54, 0x73, 0xd9, 0x51, 0x6d, 0x65, 0xa7, 0x42, 0xb2, 0x78, 0xc0, 0xd2,
+	0x81, 0xcd, 0x5b, 0x2c, 0x8c, 0x8e, 0x8a, 0x09, 0x69, 0xf3, 0x16, 0xd5, 0xc6, 0x1c, 0x4c, 0x99,
+	0xae, 0x40, 0xcf, 0xb4, 0x12, 0x18, 0x48, 0xdd, 0x6f, 0x0a, 0xae, 0x75, 0x09, 0x7b, 0x0b, 0x38,
+	0x65, 0x54, 0x23, 0x1e, 0x0f, 0xc2, 0x78, 0x68, 0xa3, 0x8d, 0x01, 0xc9, 0x6d, 0x58, 0x19, 0x25,
+	0x49, 0xd4, 0xed, 0xb3, 0x11, 0xeb, 0x2b, 0xdf, 0xdd, 0xd0, 0xd3, 0x15, 0xf2, 0xb1, 0xc1, 0xd1,
+	0x03, 0x68, 0xcd, 0x8a, 0xbf, 0x1f, 0x43, 0xeb, 0x84, 0xe5, 0x57, 0xa4, 0x75, 0x98, 0x57, 0x89,
+	0xbe, 0x1e, 0xa1, 0x9a, 0x0a, 0x93, 0x5f, 0x0e, 0x54, 0x93, 0xde, 0x87, 0xd5, 0xcf, 0x74, 0x6c,
+	0xb2, 0xb3, 0xde, 0x83, 0x25, 0x1d, 0xad, 0x30, 0x7d, 0x6f, 0x1d, 0xb7, 0xdd, 0x22, 0x47, 0x60,
+	0xfa, 0xe8, 0xc7, 0xb0, 0x88, 0x88, 0xb7, 0x2f, 0x46, 0xd0, 0x3b, 0xd0, 0x7e, 0x31, 0x4a, 0x93,
+	0x33, 0x27, 0x59, 0x89, 0x42, 0x21, 0x79, 0x6c, 0x73, 0x2d, 0x0d, 0xd1, 0x0f, 0x60, 0xc5, 0x8c,
+	0x9b, 0x71, 0x70, 0xbf, 0x07, 0xd7, 0x4e, 0xb8, 0x7c, 0x8c, 0xe5, 0xa0, 0x6c, 0xf0, 0x5d, 0x58,
+	0xd2, 0x05, 0x22, 0x63, 0x6f, 0xeb, 0x87, 0xba, 0x72, 0xa4, 0x63, 0xaa, 0x1a, 0x69, 0xfa, 0xe9,
+	0x3d, 0x58, 0x2f, 0xa7, 0xe8, 0x8a, 0x95, 0x63, 0xad, 0xcd, 0xc0, 0x40, 0xf4, 0x04, 0xd6, 0x4a,
+	0x89, 0x79, 0xdd, 0x50, 0x15, 0x8f, 0x6c, 0xca, 0x6e, 0xed, 0x36, 0x47, 0xd0, 0xa7, 0x98, 0x1d,
+	0x3e, 0xd7, 0x45, 0xad, 0x80, 0xc5, 0xaf, 0x1c, 0x72, 0x23, 0x9e, 0x86, 0xc9, 0xc0, 0xa6, 0x6e,
+	0x1a, 0x2a, 0xde, 0xf7, 0x0b, 0x6e, 0xee, 0x97, 0x1e, 0x6c, 0x95, 0x69, 0xe5, 0x1a, 0xab, 0x24,
+	0x76, 0x00, 0x6d, 0x21, 0x59, 0x2a, 0xbb, 0x85, 0x8b, 0x6e, 0x0b, 0x71, 0x79, 0x45, 0x8a, 0xc7,
+	0x83, 0x6e, 0x21, 0x01, 0x6b, 0xf2, 0x78, 0x60, 0xba, 0xef, 0xc2, 0x62, 0xca, 0xe2, 0x57, 0x2a,
+	0x9b, 0x57, 0xc6, 0x41, 0x8c, 0x71, 0xb8, 0x42, 0xe8, 0x01, 0xf4, 0x4f, 0x3d, 0x68, 0x39, 0xe8,
+	0xe9, 0x3e, 0x55, 0x4d, 0x31, 0xd2, 0x60, 0x5b, 0x99, 0x95, 0xe8, 0x27, 0xa9, 0xbe, 0xf1, 0x79,
+	0x81, 0x06, 0x54, 0xa0, 0x0c, 0xe3, 0xae, 0x4e, 0x0d, 0xf4, 0xb1, 0x5c, 0x0e, 0xe3, 0x9f, 0x28,
+	0x50, 0x1d, 0xfd, 0x64, 0x2c, 0xbb, 0x6e, 0xda, 0xd0, 0x48, 0xc6, 0x12, 0x3b, 0xe9, 0x4f, 0x61,
+	0xed, 0x84, 0xcb, 0x17, 0x69, 0x92, 0x5b, 0xdf, 0xbb, 0xd7, 0x01, 0x08, 0x2c, 0xbc, 0xe2, 0x57,
+	0xea, 0xe2, 0xac, 0x6e, 0xb5, 0xd8, 0xa6, 0xff, 0xa2, 0xbc, 0x50, 0x46, 0xd9, 0x68, 0xbf, 0x78,
+	0xf1, 0xf2, 0xca, 0x75, 0xb4, 0x29, 0x75, 0x06, 0x27, 0xe7, 0x9c, 0x2f, 0xe7, 0x9c, 0xb7, 0x61,
+	0x85, 0xe9, 0x88, 0xd6, 0x1d, 0x29, 0x76, 0xb8, 0x03, 0xed, 0xa0, 0x6d, 0x90, 0x28, 0x02, 0x79,
+	0x00, 0xab, 0x42, 0x26, 0x29, 0x1b, 0x72, 0x3d, 0xc8, 0x56, 0x2a, 0x37, 0xcc, 0x3e, 0x9d, 0xea,
+	0x4e, 0x2d, 0xef, 0x8a, 0x70, 0x20, 0x41, 0x9f, 0x41, 0xdb, 0xed, 0x56, 0xce, 0xe2, 0x15, 0xbf,
+	0xb2, 0xee, 0xe3, 0x15, 0xbf, 0xca, 0xd3, 0x32, 0x1d, 0xfd, 0xf2, 0xb4, 0x4c, 0x0b, 0x34, 0x8f,
+	0x02, 0x69, 0x80, 0x7e, 0x1f, 0xd6, 0xcb, 0x65, 0x51, 0x35, 0x12, 0x0b, 0xa3, 0xd6, 0x57, 0x20,
+	0x50, 0xa4, 0x6a, 0x93, 0x3d, 0xfa, 0x47, 0x1e, 0xac, 0x9e, 0x70, 0xf9, 0x2c, 0x19, 0xda, 0xba,
+	0x61, 0xb9, 0x9a, 0xea, 0x4d, 0x54, 0x53, 0x6f, 0x42, 0x53, 0x26, 0x45, 0xdb, 0x6e, 0xc8, 0xc4,
+	0x74, 0xee, 0x40, 0xd3, 0xe6, 0x63, 0x76, 0x0f, 0x73, 0x84, 0x53, 0x32, 0x5e, 0x70, 0x4b, 0xc6,
+	0xf4, 0x3e, 0xac, 0x65, 0x52, 0x98, 0xed, 0xbd, 0x0d, 0x0b, 0x51, 0x32, 0xb4, 0xee, 0x71, 0xcd,
+	0x75, 0x8f, 0xcf, 0x92, 0x61, 0x80, 0x9d, 0xf4, 0x1f, 0x3d, 0x68, 0x58, 0xd4, 0xff, 0xc7, 0x82,
+	0x6d, 0xa1, 0xd0, 0xe1, 0xd4, 0xc1, 0x69, 0x08, 0xfb, 0xc5, 0x6b, 0x9f, 0x78, 0x74, 0x65, 0xf2,
+	0xd7, 0xb7, 0x3a, 0x3a, 0xfd, 0x71, 0x2a, 0x12, 0x1b, 0x62, 0x0c, 0xa4, 0xc4, 0xd7, 0xc9, 0xb3,
+	0xce, 0x91, 0x34, 0x40, 0xaf, 0xe0, 0x56, 0x3d, 0x2b, 0xa3, 0xec, 0xef, 0x95, 0x02, 0xab, 0x56,
+	0xba, 0x4d, 0x22, 0xcc, 0x68, 0x87, 0x44, 0x29, 0xe6, 0xd6, 0x08, 0x44, 0xff, 0xd0, 0x03, 0x32,
+	0x39, 0xb9, 0xf6, 0xfe, 0x9c, 0xa9, 0x5f, 0xdf, 0x65, 0x34, 0x40, 0x7e, 0xa3, 0x98, 0xdf, 0xcc,
+	0x9b, 0x6b, 0x5c, 0xfd, 0x75, 0xca, 0x1d, 0x4e, 0x3f, 0x85, 0x5d, 0xe5, 0x39, 0x74, 0x0a, 0xe0,
+	0x2a, 0x61, 0x76, 0x82, 0xdb, 0x85, 0xbd, 0xba, 0xa9, 0x6f, 0xa5, 0xb6, 0xc9, 0x99, 0xa5, 0x9b,
+	0xde, 0xcf, 0x81, 0x4c, 0x8e, 0x29, 0xaf, 0xd7, 0x7b, 0xa7, 0xf5, 0x3a, 0x97, 0x1f, 0xb3, 0x15,
+	0x1a, 0xa2, 0x7f, 0xef, 0xc1, 0xc6, 0xcb, 0xcb, 0x17, 0x49, 0x12, 0xa9, 0xe2, 0xa9, 0x70, 0xb3,
+	0x4e, 0xac, 0xbf, 0xeb, 0x92, 0x35, 0xb6, 0xd1, 0x70, 0x6d, 0x8e, 0xa4, 0xb7, 0x22, 0x83, 0xb1,
+	0xd8, 0x8a, 0x35, 0x7a, 0x61, 0xac, 0xcc, 0x82, 0x2a, 0x95, 0xcc, 0x2a, 0x6f, 0xc2, 0xbc, 0x09,
+	0x38, 0x18, 0xf2, 0x21, 0x2c, 0x0b, 0x1e, 0x0f, 0x78, 0x5a, 0xf6, 0x96, 0x46, 0x2c, 0xec, 0x0b,
+	0xec, 0x18, 0xfa, 0x0f, 0x1e, 0xb4, 0xdd, 0x9e, 0x29, 0xe7, 0xc1, 0xf1, 0xd9, 0x6e, 0xb9, 0xd7,
+	0xfa, 0xec, 0xe7, 0x89, 0xa9, 0x05, 0x23, 0x64, 0x0f, 0x07, 0x02, 0xca, 0x97, 0x5d, 0x84, 0x71,
+	0xd7, 0x2d, 0x78, 0x34, 0x2e, 0xc2, 0xf8, 0xb9, 0xad, 0x71, 0x5e, 0xb0, 0x4b, 0xd3, 0xb9, 0x68,
+	0x3a, 0xd9, 0xe5, 0x73, 0x5b, 0x45, 0x1e, 0xb2, 0x91, 0x30, 0xb7, 0x64, 0x6c, 0xd3, 0x8f, 0xc0,
+	0x9f, 0xac, 0x91, 0x89, 0xc9, 0x22, 0xd9, 0x7c, 0x96, 0xe0, 0x7f, 0xed, 0xc1, 0xcd, 0xca, 0x29,
+	0x66, 0x7b, 0xee, 0xc3, 0xb2, 0x4e, 0xc3, 0xac, 0x71, 0xed, 0xd8, 0x10, 0x33, 0x51, 0x5a, 0x1b,
+	0x47, 0x32, 0xb0, 0x83, 0x2b, 0x9f, 0x1c, 0x6a, 0xbc, 0x1e, 0xe5, 0x70, 0xbd, 0x92, 0x1a, 0xb9,
+	0x5f, 0xc8, 0x08, 0x5b, 0xc7, 0x7b, 0xb5, 0xbc, 0xb5, 0x21, 0x9a, 0xd1, 0x4a, 0xd5, 0x3c, 0x4d,
+	0x33, 0x6f, 0xa0, 0x01, 0xfa, 0x72, 0xa2, 0x82, 0x9f, 0x69, 0xe6, 0x53, 0x68, 0xa4, 0xba, 0x69,
+	0x97, 0xb9, 0x6b, 0x58, 0x55, 0x3f, 0x2f, 0x04, 0xd9, 0x70, 0xfa, 0xbb, 0xd0, 0x99, 0xa4, 0x6a,
+	0x94, 0xf7, 0x49, 0x59, 0x79, 0x99, 0x43, 0x2b, 0x90, 0xfc, 0xd5, 0x35, 0xd7, 0x03, 0x32, 0x49,
+	0xaa, 0x56, 0x6d, 0x35, 0xef, 0x17, 0x33, 0xd4, 0xf6, 0x5d, 0x74, 0x60, 0x05, 0x75, 0xf7, 0x79,
+	0x38, 0x92, 0xc2, 0xad, 0x46, 0x32, 0x71, 0x9e, 0xbd, 0xcf, 0x18, 0x88, 0xfe, 0x89, 0x07, 0x7b,
+	0x75, 0x33, 0x8d, 0x82, 0x3e, 0x2d, 0x2b, 0x68, 0xbf, 0xca, 0xcd, 0xe0, 0xa4, 0xff, 0x8d, 0x9a,
+	0x06, 0xd0, 0xa9, 0x23, 0x48, 0x8e, 0x4b, 0xca, 0x9a, 0xe6, 0xe8, 0xa6, 0x2b, 0xea, 0x6f, 0xe7,
+	0x90, 0x4d, 0x9f, 0xbf, 0x6d, 0xf1, 0xac, 0x18, 0xd7, 0xe7, 0xca, 0x71, 0xfd, 0x00, 0xda, 0xa6,
+	0xdb, 0x5d, 0x53, 0xab, 0x97, 0x3f, 0xdc, 0x38, 0xce, 0x76, 0xa1, 0xb6, 0xd2, 0xb4, 0x58, 0xa8,
+	0x34, 0x39, 0xb7, 0xac, 0xa5, 0x69, 0x0f, 0x4b, 0xcb, 0x13, 0x0f, 0x4b, 0xb7, 0x61, 0x45, 0x43,
+	0x61, 0x12, 0xe3, 0xcb, 0x52, 0x43, 0x3b, 0xb9, 0x0c, 0x79, 0xc2, 0x04, 0xb9, 0x03, 0x8b, 0x42,
+	0xf2, 0x91, 0xe8, 0x34, 0x71, 0x3b, 0xd7, 0x73, 0x65, 0xf6, 0xf9, 0xa9, 0xe4, 0xa3, 0x40, 0x77,
+	0xd3, 0xdf, 0x83, 0x66, 0x86, 0xcb, 0x8a, 0x77, 0x9e, 0x53, 0xbc, 0x33, 0x59, 0xe9, 0x5c, 0x45,
+	0x56, 0x5a, 0x78, 0x1c, 0x32, 0x57, 0xdd, 0x85, 0xec, 0xaa, 0xab, 0xee, 0xe8, 0x61, 0x2c, 0x64,
+	0x3a, 0x36, 0x31, 0x51, 0xfb, 0xcd, 0x02, 0x8e, 0x5e, 0x80, 0xaf, 0xaf, 0x94, 0x98, 0x0c, 0x99,
+	0xd7, 0x4b, 0xf1, 0x8d, 0xbd, 0x1d, 0xfe, 0x18, 0x6e, 0x56, 0xb2, 0x33, 0xb6, 0x71, 0x0c, 0x0d,
+	0xf3, 0x04, 0x6a, 0x8f, 0x41, 0xf9, 0x41, 0xca, 0x4c, 0x09, 0xb2, 0x71, 0xf4, 0x4b, 0x58, 0x2b,
+	0x75, 0xba, 0x0f, 0xab, 0x3a, 0xad, 0xb1, 0x60, 0xa1, 0x9a, 0x65, 0x8d, 0xaf, 0xaa, 0x3e, 0x5b,
+	0xa8, 0xb1, 0x2e, 0x94, 0x6a, 0xac, 0xf4, 0x1c, 0xef, 0xb4, 0x96, 0xeb, 0xc3, 0x47, 0x4f, 0xbf,
+	0x31, 0x7d, 0xfd, 0x08, 0xb6, 0xca, 0x9c, 0x8c, 0xaa, 0xbe, 0x03, 0x4d, 0xfb, 0xe0, 0x64, 0x75,
+	0xb5, 0x5d, 0xd2, 0xd5, 0x13, 0xd3, 0x1f, 0xe4, 0x23, 0xe9, 0x97, 0xb0, 0x5e, 0xee, 0x56, 0x0a,
+	0x88, 0xd9, 0x45, 0x66, 0x75, 0xaa, 0x9d, 0xbd, 0x61, 0xe9, 0x27, 0x77, 0x6c, 0xab, 0x20, 0x9c,
+	0x72, 0x36, 0xe8, 0x26, 0x71, 0x74, 0x85, 0xa2, 0x36, 0x94, 0xf7, 0x67, 0x83, 0x1f, 0xc5, 0x11,
+	0x66, 0x23, 0x23, 0x76, 0x85, 0x2f, 0x56, 0x0b, 0xd8, 0x65, 0xc1, 0xe3, 0xbf, 0xd9, 0x02, 0x78,
+	0x38, 0x0a, 0x4f, 0x79, 0xfa, 0x5a, 0x55, 0xa5, 0xbe, 0x80, 0x96, 0xf3, 0x68, 0x4f, 0xac, 0xd0,
+	0xe5, 0x8f, 0x26, 0x7c, 0x3f, 0xf7, 0xd5, 0xe5, 0x17, 0x7e, 0x7a, 0xe3, 0x17, 0xff, 0xfa, 0x9f,
+	0x7f, 0x39, 0xb7, 0x41, 0xae, 0x1d, 0xbd, 0xfe, 0xf8, 0x68, 0x2c, 0x78, 0xaa, 0xbe, 0x96, 0xc1,
+	0x9b, 0x23, 0xf9, 0x1d, 0xd8, 0x7e, 0xc6, 0x24, 0x17, 0xf2, 0x69, 0x9a, 0x72, 0xdc, 0xf6, 0x5e,
+	0xc4, 0xf1, 0x8d, 0xa6, 0x9e, 0xd5, 0xa6, 0xe9, 0x28, 0x3c, 0xe5, 0xd0, 0x4d, 0x64, 0xb2, 0x4a,
+	0xda, 0x19, 0x13, 0xf5, 0x6d, 0x40, 0x0a, 0x6b, 0xa5, 0xe8, 0x41, 0xa6, 0x47, 0x48, 0x7f, 0x46,
+	0xd0, 0xa1, 0xb7, 0x90, 0x8f, 0x4f, 0xaf, 0x67, 0x7c, 0x4c, 0xb6, 0x84, 0x0b, 0x7a, 0xe0, 0xdd,
+	0x23, 0x2f, 0x60, 0x41, 0x3d, 0x89, 0x93, 0xfa, 0x52, 0xa2, 0x6f, 0x33, 0x38, 0xf7, 0xe9, 0x9c,
+	0x76, 0x90, 0x32, 0xa1, 0x2b, 0x19, 0xe5, 0x3e, 0x8b, 0x22, 0x45, 0xf1, 0x2b, 0x20, 0x93, 0xb9,
+	0x0e, 0xb9, 0xe5, 0x64, 0x15, 0x95, 0xaf, 0x8b, 0xfe, 0x8c, 0xbc, 0x83, 0x52, 0xe4, 0xb8, 0x43,
+	0xb7, 0x33, 0x8e, 0x29, 0x7b, 0xe3, 0x24, 0xc5, 0x8a, 0xf7, 0x39, 0xde, 0x73, 0x9d, 0xf7, 0x42,
+	0xb2, 0x93, 0x6b, 0x68, 0xf2, 0x19, 0xb1, 0x66, 0x77, 0x26, 0x39, 0x0d, 0x0b, 0xb3, 0x15, 0xa7,
+	0x18, 0x6b, 0x15, 0x85, 0x87, 0x43, 0xb2, 0x37, 0xc9, 0xcb, 0x7d, 0x51, 0xac, 0xe1, 0xf6, 0x1e,
+	0x72, 0xdb, 0xa3, 0x37, 0xaa, 0xb8, 0xe1, 0x7c, 0xc5, 0xef, 0x17, 0x1e, 0x3a, 0x86, 0xc9, 0x08,
+	0x4b, 0x68, 0xce, 0xb5, 0xee, 0x81, 0xd1, 0x9f, 0x12, 0x6f, 0xe9, 0xb7, 0x90, 0xff, 0x6d, 0xba,
+	0xe7, 0xf2, 0x9f, 0xe4, 0xa3, 0x84, 0xe8, 0x42, 0x33, 0xfb, 0x82, 0x2a, 0x33, 0xf9, 0xf2, 0x17,
+	0x63, 0x7e, 0x67, 0xb2, 0xc3, 0xb0, 0xda, 0x45, 0x56, 0xdb, 0x94, 0x64, 0xac, 0x84, 0x1d, 0xf3,
+	0xc0, 0xbb, 0xf7, 0x91, 0x67, 0x0e, 0xb0, 0x2d, 0x45, 0xd7, 0x9f, 0x2a, 0xdb, 0x51, 0x2e, 0x5a,
+	0xd3, 0x1d, 0xe4, 0xb0, 0x45, 0x36, 0xdd, 0xc5, 0x64, 0xf4, 0xbe, 0x80, 0xd6, 0x67, 0xf9, 0x27,
+	0x1a, 0xd3, 0x6c, 0x9e, 0xe4, 0x0c, 0x32, 0xda, 0xfb, 0x48, 0xfb, 0x06, 0xcd, 0x69, 0x3b, 0xdf,
+	0x7b, 0x28, 0xf5, 0x30, 0x3c, 0xbf, 0xba, 0x04, 0x6c, 0xcc, 0xcf, 0xd2, 0x71, 0x37, 0xe3, 0xba,
+	0x5b, 0xe5, 0xc8, 0xc9, 0xdf, 0x46, 0xf2, 0xbb, 0xb4, 0xe3, 0x8a, 0xee, 0x12, 0xd3, 0x2c, 0x20,
+	0xff, 0x4a, 0x84, 0xdc, 0xb4, 0x06, 0x55, 0xf1, 0xa1, 0x89, 0x7f, 0x23, 0xb7, 0x8b, 0xd2, 0x57,
+	0x25, 0xf4, 0x26, 0xb2, 0xba, 0x4e, 0xd7, 0x33, 0x56, 0x03, 0x3d, 0x42, 0xb1, 0xf8, 0x12, 0xcf,
+	0x90, 0x5b, 0x6d, 0xdc, 0x29, 0xb8, 0xcb, 0x52, 0xb1, 0xd5, 0xdf, 0xad, 0xe9, 0x9d, 0x76, 0x98,
+	0x9c, 0x81, 0x8a, 0xe5, 0x6f, 0x42, 0xc3, 0x16, 0xfe, 0xc8, 0x56, 0x4e, 0xce, 0xad, 0x31, 0xfa,
+	0xdb, 0x13, 0xf8, 0xe2, 0x96, 0xd3, 0x6b, 0x2e, 0x03, 0x1c, 0xa2, 0x48, 0x7f, 0x0e, 0xcb, 0xa6,
+	0xe6, 0x44, 0xae, 0xe7, 0x14, 0x9c, 0x4a, 0x98, 0xbf, 0x55, 0x46, 0xd7, 0x2a, 0x69, 0xa8, 0x47,
+	0x28, 0xb2, 0x7f, 0xed, 0xe1, 0x8d, 0xa4, 0xb2, 0xde, 0x42, 0xee, 0x54, 0x9e, 0xc8, 0x89, 0xda,
+	0x8f, 0xff, 0xc1, 0xcc, 0x71, 0x46, 0x94, 0x6f, 0xa3, 0x28, 0x77, 0xe8, 0x41, 0xcd, 0x11, 0xcd,
+	0xa7, 0x28, 0xd9, 0xfe, 0x42, 0xd7, 0xb2, 0x2b, 0x4a, 0x1a, 0xe4, 0x3d, 0x47, 0x89, 0xb5, 0xc5,
+	0x12, 0xff, 0xfd, 0x19, 0xa3, 0x8c, 0x54, 0xf7, 0x50, 0xaa, 0xf7, 0xe8, 0x7e, 0x41, 0xf1, 0x93,
+	0x13, 0x94, 0x4c, 0x5f, 0x6b, 0xf7, 0x35, 0xd9, 0xfb, 0x56, 0xee, 0xab, 0xbe, 0xd6, 0x52, 0xed,
+	0xbd, 0x26, 0xc7, 0x29, 0x19, 0xfa, 0x68, 0xd8, 0x4e, 0x79, 0x64, 0x76, 0x82, 0x50, 0x51, 0x4b,
+	0xa9, 0x70, 0x31, 0xd2, 0x21, 0xf9, 0xb5, 0x07, 0x1b, 0x15, 0x57, 0x7d, 0x72, 0x50, 0x1b, 0xff,
+	0x32, 0xa6, 0x74, 0xda, 0x90, 0x5a, 0x27, 0x51, 0x0c, 0x82, 0xa8, 0xec, 0xd7, 0xb0, 0x5e, 0x4a,
+	0x08, 0x04, 0xa9, 0xc9, 0x14, 0x32, 0xe6, 0xfb, 0xb5, 0xfd, 0x86, 0xf3, 0x01, 0x72, 0xbe, 0x49,
+	0xb7, 0x2a, 0x53, 0x09, 0xd7, 0xf0, 0x2a, 0xee, 0xa2, 0xae, 0xe1, 0xd5, 0x5f, 0x72, 0xfd, 0xf7,
+	0x67, 0x8c, 0x9a, 0x66, 0x78, 0x15, 0x13, 0x94, 0x4c, 0x7f, 0xac, 0x3f, 0xe4, 0x2c, 0x5f, 0x0b,
+	0xb2, 0xfd, 0xa8, 0xbf, 0xa1, 0xf8, 0x74, 0xda, 0x10, 0x23, 0xca, 0x07, 0x28, 0xca, 0x01, 0xdd,
+	0x71, 0x45, 0x29, 0x8f, 0xce, 0xbd, 0xaa, 0x93, 0x6d, 0xbb, 0x5e, 0x75, 0x32, 0xdd, 0xf7, 0x77,
+	0x6b, 0x7a, 0xa7, 0x79, 0x55, 0x67, 0xe0, 0x03, 0xef, 0xde, 0xf1, 0xdf, 0x01, 0xb4, 0x1f, 0x0e,
+	0x2e, 0xc2, 0xd8, 0xa6, 0xc7, 0x3f, 0x83, 0x86, 0xd9, 0x5b, 0x31, 0x3b, 0xb4, 0x96, 0x3f, 0x12,
+	0xa5, 0x3e, 0xb2, 0xdc, 0x24, 0x18, 0xbc, 0x99, 0xa2, 0x9b, 0x59, 0x00, 0xe9, 0x03, 0xe4, 0x1f,
+	0x49, 0x10, 0x9b, 0x00, 0x4c, 0x7c, 0x6c, 0xe1, 0xdf, 0xa8, 0xe8, 0xa9, 0x4a, 0x55, 0x0b, 0xe4,
+	0x8f, 0x62, 0xfe, 0x46, 0xa9, 0x30, 0x81, 0x95, 0xc2, 0xb7, 0x0e, 0x59, 0xf8, 0xab, 0xfa, 0xde,
+	0xc2, 0xdf, 0xa9, 0xee, 0xac, 0x3a, 0x47, 0x45, 0x6e, 0x63, 0x9c, 0xa0, 0x18, 0x0e, 0xa1, 0xe5,
+	0x7c, 0xfb, 0x90, 0xa5, 0x0b, 0x93, 0xdf, 0x4f, 0xf8, 0x7e, 0x55, 0x57, 0xd5, 0xc1, 0x29, 0xb2,
+	0xb2, 0x8c, 0x62, 0x58, 0x2b, 0x65, 0xbd, 0xd3, 0x72, 0x93, 0x59, 0x89, 0x72, 0x85, 0x26, 0x4b,
+	0x69, 0xf2, 0x6f, 0x41, 0xc3, 0x7e, 0x52, 0x91, 0xc5, 0xdb, 0xd2, 0x67, 0x1b, 0xfe, 0xf6, 0x04,
+	0xde, 0x90, 0xdf, 0x43, 0xf2, 0x1d, 0xba, 0x91, 0x93, 0x17, 0xe1, 0x30, 0x3e, 0x3a, 0x37, 0x29,
+	0xca, 0x9f, 0x7b, 0xb0, 0x5b, 0xfa, 0x0e, 0xe2, 0xa7, 0xa1, 0x3c, 0xcf, 0x3f, 0x69, 0x20, 0x1f,
+	0x38, 0xa4, 0xa7, 0x7d, 0xf4, 0xe0, 0xdf, 0x9d, 0x3d, 0xb0, 0x78, 0x6b, 0xa3, 0xab, 0x45, 0xa1,
+	0x94, 0x3c, 0x7f, 0xa5, 0xe4, 0x29, 0xaa, 0xaa, 0x4e, 0x9e, 0x19, 0x1f, 0x61, 0xcc, 0xd4, 0xfc,
+	0x21, 0x4a, 0x71, 0x97, 0xde, 0xae, 0xd4, 0x7c, 0x91, 0xab, 0x12, 0xed, 0x14, 0xe0, 0x54, 0xb2,
+	0x54, 0xe2, 0x13, 0x3d, 0xb1, 0xf7, 0x2c, 0xf7, 0x61, 0xdf, 0xdf, 0x2c, 0x22, 0x8b, 0x67, 0x91,
+	0xae, 0xe5, 0x8c, 0x46, 0x6a, 0x80, 0xde, 0xdc, 0x66, 0xf6, 0x92, 0x5f, 0x7f, 0xcc, 0x3b, 0x05,
+	0xff, 0xe2, 0x3c, 0xfa, 0xdb, 0xbc, 0x87, 0x38, 0xfb, 0x3b, 0xcc, 0xe8, 0xfd, 0x0c, 0x1a, 0xf6,
+	0x6f, 0x83, 0xd9, 0x2e, 0xa4, 0xfc, 0x5f, 0x42, 0x95, 0x0b, 0x89, 0x93, 0x01, 0x0f, 0x15, 0xb5,
+	0xdf, 0x87, 0xf5, 0x72, 0x5d, 0xef, 0xad, 0x72, 0x83, 0x7d, 0xb7, 0xfa, 0x55, 0xb5, 0x29, 0xef,
+	0x23, 0xd3, 0x7d, 0xea, 0x17, 0x36, 0xa5, 0x30, 0xf6, 0x81, 0x77, 0xaf, 0xb7, 0x84, 0x9f, 0xd9,
+	0x7f, 0xf2, 0x3f, 0x03, 0x00, 0x22, 0x31, 0xec, 0xe8, 0xa7, 0x33, 0x00, 0x00,
 }
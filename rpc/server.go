@@ -19,7 +19,10 @@ import (
 
 // Errors
 var (
-	ErrEmptyRPCListenList = errors.New("empty rpc listen list")
+	ErrEmptyRPCListenList   = errors.New("empty rpc listen list")
+	ErrSubscriptionOverflow = errors.New("events are dropped, resume with the cursor of last event")
+	ErrAddressIndexDisabled = errors.New("address index is disabled, enable it in chain config")
+	ErrBatchOutOfLimit      = errors.New("too many items in batch request")
 )
 
 // Const
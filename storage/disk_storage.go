@@ -26,6 +26,7 @@ import (
 	"github.com/syndtr/goleveldb/leveldb"
 	"github.com/syndtr/goleveldb/leveldb/filter"
 	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/util"
 )
 
 // DiskStorage the nodes in trie.
@@ -160,3 +161,47 @@ func (storage *DiskStorage) DisableBatch() {
 	storage.batchOpts = make(map[string]*batchOpt)
 	storage.enableBatch = false
 }
+
+// Iterator return an iterator over the entries whose keys have the prefix
+func (storage *DiskStorage) Iterator(prefix []byte) Iterator {
+	return storage.db.NewIterator(util.BytesPrefix(prefix), nil)
+}
+
+// RangeIterator return an iterator over the entries in [start, limit)
+func (storage *DiskStorage) RangeIterator(start []byte, limit []byte) Iterator {
+	return storage.db.NewIterator(&util.Range{Start: start, Limit: limit}, nil)
+}
+
+// Snapshot return a snapshot of levelDB
+func (storage *DiskStorage) Snapshot() (Snapshot, error) {
+	snap, err := storage.db.GetSnapshot()
+	if err != nil {
+		return nil, err
+	}
+	return &diskSnapshot{snap}, nil
+}
+
+// diskSnapshot wraps levelDB snapshot
+type diskSnapshot struct {
+	snap *leveldb.Snapshot
+}
+
+func (s *diskSnapshot) Get(key []byte) ([]byte, error) {
+	value, err := s.snap.Get(key, nil)
+	if err != nil && err == leveldb.ErrNotFound {
+		return nil, ErrKeyNotFound
+	}
+	return value, err
+}
+
+func (s *diskSnapshot) Iterator(prefix []byte) Iterator {
+	return s.snap.NewIterator(util.BytesPrefix(prefix), nil)
+}
+
+func (s *diskSnapshot) RangeIterator(start []byte, limit []byte) Iterator {
+	return s.snap.NewIterator(&util.Range{Start: start, Limit: limit}, nil)
+}
+
+func (s *diskSnapshot) Release() {
+	s.snap.Release()
+}
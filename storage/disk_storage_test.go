@@ -48,6 +48,16 @@ func TestNewDiskStorage(t *testing.T) {
 	assert.NotNil(t, err2)
 }
 
+func TestDiskStorage_Iterator(t *testing.T) {
+	path := "iterator.db"
+	stor, err := NewDiskStorage(path)
+	assert.Nil(t, err)
+	defer os.RemoveAll(path)
+	defer stor.Close()
+
+	checkIterableStorage(t, stor)
+}
+
 const letterBytes = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
 
 func randBytes(n int) []byte {
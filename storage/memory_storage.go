@@ -19,6 +19,8 @@
 package storage
 
 import (
+	"bytes"
+	"sort"
 	"sync"
 
 	"github.com/nebulasio/go-nebulas/util/byteutils"
@@ -27,6 +29,9 @@ import (
 // MemoryStorage the nodes in trie.
 type MemoryStorage struct {
 	data *sync.Map
+
+	// writers share the read lock, snapshots take the write lock to exclude them
+	mu sync.RWMutex
 }
 
 // kv entry
@@ -55,12 +60,18 @@ func (db *MemoryStorage) Get(key []byte) ([]byte, error) {
 
 // Put put the key-value entry to Storage
 func (db *MemoryStorage) Put(key []byte, value []byte) error {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
 	db.data.Store(byteutils.Hex(key), value)
 	return nil
 }
 
 // Del delete the key in Storage.
 func (db *MemoryStorage) Del(key []byte) error {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
 	db.data.Delete(byteutils.Hex(key))
 	return nil
 }
@@ -77,3 +88,109 @@ func (db *MemoryStorage) Flush() error {
 // DisableBatch disable batch write.
 func (db *MemoryStorage) DisableBatch() {
 }
+
+// Iterator return an iterator over the entries whose keys have the prefix
+func (db *MemoryStorage) Iterator(prefix []byte) Iterator {
+	return db.iterator(func(key []byte) bool {
+		return bytes.HasPrefix(key, prefix)
+	})
+}
+
+// RangeIterator return an iterator over the entries in [start, limit)
+func (db *MemoryStorage) RangeIterator(start []byte, limit []byte) Iterator {
+	return db.iterator(func(key []byte) bool {
+		return (start == nil || bytes.Compare(key, start) >= 0) &&
+			(limit == nil || bytes.Compare(key, limit) < 0)
+	})
+}
+
+func (db *MemoryStorage) iterator(match func(key []byte) bool) Iterator {
+	entries := []*kv{}
+	var err error
+	db.data.Range(func(k, v interface{}) bool {
+		var key []byte
+		key, err = byteutils.FromHex(k.(string))
+		if err != nil {
+			return false
+		}
+		if match(key) {
+			entries = append(entries, &kv{key, v.([]byte)})
+		}
+		return true
+	})
+	sort.Slice(entries, func(i, j int) bool {
+		return bytes.Compare(entries[i].k, entries[j].k) < 0
+	})
+	return &memoryIterator{entries: entries, index: -1, err: err}
+}
+
+// Snapshot return a copy of current entries
+func (db *MemoryStorage) Snapshot() (Snapshot, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	data := new(sync.Map)
+	db.data.Range(func(k, v interface{}) bool {
+		data.Store(k, v)
+		return true
+	})
+	return &memorySnapshot{&MemoryStorage{data: data}}, nil
+}
+
+// memoryIterator iterates the sorted entries collected from memory storage
+type memoryIterator struct {
+	entries []*kv
+	index   int
+	err     error
+}
+
+func (it *memoryIterator) Next() bool {
+	if it.err != nil || it.index+1 >= len(it.entries) {
+		return false
+	}
+	it.index++
+	return true
+}
+
+func (it *memoryIterator) Key() []byte {
+	if it.index < 0 || it.index >= len(it.entries) {
+		return nil
+	}
+	return it.entries[it.index].k
+}
+
+func (it *memoryIterator) Value() []byte {
+	if it.index < 0 || it.index >= len(it.entries) {
+		return nil
+	}
+	return it.entries[it.index].v
+}
+
+func (it *memoryIterator) Error() error {
+	return it.err
+}
+
+func (it *memoryIterator) Release() {
+	it.entries = nil
+}
+
+// memorySnapshot is a read-only copy of memory storage
+type memorySnapshot struct {
+	db *MemoryStorage
+}
+
+func (snap *memorySnapshot) Get(key []byte) ([]byte, error) {
+	return snap.db.Get(key)
+}
+
+func (snap *memorySnapshot) Iterator(prefix []byte) Iterator {
+	return snap.db.Iterator(prefix)
+}
+
+func (snap *memorySnapshot) RangeIterator(start []byte, limit []byte) Iterator {
+	return snap.db.RangeIterator(start, limit)
+}
+
+func (snap *memorySnapshot) Release() {
+	snap.db = nil
+}
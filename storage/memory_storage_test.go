@@ -0,0 +1,83 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type iterableStorage interface {
+	Storage
+	Iterable
+	Snapshotter
+}
+
+func collect(t *testing.T, iter Iterator) []string {
+	keys := []string{}
+	for iter.Next() {
+		keys = append(keys, string(iter.Key()))
+	}
+	assert.Nil(t, iter.Error())
+	iter.Release()
+	return keys
+}
+
+func checkIterableStorage(t *testing.T, stor iterableStorage) {
+	for _, key := range []string{"b2", "a1", "b1", "c1", "b3"} {
+		assert.Nil(t, stor.Put([]byte(key), []byte("v"+key)))
+	}
+
+	assert.Equal(t, collect(t, stor.Iterator([]byte("b"))), []string{"b1", "b2", "b3"})
+	assert.Equal(t, collect(t, stor.Iterator(nil)), []string{"a1", "b1", "b2", "b3", "c1"})
+	assert.Equal(t, collect(t, stor.Iterator([]byte("d"))), []string{})
+	assert.Equal(t, collect(t, stor.RangeIterator([]byte("a2"), []byte("b3"))), []string{"b1", "b2"})
+	assert.Equal(t, collect(t, stor.RangeIterator([]byte("b2"), nil)), []string{"b2", "b3", "c1"})
+	assert.Equal(t, collect(t, stor.RangeIterator(nil, []byte("b1"))), []string{"a1"})
+
+	iter := stor.Iterator([]byte("c"))
+	assert.True(t, iter.Next())
+	assert.Equal(t, iter.Value(), []byte("vc1"))
+	assert.False(t, iter.Next())
+	iter.Release()
+
+	// later writes are not visible in the snapshot
+	snap, err := stor.Snapshot()
+	assert.Nil(t, err)
+	assert.Nil(t, stor.Put([]byte("b4"), []byte("vb4")))
+	assert.Nil(t, stor.Del([]byte("b1")))
+
+	value, err := snap.Get([]byte("b1"))
+	assert.Nil(t, err)
+	assert.Equal(t, value, []byte("vb1"))
+	_, err = snap.Get([]byte("b4"))
+	assert.Equal(t, err, ErrKeyNotFound)
+	assert.Equal(t, collect(t, snap.Iterator([]byte("b"))), []string{"b1", "b2", "b3"})
+	assert.Equal(t, collect(t, snap.RangeIterator([]byte("b3"), nil)), []string{"b3", "c1"})
+	snap.Release()
+
+	assert.Equal(t, collect(t, stor.Iterator([]byte("b"))), []string{"b2", "b3", "b4"})
+}
+
+func TestMemoryStorage_Iterator(t *testing.T) {
+	stor, err := NewMemoryStorage()
+	assert.Nil(t, err)
+	checkIterableStorage(t, stor)
+}
@@ -1,6 +1,7 @@
 package storage
 
 import (
+	"bytes"
 	"sync"
 
 	"github.com/nebulasio/go-nebulas/util/byteutils"
@@ -140,3 +141,114 @@ func (storage *RocksStorage) DisableBatch() {
 
 	storage.enableBatch = false
 }
+
+// Iterator return an iterator over the entries whose keys have the prefix
+func (storage *RocksStorage) Iterator(prefix []byte) Iterator {
+	return newRocksIterator(storage.db.NewIterator(storage.ro), prefix, prefix, nil)
+}
+
+// RangeIterator return an iterator over the entries in [start, limit)
+func (storage *RocksStorage) RangeIterator(start []byte, limit []byte) Iterator {
+	return newRocksIterator(storage.db.NewIterator(storage.ro), nil, start, limit)
+}
+
+// Snapshot return a snapshot of rocksDB
+func (storage *RocksStorage) Snapshot() (Snapshot, error) {
+	snap := storage.db.NewSnapshot()
+	ro := gorocksdb.NewDefaultReadOptions()
+	ro.SetSnapshot(snap)
+	return &rocksSnapshot{db: storage.db, snap: snap, ro: ro}, nil
+}
+
+// rocksIterator adapts rocksDB iterator to Iterator
+type rocksIterator struct {
+	iter    *gorocksdb.Iterator
+	prefix  []byte
+	limit   []byte
+	started bool
+
+	key   []byte
+	value []byte
+}
+
+func newRocksIterator(iter *gorocksdb.Iterator, prefix []byte, start []byte, limit []byte) *rocksIterator {
+	if len(start) > 0 {
+		iter.Seek(start)
+	} else {
+		iter.SeekToFirst()
+	}
+	return &rocksIterator{iter: iter, prefix: prefix, limit: limit}
+}
+
+func (it *rocksIterator) Next() bool {
+	if it.started {
+		it.iter.Next()
+	}
+	it.started = true
+	it.key, it.value = nil, nil
+
+	if !it.iter.Valid() {
+		return false
+	}
+	key := it.iter.Key()
+	defer key.Free()
+	if it.prefix != nil && !bytes.HasPrefix(key.Data(), it.prefix) {
+		return false
+	}
+	if it.limit != nil && bytes.Compare(key.Data(), it.limit) >= 0 {
+		return false
+	}
+	value := it.iter.Value()
+	defer value.Free()
+
+	it.key = append([]byte{}, key.Data()...)
+	it.value = append([]byte{}, value.Data()...)
+	return true
+}
+
+func (it *rocksIterator) Key() []byte {
+	return it.key
+}
+
+func (it *rocksIterator) Value() []byte {
+	return it.value
+}
+
+func (it *rocksIterator) Error() error {
+	return it.iter.Err()
+}
+
+func (it *rocksIterator) Release() {
+	it.iter.Close()
+}
+
+// rocksSnapshot reads rocksDB with a snapshot
+type rocksSnapshot struct {
+	db   *gorocksdb.DB
+	snap *gorocksdb.Snapshot
+	ro   *gorocksdb.ReadOptions
+}
+
+func (s *rocksSnapshot) Get(key []byte) ([]byte, error) {
+	value, err := s.db.GetBytes(s.ro, key)
+	if err != nil {
+		return nil, err
+	}
+	if value == nil {
+		return nil, ErrKeyNotFound
+	}
+	return value, nil
+}
+
+func (s *rocksSnapshot) Iterator(prefix []byte) Iterator {
+	return newRocksIterator(s.db.NewIterator(s.ro), prefix, prefix, nil)
+}
+
+func (s *rocksSnapshot) RangeIterator(start []byte, limit []byte) Iterator {
+	return newRocksIterator(s.db.NewIterator(s.ro), nil, start, limit)
+}
+
+func (s *rocksSnapshot) Release() {
+	s.db.ReleaseSnapshot(s.snap)
+	s.ro.Destroy()
+}
@@ -1,6 +1,7 @@
 package storage
 
 import (
+	"os"
 	"reflect"
 	"testing"
 
@@ -43,3 +44,13 @@ func TestNewRocksStorage(t *testing.T) {
 	val, err := s.Get(key)
 	assert.Equal(t, val, value)
 }
+
+func TestRocksStorage_Iterator(t *testing.T) {
+	path := "iterator.rocks.db"
+	stor, err := NewRocksStorage(path)
+	assert.Nil(t, err)
+	defer os.RemoveAll(path)
+	defer stor.Close()
+
+	checkIterableStorage(t, stor)
+}
@@ -45,3 +45,54 @@ type Storage interface {
 	// Flush write and flush pending batch write.
 	Flush() error
 }
+
+// Reader is implemented by Storage and Snapshot for reading.
+type Reader interface {
+	// Get return the value to the key.
+	Get(key []byte) ([]byte, error)
+}
+
+// Iterator iterates the key-value entries in ascending order of keys.
+// The returned key and value should not be modified, and may change on the next call to Next.
+type Iterator interface {
+	// Next move to the next entry, return false if there is no more entry.
+	Next() bool
+
+	// Key return the key of current entry.
+	Key() []byte
+
+	// Value return the value of current entry.
+	Value() []byte
+
+	// Error return the error met during iteration.
+	Error() error
+
+	// Release release the resources held by the iterator.
+	Release()
+}
+
+// Iterable is implemented by Storage supporting iteration.
+// Pending batch writes are not visible to iterators until flushed.
+type Iterable interface {
+	// Iterator return an iterator over the entries whose keys have the prefix.
+	Iterator(prefix []byte) Iterator
+
+	// RangeIterator return an iterator over the entries in [start, limit),
+	// nil start or limit means unbounded.
+	RangeIterator(start []byte, limit []byte) Iterator
+}
+
+// Snapshot is a read-consistent view of Storage, not affected by later writes.
+type Snapshot interface {
+	Iterable
+	Reader
+
+	// Release release the snapshot.
+	Release()
+}
+
+// Snapshotter is implemented by Storage supporting snapshots.
+type Snapshotter interface {
+	// Snapshot return a snapshot of current Storage.
+	Snapshot() (Snapshot, error)
+}
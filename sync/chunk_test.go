@@ -126,6 +126,14 @@ func (n *Neb) Nvm() core.NVM {
 	return n.nvm
 }
 
+func (n *Neb) Nr() core.NR {
+	return nil
+}
+
+func (n *Neb) Dip() core.Dip {
+	return nil
+}
+
 func (n *Neb) StartPprof(string) error {
 	return nil
 }
@@ -220,7 +228,7 @@ func TestChunk_generateChunkMeta(t *testing.T) {
 	source := `"use strict";var DepositeContent=function(text){if(text){var o=JSON.parse(text);this.balance=new BigNumber(o.balance);this.expiryHeight=new BigNumber(o.expiryHeight)}else{this.balance=new BigNumber(0);this.expiryHeight=new BigNumber(0)}};DepositeContent.prototype={toString:function(){return JSON.stringify(this)}};var BankVaultContract=function(){LocalContractStorage.defineMapProperty(this,"bankVault",{parse:function(text){return new DepositeContent(text)},stringify:function(o){return o.toString()}})};BankVaultContract.prototype={init:function(){},save:function(height){var from=Blockchain.transaction.from;var value=Blockchain.transaction.value;var bk_height=new BigNumber(Blockchain.block.height);var orig_deposit=this.bankVault.get(from);if(orig_deposit){value=value.plus(orig_deposit.balance)}var deposit=new DepositeContent();deposit.balance=value;deposit.expiryHeight=bk_height.plus(height);this.bankVault.put(from,deposit)},takeout:function(value){var from=Blockchain.transaction.from;var bk_height=new BigNumber(Blockchain.block.height);var amount=new BigNumber(value);var deposit=this.bankVault.get(from);if(!deposit){throw new Error("No deposit before.")}if(bk_height.lt(deposit.expiryHeight)){throw new Error("Can not takeout before expiryHeight.")}if(amount.gt(deposit.balance)){throw new Error("Insufficient balance.")}var result=Blockchain.transfer(from,amount);if(result!=0){throw new Error("transfer failed.")}Event.Trigger("BankVault",{Transfer:{from:Blockchain.transaction.to,to:from,value:amount.toString()}});deposit.balance=deposit.balance.sub(amount);this.bankVault.put(from,deposit)},balanceOf:function(){var from=Blockchain.transaction.from;return this.bankVault.get(from)}};module.exports=BankVaultContract;`
 	sourceType := "js"
 	argsDeploy := ""
-	payload, _ := core.NewDeployPayload(source, sourceType, argsDeploy)
+	payload, _ := core.NewDeployPayload(source, sourceType, argsDeploy, "", false)
 	payloadDeploy, _ := payload.ToBytes()
 
 	from, _ := core.AddressParse("n1FkntVUMPAsESuCAAPK711omQk19JotBjM")
@@ -228,7 +236,7 @@ func TestChunk_generateChunkMeta(t *testing.T) {
 
 	blocks := []*core.Block{}
 	for i := 0; i < 96; i++ {
-		context, err := chain.TailBlock().WorldState().NextConsensusState(dpos.BlockIntervalInMs / dpos.SecondInMs)
+		context, err := chain.TailBlock().WorldState().NextConsensusState(core.DefaultBlockIntervalInMs / dpos.SecondInMs)
 		assert.Nil(t, err)
 		coinbase, err := core.AddressParseFromBytes(context.Proposer())
 		assert.Nil(t, err)
@@ -236,7 +244,7 @@ func TestChunk_generateChunkMeta(t *testing.T) {
 		block, err := chain.NewBlock(coinbase)
 		assert.Nil(t, err)
 		block.WorldState().SetConsensusState(context)
-		block.SetTimestamp(chain.TailBlock().Timestamp() + dpos.BlockIntervalInMs/dpos.SecondInMs)
+		block.SetTimestamp(chain.TailBlock().Timestamp() + core.DefaultBlockIntervalInMs/dpos.SecondInMs)
 		value, _ := util.NewUint128FromInt(1)
 		gasLimit, _ := util.NewUint128FromInt(200000)
 		txDeploy, _ := core.NewTransaction(neb.chain.ChainID(), from, from, value, uint64(i+1), core.TxPayloadDeployType, payloadDeploy, core.TransactionGasPrice, gasLimit)
@@ -12,6 +12,9 @@ It has these top-level messages:
 	ChunkHeader
 	ChunkHeaders
 	ChunkData
+	GetStateRoot
+	TrieNodesRequest
+	TrieNodes
 */
 package syncpb
 
@@ -119,30 +122,85 @@ func (m *ChunkData) GetRoot() []byte {
 	return nil
 }
 
+type GetStateRoot struct {
+	GenesisHash []byte `protobuf:"bytes,1,opt,name=genesis_hash,json=genesisHash,proto3" json:"genesis_hash,omitempty"`
+}
+
+func (m *GetStateRoot) Reset()                    { *m = GetStateRoot{} }
+func (m *GetStateRoot) String() string            { return proto.CompactTextString(m) }
+func (*GetStateRoot) ProtoMessage()               {}
+func (*GetStateRoot) Descriptor() ([]byte, []int) { return fileDescriptorSync, []int{4} }
+
+func (m *GetStateRoot) GetGenesisHash() []byte {
+	if m != nil {
+		return m.GenesisHash
+	}
+	return nil
+}
+
+type TrieNodesRequest struct {
+	Hashes [][]byte `protobuf:"bytes,1,rep,name=hashes" json:"hashes,omitempty"`
+}
+
+func (m *TrieNodesRequest) Reset()                    { *m = TrieNodesRequest{} }
+func (m *TrieNodesRequest) String() string            { return proto.CompactTextString(m) }
+func (*TrieNodesRequest) ProtoMessage()               {}
+func (*TrieNodesRequest) Descriptor() ([]byte, []int) { return fileDescriptorSync, []int{5} }
+
+func (m *TrieNodesRequest) GetHashes() [][]byte {
+	if m != nil {
+		return m.Hashes
+	}
+	return nil
+}
+
+type TrieNodes struct {
+	Nodes [][]byte `protobuf:"bytes,1,rep,name=nodes" json:"nodes,omitempty"`
+}
+
+func (m *TrieNodes) Reset()                    { *m = TrieNodes{} }
+func (m *TrieNodes) String() string            { return proto.CompactTextString(m) }
+func (*TrieNodes) ProtoMessage()               {}
+func (*TrieNodes) Descriptor() ([]byte, []int) { return fileDescriptorSync, []int{6} }
+
+func (m *TrieNodes) GetNodes() [][]byte {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Sync)(nil), "syncpb.Sync")
 	proto.RegisterType((*ChunkHeader)(nil), "syncpb.ChunkHeader")
 	proto.RegisterType((*ChunkHeaders)(nil), "syncpb.ChunkHeaders")
 	proto.RegisterType((*ChunkData)(nil), "syncpb.ChunkData")
+	proto.RegisterType((*GetStateRoot)(nil), "syncpb.GetStateRoot")
+	proto.RegisterType((*TrieNodesRequest)(nil), "syncpb.TrieNodesRequest")
+	proto.RegisterType((*TrieNodes)(nil), "syncpb.TrieNodes")
 }
 
 func init() { proto.RegisterFile("sync.proto", fileDescriptorSync) }
 
 var fileDescriptorSync = []byte{
-	// 236 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0x41, 0x4b, 0x03, 0x31,
-	0x10, 0x85, 0x59, 0x2d, 0x2b, 0x4e, 0xb7, 0x08, 0xf1, 0xb2, 0x78, 0x2a, 0x0b, 0x4a, 0x2f, 0x26,
-	0x60, 0x0f, 0x1e, 0xbc, 0xa9, 0x48, 0xcf, 0xeb, 0xd1, 0x43, 0x49, 0x62, 0x68, 0x96, 0xae, 0x99,
-	0x90, 0x64, 0x0f, 0xfd, 0xf7, 0x92, 0x69, 0x0b, 0x5b, 0xd8, 0xdb, 0x7b, 0x33, 0xf3, 0x3e, 0x78,
-	0x03, 0x10, 0x0f, 0x4e, 0x73, 0x1f, 0x30, 0x21, 0x2b, 0xb3, 0xf6, 0xea, 0x61, 0xbd, 0xeb, 0x92,
-	0x1d, 0x14, 0xd7, 0xf8, 0x27, 0x9c, 0x51, 0x43, 0x2f, 0x63, 0x87, 0x62, 0x87, 0xcf, 0x27, 0x23,
-	0x34, 0x06, 0x23, 0xbc, 0x12, 0xaa, 0x47, 0xbd, 0x3f, 0x86, 0x1b, 0x0e, 0xb3, 0xef, 0x83, 0xd3,
-	0xec, 0x09, 0xee, 0x92, 0xec, 0xfa, 0x2d, 0xed, 0xb6, 0x56, 0x46, 0x5b, 0x17, 0xcb, 0x62, 0x55,
-	0xb5, 0x8b, 0x3c, 0x7e, 0xcf, 0xd3, 0x8d, 0x8c, 0xb6, 0x79, 0x83, 0xf9, 0x87, 0x1d, 0xdc, 0x7e,
-	0x63, 0xe4, 0xaf, 0x09, 0xac, 0x86, 0x1b, 0x4b, 0x2a, 0xd6, 0xc5, 0xf2, 0x7a, 0x55, 0xb5, 0x67,
-	0xcb, 0x18, 0xcc, 0x02, 0x62, 0xaa, 0xaf, 0x88, 0x42, 0xba, 0xf9, 0x81, 0x6a, 0x14, 0x8e, 0xec,
-	0x15, 0x2a, 0x3d, 0xf2, 0x84, 0x98, 0xbf, 0xdc, 0xf3, 0x63, 0x21, 0x3e, 0xba, 0x6d, 0x2f, 0x0e,
-	0x27, 0xe1, 0x5f, 0x70, 0x4b, 0x81, 0x4f, 0x99, 0x24, 0x7b, 0x84, 0x92, 0x9a, 0x9c, 0x99, 0x0b,
-	0x9e, 0xcb, 0x7b, 0xc5, 0xa9, 0x49, 0x7b, 0x5a, 0x4e, 0x71, 0x54, 0x49, 0x8f, 0x59, 0xff, 0x07,
-	0x00, 0x00, 0xff, 0xff, 0x73, 0x84, 0xc9, 0xac, 0x63, 0x01, 0x00, 0x00,
+	// 297 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x51, 0x41, 0x4b, 0xc3, 0x30,
+	0x18, 0x65, 0x3a, 0x2b, 0xfb, 0xd6, 0xa1, 0x44, 0x91, 0xe2, 0x69, 0x2b, 0x28, 0x43, 0x30, 0x45,
+	0x77, 0xf0, 0xe0, 0x4d, 0x45, 0x77, 0xf2, 0x90, 0x79, 0xf3, 0x30, 0x92, 0xec, 0x63, 0x29, 0x9b,
+	0xf9, 0x6a, 0x93, 0x1e, 0xf6, 0xef, 0xa5, 0x59, 0x37, 0x2a, 0xec, 0xf6, 0xbd, 0x97, 0xf7, 0x1e,
+	0xbc, 0x17, 0x00, 0xb7, 0xb1, 0x9a, 0x17, 0x25, 0x79, 0x62, 0x51, 0x7d, 0x17, 0xea, 0x7a, 0xb2,
+	0xcc, 0xbd, 0xa9, 0x14, 0xd7, 0xf4, 0x93, 0x59, 0x54, 0xd5, 0x5a, 0xba, 0x9c, 0xb2, 0x25, 0xdd,
+	0x37, 0x20, 0xd3, 0x54, 0x62, 0x56, 0xa8, 0x4c, 0xad, 0x49, 0xaf, 0xb6, 0xe6, 0x94, 0x43, 0x77,
+	0xb6, 0xb1, 0x9a, 0xdd, 0xc2, 0x99, 0x97, 0xf9, 0x7a, 0x1e, 0xde, 0xe6, 0x46, 0x3a, 0x93, 0x74,
+	0x86, 0x9d, 0x71, 0x2c, 0x06, 0x35, 0xfd, 0x52, 0xb3, 0x53, 0xe9, 0x4c, 0xfa, 0x0c, 0xfd, 0x57,
+	0x53, 0xd9, 0xd5, 0x14, 0xe5, 0x02, 0x4b, 0x96, 0xc0, 0xa9, 0x09, 0x97, 0x4b, 0x3a, 0xc3, 0xe3,
+	0x71, 0x2c, 0x76, 0x90, 0x31, 0xe8, 0x96, 0x44, 0x3e, 0x39, 0x0a, 0x29, 0xe1, 0x4e, 0xbf, 0x21,
+	0x6e, 0x99, 0x1d, 0x7b, 0x82, 0x58, 0xb7, 0x70, 0x88, 0xe8, 0x3f, 0x5e, 0xf0, 0x6d, 0x21, 0xde,
+	0xd2, 0x8a, 0x7f, 0xc2, 0x83, 0xe1, 0xef, 0xd0, 0x0b, 0x86, 0x37, 0xe9, 0x25, 0xbb, 0x81, 0x28,
+	0x34, 0xd9, 0x65, 0x0e, 0x78, 0x5d, 0xbe, 0x50, 0x3c, 0x34, 0x11, 0xcd, 0xe3, 0xc1, 0x9c, 0x07,
+	0x88, 0x3f, 0xd0, 0xcf, 0xbc, 0xf4, 0x28, 0x88, 0x3c, 0x1b, 0x41, 0xbc, 0x44, 0x8b, 0x2e, 0x77,
+	0xed, 0x59, 0xfa, 0x0d, 0x17, 0x46, 0xb9, 0x83, 0xf3, 0xaf, 0x32, 0xc7, 0x4f, 0x5a, 0xa0, 0x13,
+	0xf8, 0x5b, 0xa1, 0xf3, 0xec, 0x0a, 0xa2, 0x5a, 0x8e, 0xbb, 0x61, 0x1a, 0x94, 0x8e, 0xa0, 0xb7,
+	0xd7, 0xb2, 0x4b, 0x38, 0xb1, 0xb4, 0xd8, 0x6b, 0xb6, 0x40, 0x45, 0xe1, 0x6b, 0x26, 0x7f, 0x03,
+	0x00, 0x7b, 0x69, 0xac, 0x8b, 0xe5, 0x01, 0x00, 0x00,
 }
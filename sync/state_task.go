@@ -0,0 +1,420 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package sync
+
+import (
+	"errors"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/net"
+	"github.com/nebulasio/go-nebulas/sync/pb"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// Errors
+var (
+	ErrInvalidChainStateRootMessageData = errors.New("invalid ChainStateRoot message data")
+	ErrInvalidChainTrieNodesMessageData = errors.New("invalid ChainTrieNodes message data")
+	ErrWrongChainTrieNodesMessageData   = errors.New("wrong ChainTrieNodes message data")
+)
+
+type trieNodesRequest struct {
+	hashes [][]byte
+	sentAt int64
+}
+
+// StateTask syncs the states at a recent LIB agreed by most peers,
+// so that only the blocks after it need to be replayed.
+type StateTask struct {
+	blockChain *core.BlockChain
+	netService net.Service
+	syncMutex  sync.Mutex
+
+	stateRootPeers        []string
+	stateRootCounter      map[string]int
+	stateRootBlocks       map[string]*corepb.Block
+	stateRootSourcePeers  map[string][]string
+	receivedStateRootPeer map[string]bool
+	stateRootDoneCh       chan bool
+
+	pivot            *core.Block
+	pivotPeers       []string
+	trieSync         *trie.Sync
+	trieNodeRequests map[string]*trieNodesRequest
+	trieNodesDone    bool
+	trieNodesDoneCh  chan bool
+}
+
+// NewStateTask return a new state sync task
+func NewStateTask(blockChain *core.BlockChain, netService net.Service) *StateTask {
+	st := &StateTask{
+		blockChain:      blockChain,
+		netService:      netService,
+		stateRootDoneCh: make(chan bool, 1),
+		trieNodesDoneCh: make(chan bool, 1),
+	}
+	st.reset()
+	return st
+}
+
+func (st *StateTask) reset() {
+	st.syncMutex.Lock()
+	defer st.syncMutex.Unlock()
+
+	st.stateRootPeers = nil
+	st.stateRootCounter = make(map[string]int)
+	st.stateRootBlocks = make(map[string]*corepb.Block)
+	st.stateRootSourcePeers = make(map[string][]string)
+	st.receivedStateRootPeer = make(map[string]bool)
+}
+
+// run syncs the states until done, return false if it's stopped by quitCh.
+// If no states are synced, blocks are replayed from genesis as usual.
+func (st *StateTask) run(quitCh chan bool) bool {
+	st.sendGetStateRoot()
+
+	syncTicker := time.NewTicker(10 * time.Second)
+	defer syncTicker.Stop()
+
+STATE_SYNC_STEP_1:
+	for {
+		select {
+		case <-quitCh:
+			logging.VLog().Info("Stopped state sync.")
+			return false
+		case <-syncTicker.C:
+			if !st.receivedEnoughStateRoots() {
+				st.reset()
+				st.sendGetStateRoot()
+			}
+		case <-st.stateRootDoneCh:
+			break STATE_SYNC_STEP_1
+		}
+	}
+
+	if err := st.startTrieSync(); err != nil {
+		logging.CLog().WithFields(logrus.Fields{
+			"err": err,
+		}).Info("Skip state sync, replay blocks from genesis.")
+		return true
+	}
+
+	logging.CLog().WithFields(logrus.Fields{
+		"pivot": st.pivot,
+		"peers": st.pivotPeers,
+	}).Info("Starting to sync states from peers.")
+
+	timeoutTicker := time.NewTicker(GetChunkDataTimeout * time.Second)
+	defer timeoutTicker.Stop()
+
+	for {
+		select {
+		case <-quitCh:
+			logging.VLog().Info("Stopped state sync.")
+			return false
+		case <-timeoutTicker.C:
+			st.checkTrieNodesTimeout()
+		case <-st.trieNodesDoneCh:
+			if err := st.blockChain.SetStateSyncTail(st.pivot); err != nil {
+				logging.CLog().WithFields(logrus.Fields{
+					"pivot": st.pivot,
+					"err":   err,
+				}).Error("Failed to set the tail synced from peers.")
+				return true
+			}
+			logging.CLog().WithFields(logrus.Fields{
+				"tail": st.pivot,
+			}).Info("Finished state sync. Move to block sync.")
+			return true
+		}
+	}
+}
+
+func (st *StateTask) sendGetStateRoot() {
+	peers := st.broadcastGetStateRoot()
+
+	st.syncMutex.Lock()
+	defer st.syncMutex.Unlock()
+	st.stateRootPeers = peers
+}
+
+func (st *StateTask) broadcastGetStateRoot() []string {
+	data, err := proto.Marshal(&syncpb.GetStateRoot{
+		GenesisHash: st.blockChain.GenesisBlock().Hash(),
+	})
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"err": err,
+		}).Warn("Failed to serialize GetStateRoot message")
+		return nil
+	}
+
+	return st.netService.SendMessageToPeers(net.ChainGetStateRoot, data,
+		net.MessagePriorityLow, new(net.ChainSyncPeersFilter))
+}
+
+func (st *StateTask) processStateRoot(message net.Message) {
+	st.syncMutex.Lock()
+	defer st.syncMutex.Unlock()
+
+	pbBlock := new(corepb.Block)
+	if err := proto.Unmarshal(message.Data(), pbBlock); err != nil || pbBlock.Header == nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"err": err,
+			"pid": message.MessageFrom(),
+		}).Debug("Invalid ChainStateRoot message data.")
+		st.netService.ClosePeer(message.MessageFrom(), ErrInvalidChainStateRootMessageData)
+		return
+	}
+	hash := byteutils.Hex(pbBlock.Header.Hash)
+
+	// peers agreeing with the pivot can serve trie nodes.
+	if st.pivot != nil {
+		if hash == st.pivot.Hash().String() && !contains(st.pivotPeers, message.MessageFrom()) {
+			st.pivotPeers = append(st.pivotPeers, message.MessageFrom())
+			st.sendGetTrieNodes()
+		}
+		return
+	}
+
+	if st.hasEnoughStateRoots() || !contains(st.stateRootPeers, message.MessageFrom()) {
+		return
+	}
+	if st.receivedStateRootPeer[message.MessageFrom()] {
+		return
+	}
+	calculated, err := core.HashPbBlock(pbBlock)
+	if err != nil || hash != calculated.String() {
+		logging.VLog().WithFields(logrus.Fields{
+			"err": err,
+			"pid": message.MessageFrom(),
+		}).Debug("Invalid block hash in ChainStateRoot message data.")
+		st.netService.ClosePeer(message.MessageFrom(), ErrInvalidChainStateRootMessageData)
+		return
+	}
+
+	st.receivedStateRootPeer[message.MessageFrom()] = true
+	st.stateRootCounter[hash]++
+	st.stateRootBlocks[hash] = pbBlock
+	st.stateRootSourcePeers[hash] = append(st.stateRootSourcePeers[hash], message.MessageFrom())
+
+	if st.hasEnoughStateRoots() {
+		st.stateRootDoneCh <- true
+	}
+}
+
+func (st *StateTask) receivedEnoughStateRoots() bool {
+	st.syncMutex.Lock()
+	defer st.syncMutex.Unlock()
+	return st.hasEnoughStateRoots()
+}
+
+// hasEnoughStateRoots return whether a state root is agreed by the majority of peers,
+// the caller must hold syncMutex.
+func (st *StateTask) hasEnoughStateRoots() bool {
+	if len(st.stateRootPeers) == 0 {
+		return false
+	}
+	for _, count := range st.stateRootCounter {
+		if count > len(st.stateRootPeers)/2 {
+			return true
+		}
+	}
+	return false
+}
+
+func (st *StateTask) startTrieSync() error {
+	st.syncMutex.Lock()
+	defer st.syncMutex.Unlock()
+
+	var pivot string
+	for hash, count := range st.stateRootCounter {
+		if pivot == "" || count > st.stateRootCounter[pivot] {
+			pivot = hash
+		}
+	}
+	block := new(core.Block)
+	if err := block.FromProto(st.stateRootBlocks[pivot]); err != nil {
+		return err
+	}
+	if block.Height() <= st.blockChain.GenesisBlock().Height() {
+		return ErrTooSmallGapToSync
+	}
+
+	trieSync := trie.NewSync(st.blockChain.Storage())
+	accountCallback := func(value []byte) ([][]byte, error) {
+		pbAcc := new(corepb.Account)
+		if err := proto.Unmarshal(value, pbAcc); err != nil {
+			return nil, err
+		}
+		return [][]byte{pbAcc.VarsHash}, nil
+	}
+	if err := trieSync.AddRoot(block.StateRoot(), accountCallback); err != nil {
+		return err
+	}
+	roots := [][]byte{block.TxsRoot(), block.EventsRoot()}
+	if root := block.ConsensusRoot(); root != nil {
+		roots = append(roots, root.DynastyRoot, root.CandidatesRoot, root.DelegateRoot, root.VoteRoot, root.SlashedRoot)
+	}
+	for _, root := range roots {
+		if err := trieSync.AddRoot(root, nil); err != nil {
+			return err
+		}
+	}
+
+	st.pivot = block
+	st.pivotPeers = st.stateRootSourcePeers[pivot]
+	st.trieSync = trieSync
+	st.trieNodeRequests = make(map[string]*trieNodesRequest)
+	st.sendGetTrieNodes()
+	return nil
+}
+
+func (st *StateTask) sendGetTrieNodes() {
+	if st.trieNodesDone {
+		return
+	}
+	if st.trieSync.Pending() == 0 {
+		st.trieNodesDone = true
+		st.trieNodesDoneCh <- true
+		return
+	}
+
+	for _, idx := range rand.Perm(len(st.pivotPeers)) {
+		peer := st.pivotPeers[idx]
+		if st.trieNodeRequests[peer] != nil {
+			continue
+		}
+		hashes := st.trieSync.Missing(MaxTrieNodesPerRequest)
+		if len(hashes) == 0 {
+			return
+		}
+		data, err := proto.Marshal(&syncpb.TrieNodesRequest{Hashes: hashes})
+		if err != nil {
+			logging.VLog().WithFields(logrus.Fields{
+				"err": err,
+			}).Warn("Failed to marshal TrieNodesRequest.")
+			st.trieSync.Retry(hashes)
+			return
+		}
+		st.netService.SendMessageToPeer(net.ChainGetTrieNodes, data, net.MessagePriorityLow, peer)
+		st.trieNodeRequests[peer] = &trieNodesRequest{hashes: hashes, sentAt: time.Now().Unix()}
+	}
+}
+
+func (st *StateTask) processTrieNodes(message net.Message) {
+	st.syncMutex.Lock()
+	defer st.syncMutex.Unlock()
+
+	peer := message.MessageFrom()
+	if st.trieSync == nil || st.trieNodeRequests[peer] == nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"pid": peer,
+		}).Debug("Unrequested ChainTrieNodes message data.")
+		return
+	}
+	request := st.trieNodeRequests[peer]
+	delete(st.trieNodeRequests, peer)
+
+	trieNodes := new(syncpb.TrieNodes)
+	if err := proto.Unmarshal(message.Data(), trieNodes); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"err": err,
+			"pid": peer,
+		}).Debug("Invalid ChainTrieNodes message data.")
+		st.trieSync.Retry(request.hashes)
+		st.closePivotPeer(peer, ErrInvalidChainTrieNodesMessageData)
+		return
+	}
+
+	for _, node := range trieNodes.Nodes {
+		if err := st.trieSync.Process(node); err != nil {
+			logging.VLog().WithFields(logrus.Fields{
+				"err": err,
+				"pid": peer,
+			}).Debug("Wrong ChainTrieNodes message data.")
+			st.trieSync.Retry(request.hashes)
+			st.closePivotPeer(peer, ErrWrongChainTrieNodesMessageData)
+			return
+		}
+	}
+
+	// the nodes missing in response are requested again.
+	st.trieSync.Retry(request.hashes)
+
+	logging.VLog().WithFields(logrus.Fields{
+		"received": len(trieNodes.Nodes),
+		"pending":  st.trieSync.Pending(),
+		"pid":      peer,
+	}).Debug("Processed ChainTrieNodes message data.")
+
+	st.sendGetTrieNodes()
+}
+
+func (st *StateTask) closePivotPeer(peer string, err error) {
+	st.netService.ClosePeer(peer, err)
+	for i, p := range st.pivotPeers {
+		if p == peer {
+			st.pivotPeers = append(st.pivotPeers[:i], st.pivotPeers[i+1:]...)
+			break
+		}
+	}
+}
+
+func (st *StateTask) checkTrieNodesTimeout() {
+	st.syncMutex.Lock()
+	defer st.syncMutex.Unlock()
+
+	now := time.Now().Unix()
+	for peer, request := range st.trieNodeRequests {
+		if now-request.sentAt < GetChunkDataTimeout {
+			continue
+		}
+		logging.VLog().WithFields(logrus.Fields{
+			"pid":   peer,
+			"count": len(request.hashes),
+		}).Debug("Get trie nodes timeout. Retry.")
+		delete(st.trieNodeRequests, peer)
+		st.trieSync.Retry(request.hashes)
+	}
+
+	// find more peers keeping the states of pivot.
+	if len(st.pivotPeers) == 0 {
+		st.broadcastGetStateRoot()
+		return
+	}
+	st.sendGetTrieNodes()
+}
+
+func contains(peers []string, peer string) bool {
+	for _, p := range peers {
+		if p == peer {
+			return true
+		}
+	}
+	return false
+}
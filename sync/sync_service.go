@@ -26,6 +26,7 @@ import (
 	"github.com/nebulasio/go-nebulas/util/byteutils"
 
 	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/common/trie"
 	"github.com/nebulasio/go-nebulas/core"
 	"github.com/nebulasio/go-nebulas/net"
 	"github.com/nebulasio/go-nebulas/sync/pb"
@@ -35,8 +36,9 @@ import (
 
 // Errors
 var (
-	ErrInvalidChainSyncMessageData     = errors.New("invalid ChainSync message data")
-	ErrInvalidChainGetChunkMessageData = errors.New("invalid ChainGetChunk message data")
+	ErrInvalidChainSyncMessageData         = errors.New("invalid ChainSync message data")
+	ErrInvalidChainGetChunkMessageData     = errors.New("invalid ChainGetChunk message data")
+	ErrInvalidChainGetTrieNodesMessageData = errors.New("invalid ChainGetTrieNodes message data")
 )
 
 // Service manage sync tasks
@@ -49,6 +51,8 @@ type Service struct {
 
 	activeTask      *Task
 	activeTaskMutex sync.Mutex
+
+	enableStateSync bool
 }
 
 // NewService return new Service.
@@ -73,6 +77,10 @@ func (ss *Service) Start() {
 	netService.Register(net.NewSubscriber(ss, ss.messageCh, false, net.ChainChunks, net.MessageWeightChainChunks))
 	netService.Register(net.NewSubscriber(ss, ss.messageCh, false, net.ChainGetChunk, net.MessageWeightZero))
 	netService.Register(net.NewSubscriber(ss, ss.messageCh, false, net.ChainChunkData, net.MessageWeightChainChunkData))
+	netService.Register(net.NewSubscriber(ss, ss.messageCh, false, net.ChainGetStateRoot, net.MessageWeightZero))
+	netService.Register(net.NewSubscriber(ss, ss.messageCh, false, net.ChainStateRoot, net.MessageWeightChainChunks))
+	netService.Register(net.NewSubscriber(ss, ss.messageCh, false, net.ChainGetTrieNodes, net.MessageWeightZero))
+	netService.Register(net.NewSubscriber(ss, ss.messageCh, false, net.ChainTrieNodes, net.MessageWeightChainChunkData))
 
 	// start loop().
 	go ss.startLoop()
@@ -86,12 +94,22 @@ func (ss *Service) Stop() {
 	netService.Deregister(net.NewSubscriber(ss, ss.messageCh, false, net.ChainChunks, net.MessageWeightChainChunks))
 	netService.Deregister(net.NewSubscriber(ss, ss.messageCh, false, net.ChainGetChunk, net.MessageWeightZero))
 	netService.Deregister(net.NewSubscriber(ss, ss.messageCh, false, net.ChainChunkData, net.MessageWeightChainChunkData))
+	netService.Deregister(net.NewSubscriber(ss, ss.messageCh, false, net.ChainGetStateRoot, net.MessageWeightZero))
+	netService.Deregister(net.NewSubscriber(ss, ss.messageCh, false, net.ChainStateRoot, net.MessageWeightChainChunks))
+	netService.Deregister(net.NewSubscriber(ss, ss.messageCh, false, net.ChainGetTrieNodes, net.MessageWeightZero))
+	netService.Deregister(net.NewSubscriber(ss, ss.messageCh, false, net.ChainTrieNodes, net.MessageWeightChainChunkData))
 
 	ss.StopActiveSync()
 
 	ss.quitCh <- true
 }
 
+// EnableStateSync makes an empty chain sync the states at a recent LIB
+// from peers, instead of replaying all blocks from genesis.
+func (ss *Service) EnableStateSync(enable bool) {
+	ss.enableStateSync = enable
+}
+
 // StartActiveSync starts an active sync task
 func (ss *Service) StartActiveSync() bool {
 	// lock.
@@ -103,6 +121,9 @@ func (ss *Service) StartActiveSync() bool {
 	}
 
 	ss.activeTask = NewTask(ss.blockChain, ss.netService, ss.chunk)
+	if ss.enableStateSync && ss.blockChain.TailBlock().Height() == ss.blockChain.GenesisBlock().Height() {
+		ss.activeTask.stateTask = NewStateTask(ss.blockChain, ss.netService)
+	}
 	ss.activeTask.Start()
 
 	logging.CLog().WithFields(logrus.Fields{
@@ -169,6 +190,14 @@ func (ss *Service) startLoop() {
 				ss.onChainGetChunk(message)
 			case net.ChainChunkData:
 				ss.onChainChunkData(message)
+			case net.ChainGetStateRoot:
+				ss.onChainGetStateRoot(message)
+			case net.ChainStateRoot:
+				ss.onChainStateRoot(message)
+			case net.ChainGetTrieNodes:
+				ss.onChainGetTrieNodes(message)
+			case net.ChainTrieNodes:
+				ss.onChainTrieNodes(message)
 			default:
 				logging.VLog().WithFields(logrus.Fields{
 					"messageName": message.MessageType(),
@@ -253,6 +282,90 @@ func (ss *Service) onChainChunkData(message net.Message) {
 	ss.activeTask.processChunkData(message)
 }
 
+func (ss *Service) onChainGetStateRoot(message net.Message) {
+	if ss.IsActiveSyncing() {
+		return
+	}
+
+	getStateRoot := new(syncpb.GetStateRoot)
+	if err := proto.Unmarshal(message.Data(), getStateRoot); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"err": err,
+			"pid": message.MessageFrom(),
+		}).Debug("Invalid ChainGetStateRoot message data.")
+		return
+	}
+	if !byteutils.Equal(getStateRoot.GenesisHash, ss.blockChain.GenesisBlock().Hash()) {
+		return
+	}
+
+	// the states of LIB are never pruned.
+	pbBlock, err := ss.blockChain.LIB().ToProto()
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"err": err,
+		}).Debug("Failed to serialize LIB.")
+		return
+	}
+	ss.sendMessage(message.MessageFrom(), net.ChainStateRoot, pbBlock)
+}
+
+func (ss *Service) onChainStateRoot(message net.Message) {
+	if ss.activeTask == nil || ss.activeTask.stateTask == nil {
+		return
+	}
+
+	ss.activeTask.stateTask.processStateRoot(message)
+}
+
+func (ss *Service) onChainGetTrieNodes(message net.Message) {
+	if ss.IsActiveSyncing() {
+		return
+	}
+
+	request := new(syncpb.TrieNodesRequest)
+	if err := proto.Unmarshal(message.Data(), request); err != nil || len(request.Hashes) > MaxTrieNodesPerRequest {
+		logging.VLog().WithFields(logrus.Fields{
+			"err": err,
+			"pid": message.MessageFrom(),
+		}).Debug("Invalid ChainGetTrieNodes message data.")
+		ss.netService.ClosePeer(message.MessageFrom(), ErrInvalidChainGetTrieNodesMessageData)
+		return
+	}
+
+	// only trie nodes are served, the missing ones are skipped.
+	nodes := [][]byte{}
+	for _, hash := range request.Hashes {
+		value, err := ss.blockChain.Storage().Get(hash)
+		if err != nil || !trie.IsNode(hash, value) {
+			continue
+		}
+		nodes = append(nodes, value)
+	}
+	ss.sendMessage(message.MessageFrom(), net.ChainTrieNodes, &syncpb.TrieNodes{Nodes: nodes})
+}
+
+func (ss *Service) onChainTrieNodes(message net.Message) {
+	if ss.activeTask == nil || ss.activeTask.stateTask == nil {
+		return
+	}
+
+	ss.activeTask.stateTask.processTrieNodes(message)
+}
+
+func (ss *Service) sendMessage(peerID string, msgType string, msg proto.Message) {
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"err":     err,
+			"msgType": msgType,
+		}).Debug("Failed to marshal message.")
+		return
+	}
+
+	ss.netService.SendMessageToPeer(msgType, data, net.MessagePriorityLow, peerID)
+}
+
 func (ss *Service) sendChainChunks(peerID string, chunks *syncpb.ChunkHeaders) {
 	data, err := proto.Marshal(chunks)
 	if err != nil {
@@ -75,6 +75,9 @@ type Task struct {
 	chainChunkDataStatus          map[int]int64
 	chinGetChunkDataDoneCh        chan bool
 
+	// sync states before blocks if not nil.
+	stateTask *StateTask
+
 	// debug fields.
 	chainSyncRetryCount int
 }
@@ -117,6 +120,13 @@ func (st *Task) Stop() {
 }
 
 func (st *Task) startSyncLoop() {
+	if st.stateTask != nil {
+		if !st.stateTask.run(st.quitCh) {
+			return
+		}
+		st.setSyncPointToNewTail()
+	}
+
 	for {
 		// start chain sync.
 		st.sendChainSync()
@@ -218,7 +228,10 @@ func (st *Task) setSyncPointToLastChunk() {
 		lastChunkBlockHeight = st.syncPointBlock.Height() - uint64(core.ChunkSize)
 	}
 
-	st.syncPointBlock = st.blockChain.GetBlockOnCanonicalChainByHeight(lastChunkBlockHeight)
+	// the blocks before the states synced from peers are missing.
+	if block := st.blockChain.GetBlockOnCanonicalChainByHeight(lastChunkBlockHeight); block != nil {
+		st.syncPointBlock = block
+	}
 }
 
 func (st *Task) sendChainSync() {
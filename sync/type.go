@@ -41,6 +41,7 @@ const (
 	MaxChunkPerSyncRequest       = 10
 	ConcurrentSyncChunkDataCount = 10
 	GetChunkDataTimeout          = 10 // 10s.
+	MaxTrieNodesPerRequest       = 384
 )
 
 // Metrics